@@ -28,23 +28,28 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-var rolesCtxKey = "roles"
+var (
+	rolesCtxKey   = "roles"
+	subjectCtxKey = "subject"
+)
 
 type authenticator struct {
 	authConfig   *config.AuthConfig
 	logger       *zap.Logger
 	roleRegistry RoleRegistry
+	roleMapper   RoleMapper
 }
 
 type Authenticator interface {
 	Authenticate(ctx context.Context) (context.Context, error)
 }
 
-func NewAuthenticator(authConfig *config.AuthConfig, logger *zap.Logger, registry RoleRegistry) Authenticator {
+func NewAuthenticator(authConfig *config.AuthConfig, logger *zap.Logger, registry RoleRegistry, mapper RoleMapper) Authenticator {
 	return &authenticator{
 		authConfig,
 		logger,
 		registry,
+		mapper,
 	}
 }
 
@@ -84,6 +89,8 @@ func (a *authenticator) basic(ctx context.Context, log *zap.Logger) (context.Con
 	}
 
 	if a.authConfig.Basic.Username == parts[0] && a.authConfig.Basic.Password == parts[1] {
+		ctx = context.WithValue(ctx, subjectCtxKey, parts[0])
+
 		return context.WithValue(ctx, rolesCtxKey, []Role{RoleAdministrator}), nil
 	}
 
@@ -106,14 +113,23 @@ func (a *authenticator) oidc(ctx context.Context, log *zap.Logger) (context.Cont
 		return nil, util.GrpcErrorWithCode(log, "error unmarshalling claims", err, codes.Unauthenticated)
 	}
 
+	mappedRoles := a.roleMapper.MapRoles(claims)
 	allRoles, ok := gabs.Wrap(claims).Path(a.authConfig.OIDC.RoleClaimPath).Data().([]interface{})
-	if !ok {
+	// the roles claim may be omitted when the caller's roles come from the role mappings instead
+	if !ok && len(mappedRoles) == 0 {
 		return nil, util.GrpcErrorWithCode(log, "missing roles claim", nil, codes.Unauthenticated)
 	}
 
 	var roles []Role
 	for _, roleName := range allRoles {
-		if role := a.roleRegistry.GetRoleByName(roleName.(string)); role != "" {
+		name, _ := roleName.(string)
+		if role := a.roleRegistry.GetRoleByName(name); role != "" {
+			roles = append(roles, role)
+		}
+	}
+
+	for _, role := range mappedRoles {
+		if !containsRole(roles, role) {
 			roles = append(roles, role)
 		}
 	}
@@ -122,5 +138,25 @@ func (a *authenticator) oidc(ctx context.Context, log *zap.Logger) (context.Cont
 		roles = append(roles, RoleAnonymous)
 	}
 
+	ctx = context.WithValue(ctx, subjectCtxKey, token.Subject)
+
 	return context.WithValue(ctx, rolesCtxKey, roles), nil
 }
+
+// SubjectFromContext returns the identity of the caller, as set by the Authenticator: the basic auth username or
+// the sub claim of the OIDC token. An empty string is returned for anonymous requests.
+func SubjectFromContext(ctx context.Context) string {
+	subject, _ := ctx.Value(subjectCtxKey).(string)
+
+	return subject
+}
+
+func containsRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -39,6 +39,7 @@ var _ = Describe("Auth", func() {
 	var (
 		authConfig    *config.AuthConfig
 		ctx           context.Context
+		roleMapper    RoleMapper
 		authenticator Authenticator
 
 		actualCtx   context.Context
@@ -47,16 +48,15 @@ var _ = Describe("Auth", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		registry := NewRoleRegistry()
+		roleMapper = NewRoleMapper()
 		authConfig = &config.AuthConfig{
 			Basic: &config.BasicAuthConfig{},
 			OIDC:  &config.OIDCAuthConfig{},
 		}
-
-		authenticator = NewAuthenticator(authConfig, logger, registry)
 	})
 
 	JustBeforeEach(func() {
+		authenticator = NewAuthenticator(authConfig, logger, NewRoleRegistry(), roleMapper)
 		actualCtx, actualError = authenticator.Authenticate(ctx)
 	})
 
@@ -64,6 +64,10 @@ var _ = Describe("Auth", func() {
 		It("should allow the request", func() {
 			Expect(actualError).ToNot(HaveOccurred())
 		})
+
+		It("should not set a subject", func() {
+			Expect(SubjectFromContext(actualCtx)).To(BeEmpty())
+		})
 	})
 
 	When("no authentication is configured but the authorization header is set", func() {
@@ -94,6 +98,10 @@ var _ = Describe("Auth", func() {
 			It("should allow the request", func() {
 				Expect(actualError).NotTo(HaveOccurred())
 			})
+
+			It("should set the subject to the username", func() {
+				Expect(SubjectFromContext(actualCtx)).To(Equal(authConfig.Basic.Username))
+			})
 		})
 
 		When("the credentials are incorrect", func() {
@@ -148,6 +156,7 @@ var _ = Describe("Auth", func() {
 			issuer   string
 			keySet   *fakeKeySet
 			clientId string
+			subject  string
 			verifier *oidc.IDTokenVerifier
 			payload  []byte
 		)
@@ -156,6 +165,7 @@ var _ = Describe("Auth", func() {
 			issuer = fake.LetterN(10)
 			keySet = &fakeKeySet{}
 			clientId = fake.LetterN(10)
+			subject = fake.UUID()
 			verifier = oidc.NewVerifier(issuer, keySet, &oidc.Config{
 				ClientID: clientId,
 			})
@@ -177,7 +187,7 @@ var _ = Describe("Auth", func() {
 					string(RoleEnforcer),
 				})
 
-				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, role, time.Now().Add(time.Minute*1).Unix())
+				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, subject, role, time.Now().Add(time.Minute*1).Unix())
 				keySet.jwtPayload = payload
 				keySet.shouldVerify = true
 			})
@@ -185,12 +195,13 @@ var _ = Describe("Auth", func() {
 			It("should allow the request", func() {
 				Expect(actualError).NotTo(HaveOccurred())
 				Expect(actualCtx.Value(rolesCtxKey)).To(Equal([]Role{Role(role)}))
+				Expect(SubjectFromContext(actualCtx)).To(Equal(subject))
 			})
 		})
 
 		When("there the role claim does not contain any known roles", func() {
 			BeforeEach(func() {
-				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, fake.Word(), time.Now().Add(time.Minute*1).Unix())
+				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, subject, fake.Word(), time.Now().Add(time.Minute*1).Unix())
 
 				keySet.jwtPayload = payload
 				keySet.shouldVerify = true
@@ -227,9 +238,94 @@ var _ = Describe("Auth", func() {
 			})
 		})
 
+		When("role mappings are configured", func() {
+			var (
+				claims    *fakeClaims
+				useClaims func()
+			)
+
+			BeforeEach(func() {
+				var err error
+				roleMapper, err = ParseRoleMappings([]byte(`
+mappings:
+  - claim: groups
+    value: rode-*-admins
+    roles: [PolicyAdministrator]
+  - claim: scope
+    value: rode:collect
+    roles: [Collector]
+`), NewRoleRegistry())
+				Expect(err).NotTo(HaveOccurred())
+
+				claims = &fakeClaims{
+					StandardClaims: &jwt.StandardClaims{
+						Issuer:    issuer,
+						Audience:  clientId,
+						Subject:   subject,
+						ExpiresAt: time.Now().Add(time.Minute * 1).Unix(),
+					},
+					Roles:  []string{string(RoleEnforcer)},
+					Groups: []string{fake.Word(), "rode-platform-admins"},
+					Scope:  "openid rode:collect",
+				}
+				useClaims = func() {
+					ctx, payload = createCtxWithClaims(ctx, claims)
+					keySet.jwtPayload = payload
+					keySet.shouldVerify = true
+				}
+			})
+
+			When("the token has claims matching the mappings", func() {
+				BeforeEach(func() {
+					useClaims()
+				})
+
+				It("should add the mapped roles to the roles from the role claim", func() {
+					Expect(actualError).NotTo(HaveOccurred())
+					Expect(actualCtx.Value(rolesCtxKey)).To(Equal([]Role{RoleEnforcer, RolePolicyAdministrator, RoleCollector}))
+				})
+			})
+
+			When("a mapped role is also in the role claim", func() {
+				BeforeEach(func() {
+					claims.Roles = []string{string(RoleCollector)}
+					useClaims()
+				})
+
+				It("should only include the role once", func() {
+					Expect(actualCtx.Value(rolesCtxKey)).To(Equal([]Role{RoleCollector, RolePolicyAdministrator}))
+				})
+			})
+
+			When("the role claim is missing", func() {
+				BeforeEach(func() {
+					claims.Roles = nil
+					useClaims()
+				})
+
+				It("should use the mapped roles", func() {
+					Expect(actualError).NotTo(HaveOccurred())
+					Expect(actualCtx.Value(rolesCtxKey)).To(Equal([]Role{RolePolicyAdministrator, RoleCollector}))
+				})
+			})
+
+			When("no mappings match and the role claim is missing", func() {
+				BeforeEach(func() {
+					claims.Roles = nil
+					claims.Groups = []string{fake.Word()}
+					claims.Scope = "openid"
+					useClaims()
+				})
+
+				It("should deny the request", func() {
+					expectUnauthenticatedErrorToHaveOccurred(actualError)
+				})
+			})
+		})
+
 		When("jwt validation fails", func() {
 			BeforeEach(func() {
-				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, string(RoleAdministrator), time.Now().Add(time.Minute*1).Unix())
+				ctx, payload = createCtxWithJWT(ctx, issuer, clientId, subject, string(RoleAdministrator), time.Now().Add(time.Minute*1).Unix())
 				keySet.jwtPayload = payload
 				keySet.shouldVerify = false
 			})
@@ -269,7 +365,9 @@ func (f *fakeKeySet) VerifySignature(context.Context, string) ([]byte, error) {
 
 type fakeClaims struct {
 	*jwt.StandardClaims
-	Roles []string `json:"roles"`
+	Roles  []string `json:"roles"`
+	Groups []string `json:"groups,omitempty"`
+	Scope  string   `json:"scope,omitempty"`
 }
 
 func expectUnauthenticatedErrorToHaveOccurred(err error) {
@@ -291,15 +389,20 @@ func createCtxWithBasicAuth(ctx context.Context, username, password string) cont
 	return metautils.NiceMD(meta).ToIncoming(ctx)
 }
 
-func createCtxWithJWT(ctx context.Context, issuer, audience, role string, expires int64) (context.Context, []byte) {
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &fakeClaims{
+func createCtxWithJWT(ctx context.Context, issuer, audience, subject, role string, expires int64) (context.Context, []byte) {
+	return createCtxWithClaims(ctx, &fakeClaims{
 		StandardClaims: &jwt.StandardClaims{
 			Issuer:    issuer,
 			Audience:  audience,
+			Subject:   subject,
 			ExpiresAt: expires,
 		},
 		Roles: []string{role},
 	})
+}
+
+func createCtxWithClaims(ctx context.Context, claims jwt.Claims) (context.Context, []byte) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	key, _ := rsa.GenerateKey(rand.Reader, 2048)
 	signedString, _ := token.SignedString(key)
 
@@ -0,0 +1,164 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ghodss/yaml"
+	"github.com/gobwas/glob"
+)
+
+// RoleMapper grants roles based on the claims in an OIDC token, so that existing identity provider groups can be
+// reused instead of creating Rode specific roles.
+type RoleMapper interface {
+	MapRoles(claims map[string]interface{}) []Role
+}
+
+// RoleMapping grants Roles to callers that have a Claim with a value matching Value. Claim is a path to the claim;
+// a nested claim can be used by adding periods between the key names. Value may contain wildcards, for example
+// "rode-*-admins".
+type RoleMapping struct {
+	Claim string   `json:"claim"`
+	Value string   `json:"value"`
+	Roles []string `json:"roles"`
+}
+
+// roleMappingFile is the format of the role mapping file. For example:
+//
+//	mappings:
+//	  - claim: groups
+//	    value: rode-*-admins
+//	    roles:
+//	      - PolicyAdministrator
+//	  - claim: scope
+//	    value: rode:write
+//	    roles:
+//	      - ApplicationDeveloper
+type roleMappingFile struct {
+	Mappings []RoleMapping `json:"mappings"`
+}
+
+type compiledRoleMapping struct {
+	claim string
+	value glob.Glob
+	roles []Role
+}
+
+type roleMapper struct {
+	mappings []*compiledRoleMapping
+}
+
+// NewRoleMapper returns a RoleMapper without any mappings, so only the role names in the role claim are used
+func NewRoleMapper() RoleMapper {
+	return &roleMapper{}
+}
+
+// NewRoleMapperFromFile reads YAML or JSON role mappings from path
+func NewRoleMapperFromFile(path string, registry RoleRegistry) (RoleMapper, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading role mappings: %v", err)
+	}
+
+	return ParseRoleMappings(data, registry)
+}
+
+// ParseRoleMappings parses YAML or JSON role mappings. Every mapped role must exist in the registry.
+func ParseRoleMappings(data []byte, registry RoleRegistry) (RoleMapper, error) {
+	var file roleMappingFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing role mappings: %v", err)
+	}
+
+	mapper := &roleMapper{}
+	for i, mapping := range file.Mappings {
+		if mapping.Claim == "" {
+			return nil, fmt.Errorf("role mapping %d is missing a claim", i)
+		}
+
+		if mapping.Value == "" {
+			return nil, fmt.Errorf("role mapping %d is missing a value", i)
+		}
+
+		if len(mapping.Roles) == 0 {
+			return nil, fmt.Errorf("role mapping %d must grant at least one role", i)
+		}
+
+		value, err := glob.Compile(mapping.Value)
+		if err != nil {
+			return nil, fmt.Errorf("role mapping %d has an invalid value: %v", i, err)
+		}
+
+		compiled := &compiledRoleMapping{
+			claim: mapping.Claim,
+			value: value,
+		}
+		for _, roleName := range mapping.Roles {
+			role := registry.GetRoleByName(roleName)
+			if role == "" {
+				return nil, fmt.Errorf("role mapping %d has unknown role %s", i, roleName)
+			}
+
+			compiled.roles = append(compiled.roles, role)
+		}
+
+		mapper.mappings = append(mapper.mappings, compiled)
+	}
+
+	if len(mapper.mappings) == 0 {
+		return nil, errors.New("role mappings must contain at least one mapping")
+	}
+
+	return mapper, nil
+}
+
+func (m *roleMapper) MapRoles(claims map[string]interface{}) []Role {
+	var roles []Role
+	container := gabs.Wrap(claims)
+	for _, mapping := range m.mappings {
+		for _, value := range claimValues(container, mapping.claim) {
+			if mapping.value.Match(value) {
+				roles = append(roles, mapping.roles...)
+				break
+			}
+		}
+	}
+
+	return roles
+}
+
+// claimValues returns the values of a claim that's either a list of strings, or a space delimited string like scope
+func claimValues(claims *gabs.Container, path string) []string {
+	switch claim := claims.Path(path).Data().(type) {
+	case string:
+		return strings.Fields(claim)
+	case []interface{}:
+		var values []string
+		for _, value := range claim {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
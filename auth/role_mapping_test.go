@@ -0,0 +1,167 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RoleMapper", func() {
+	var registry = NewRoleRegistry()
+
+	Context("NewRoleMapper", func() {
+		It("should not map any roles", func() {
+			mapper := NewRoleMapper()
+
+			Expect(mapper.MapRoles(map[string]interface{}{
+				"roles": []interface{}{string(RoleAdministrator)},
+			})).To(BeEmpty())
+		})
+	})
+
+	Context("MapRoles", func() {
+		var mapper RoleMapper
+
+		BeforeEach(func() {
+			var err error
+			mapper, err = ParseRoleMappings([]byte(`
+mappings:
+  - claim: groups
+    value: 5e9d6a3c-2b0f-4c8e-9f51-3a7d2c1b0e84
+    roles: [Administrator]
+  - claim: groups
+    value: /rode/*
+    roles: [ApplicationDeveloper]
+  - claim: resource_access.rode.roles
+    value: policy-*
+    roles: [PolicyDeveloper, Enforcer]
+  - claim: scope
+    value: rode:collect
+    roles: [Collector]
+`), registry)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("claim to role mapping", func(claims map[string]interface{}, expectedRoles []Role) {
+			actualRoles := mapper.MapRoles(claims)
+
+			if len(expectedRoles) == 0 {
+				Expect(actualRoles).To(BeEmpty())
+			} else {
+				Expect(actualRoles).To(Equal(expectedRoles))
+			}
+		},
+			Entry("exact match", map[string]interface{}{
+				"groups": []interface{}{"5e9d6a3c-2b0f-4c8e-9f51-3a7d2c1b0e84"},
+			}, []Role{RoleAdministrator}),
+			Entry("wildcard match", map[string]interface{}{
+				"groups": []interface{}{"/rode/developers/frontend"},
+			}, []Role{RoleApplicationDeveloper}),
+			Entry("multiple values matching the same mapping", map[string]interface{}{
+				"groups": []interface{}{"/rode/a", "/rode/b"},
+			}, []Role{RoleApplicationDeveloper}),
+			Entry("nested claim", map[string]interface{}{
+				"resource_access": map[string]interface{}{
+					"rode": map[string]interface{}{
+						"roles": []interface{}{"policy-authors"},
+					},
+				},
+			}, []Role{RolePolicyDeveloper, RoleEnforcer}),
+			Entry("space delimited claim", map[string]interface{}{
+				"scope": "openid email rode:collect",
+			}, []Role{RoleCollector}),
+			Entry("multiple claims", map[string]interface{}{
+				"groups": []interface{}{"/rode/devs"},
+				"scope":  "rode:collect",
+			}, []Role{RoleApplicationDeveloper, RoleCollector}),
+			Entry("no matching values", map[string]interface{}{
+				"groups": []interface{}{"/other/devs"},
+				"scope":  "openid",
+			}, nil),
+			Entry("non-string values", map[string]interface{}{
+				"groups": []interface{}{1.0, true},
+			}, nil),
+			Entry("missing claims", map[string]interface{}{}, nil),
+		)
+	})
+
+	Context("ParseRoleMappings", func() {
+		It("should accept JSON", func() {
+			mapper, err := ParseRoleMappings([]byte(`{"mappings": [{"claim": "groups", "value": "admins", "roles": ["Administrator"]}]}`), registry)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mapper.MapRoles(map[string]interface{}{
+				"groups": []interface{}{"admins"},
+			})).To(Equal([]Role{RoleAdministrator}))
+		})
+
+		DescribeTable("invalid mappings", func(mappings string) {
+			mapper, err := ParseRoleMappings([]byte(mappings), registry)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mapper).To(BeNil())
+		},
+			Entry("malformed", `mappings: [`),
+			Entry("no mappings", `mappings: []`),
+			Entry("missing claim", `{"mappings": [{"value": "admins", "roles": ["Administrator"]}]}`),
+			Entry("missing value", `{"mappings": [{"claim": "groups", "roles": ["Administrator"]}]}`),
+			Entry("no roles", `{"mappings": [{"claim": "groups", "value": "admins", "roles": []}]}`),
+			Entry("unknown role", `{"mappings": [{"claim": "groups", "value": "admins", "roles": ["Admin"]}]}`),
+			Entry("invalid pattern", `{"mappings": [{"claim": "groups", "value": "admins[", "roles": ["Administrator"]}]}`),
+		)
+	})
+
+	Context("NewRoleMapperFromFile", func() {
+		var (
+			dir  string
+			path string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "role-mappings")
+			Expect(err).NotTo(HaveOccurred())
+
+			path = filepath.Join(dir, "role-mappings.yaml")
+			Expect(ioutil.WriteFile(path, []byte("mappings:\n  - claim: groups\n    value: admins\n    roles: [Administrator]\n"), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should load the mappings", func() {
+			mapper, err := NewRoleMapperFromFile(path, registry)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mapper.MapRoles(map[string]interface{}{
+				"groups": []interface{}{"admins"},
+			})).To(Equal([]Role{RoleAdministrator}))
+		})
+
+		It("should return an error when the file does not exist", func() {
+			mapper, err := NewRoleMapperFromFile(path+fake.LetterN(5), registry)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mapper).To(BeNil())
+		})
+	})
+})
@@ -13,6 +13,19 @@
 // limitations under the License.
 package auth
 
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"github.com/scylladb/go-set/strset"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
 type Permission string
 type Role string
 
@@ -25,6 +38,7 @@ var (
 	RolePolicyAdministrator  Role = "PolicyAdministrator"
 	RoleAdministrator        Role = "Administrator"
 
+	PermissionAuditRead              Permission = "rode.audit.read"
 	PermissionCollectorRegister      Permission = "rode.collector.register"
 	PermissionEvaluationResultRead   Permission = "rode.evaluationResult.read"
 	PermissionOccurrenceRead         Permission = "rode.occurrence.read"
@@ -43,6 +57,12 @@ var (
 	PermissionResourceEvaluate       Permission = "rode.resource.evaluate"
 	PermissionResourceRead           Permission = "rode.resource.read"
 	PermissionNoteWrite              Permission = "rode.note.write"
+	PermissionWaiverDelete           Permission = "rode.waiver.delete"
+	PermissionWaiverRead             Permission = "rode.waiver.read"
+	PermissionWaiverWrite            Permission = "rode.waiver.write"
+	PermissionWebhookDelete          Permission = "rode.webhook.delete"
+	PermissionWebhookRead            Permission = "rode.webhook.read"
+	PermissionWebhookWrite           Permission = "rode.webhook.write"
 )
 
 type RoleRegistry interface {
@@ -64,6 +84,7 @@ func NewRoleRegistry() RoleRegistry {
 				PermissionPolicyGroupRead,
 				PermissionPolicyRead,
 				PermissionResourceRead,
+				PermissionWaiverRead,
 			},
 			RoleEnforcer: {
 				PermissionEvaluationResultRead,
@@ -86,6 +107,7 @@ func NewRoleRegistry() RoleRegistry {
 				PermissionPolicyRead,
 				PermissionPolicyValidate,
 				PermissionResourceRead,
+				PermissionWaiverRead,
 			},
 			RolePolicyDeveloper: {
 				PermissionEvaluationResultRead,
@@ -98,6 +120,7 @@ func NewRoleRegistry() RoleRegistry {
 				PermissionPolicyWrite,
 				PermissionResourceEvaluate,
 				PermissionResourceRead,
+				PermissionWaiverRead,
 			},
 			RolePolicyAdministrator: {
 				PermissionEvaluationResultRead,
@@ -115,8 +138,15 @@ func NewRoleRegistry() RoleRegistry {
 				PermissionPolicyWrite,
 				PermissionResourceEvaluate,
 				PermissionResourceRead,
+				PermissionWaiverDelete,
+				PermissionWaiverRead,
+				PermissionWaiverWrite,
+				PermissionWebhookDelete,
+				PermissionWebhookRead,
+				PermissionWebhookWrite,
 			},
 			RoleAdministrator: {
+				PermissionAuditRead,
 				PermissionCollectorRegister,
 				PermissionEvaluationResultRead,
 				PermissionNoteWrite,
@@ -135,11 +165,101 @@ func NewRoleRegistry() RoleRegistry {
 				PermissionPolicyWrite,
 				PermissionResourceEvaluate,
 				PermissionResourceRead,
+				PermissionWaiverDelete,
+				PermissionWaiverRead,
+				PermissionWaiverWrite,
+				PermissionWebhookDelete,
+				PermissionWebhookRead,
+				PermissionWebhookWrite,
 			},
 		},
 	}
 }
 
+// roleRegistryFile is the format of a custom role registry. For example:
+//
+//	roles:
+//	  SecurityAuditor:
+//	    - rode.evaluationResult.read
+//	    - rode.occurrence.read
+type roleRegistryFile struct {
+	Roles map[string][]string `json:"roles"`
+}
+
+// NewRoleRegistryFromFile reads a YAML or JSON role registry from path. The roles in the file are merged over the
+// built-in roles: a role with the same name as a built-in role replaces its permissions, any other role is added.
+func NewRoleRegistryFromFile(path string) (RoleRegistry, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading role registry: %v", err)
+	}
+
+	return ParseRoleRegistry(data)
+}
+
+// ParseRoleRegistry parses a YAML or JSON role registry and merges it over the built-in roles. The built-in roles are
+// always present because basic auth relies on Administrator and unauthenticated callers on Anonymous; to restrict
+// one of them, redefine it with fewer permissions. Every permission must be required by at least one RPC, so that a
+// misspelled permission is caught at startup instead of silently granting nothing.
+func ParseRoleRegistry(data []byte) (RoleRegistry, error) {
+	var file roleRegistryFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing role registry: %v", err)
+	}
+
+	if len(file.Roles) == 0 {
+		return nil, errors.New("role registry must define at least one role")
+	}
+
+	knownPermissions := declaredPermissions()
+	registry := map[Role][]Permission{}
+	for role, permissions := range NewRoleRegistry().(*roleRegistry).registry {
+		registry[role] = permissions
+	}
+
+	for roleName, permissions := range file.Roles {
+		if roleName == "" {
+			return nil, errors.New("role names must not be empty")
+		}
+
+		registry[Role(roleName)] = []Permission{}
+		rolePermissions := strset.New()
+		for _, permission := range permissions {
+			if !knownPermissions.Has(permission) {
+				return nil, fmt.Errorf("role %s has unknown permission %s", roleName, permission)
+			}
+
+			if rolePermissions.Has(permission) {
+				continue
+			}
+
+			rolePermissions.Add(permission)
+			registry[Role(roleName)] = append(registry[Role(roleName)], Permission(permission))
+		}
+	}
+
+	return &roleRegistry{registry: registry}, nil
+}
+
+// declaredPermissions returns every permission listed in a rode.v1alpha1.authorization method option
+func declaredPermissions() *strset.Set {
+	permissions := strset.New()
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			methods := fd.Services().Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				if authz, ok := proto.GetExtension(methods.Get(j).Options(), pb.E_Authorization).(*pb.Authorization); ok && authz != nil {
+					permissions.Add(authz.Permissions...)
+				}
+			}
+		}
+
+		return true
+	})
+
+	return permissions
+}
+
 func (r *roleRegistry) GetRoleByName(roleName string) Role {
 	role := Role(roleName)
 
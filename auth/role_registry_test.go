@@ -14,6 +14,10 @@
 package auth
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -72,7 +76,7 @@ var _ = Describe("RoleRegistry", func() {
 
 		When("the Administrator role is requested", func() {
 			It("should return all roles", func() {
-				Expect(registry.GetRolePermissions(RoleAdministrator)).To(HaveLen(18))
+				Expect(registry.GetRolePermissions(RoleAdministrator)).To(HaveLen(25))
 			})
 		})
 
@@ -86,6 +90,158 @@ var _ = Describe("RoleRegistry", func() {
 			})
 		})
 	})
+
+	Context("built-in roles", func() {
+		It("should only grant permissions that are required by an RPC", func() {
+			knownPermissions := declaredPermissions()
+
+			for _, permission := range registry.GetRolePermissions(RoleAdministrator) {
+				Expect(knownPermissions.Has(string(permission))).To(BeTrue(), "unknown permission %s", permission)
+			}
+		})
+	})
+
+	Context("ParseRoleRegistry", func() {
+		var (
+			data []byte
+
+			actualRegistry RoleRegistry
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			data = []byte(`
+roles:
+  SecurityAuditor:
+    - rode.evaluationResult.read
+    - rode.occurrence.read
+  Administrator:
+    - rode.policy.write
+`)
+		})
+
+		JustBeforeEach(func() {
+			actualRegistry, actualError = ParseRoleRegistry(data)
+		})
+
+		It("should load the roles from YAML", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualRegistry.GetRoleByName("SecurityAuditor")).To(Equal(Role("SecurityAuditor")))
+			Expect(actualRegistry.GetRolePermissions("SecurityAuditor")).To(ConsistOf(
+				PermissionEvaluationResultRead,
+				PermissionOccurrenceRead,
+			))
+		})
+
+		It("should replace the permissions of built-in roles that the file redefines", func() {
+			Expect(actualRegistry.GetRolePermissions(RoleAdministrator)).To(ConsistOf(PermissionPolicyWrite))
+		})
+
+		It("should keep the built-in roles that the file does not define", func() {
+			builtIn := NewRoleRegistry()
+
+			Expect(actualRegistry.GetRoleByName(string(RoleEnforcer))).To(Equal(RoleEnforcer))
+			Expect(actualRegistry.GetRolePermissions(RoleEnforcer)).To(ConsistOf(builtIn.GetRolePermissions(RoleEnforcer)))
+			Expect(actualRegistry.GetRolePermissions(RoleAnonymous)).To(ConsistOf(builtIn.GetRolePermissions(RoleAnonymous)))
+		})
+
+		When("the file does not define the roles used by basic auth or anonymous callers", func() {
+			BeforeEach(func() {
+				data = []byte(`{"roles": {"SecurityAuditor": ["rode.occurrence.read"]}}`)
+			})
+
+			It("should keep the built-in Administrator and Anonymous roles", func() {
+				builtIn := NewRoleRegistry()
+
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualRegistry.GetRolePermissions(RoleAdministrator)).To(ConsistOf(builtIn.GetRolePermissions(RoleAdministrator)))
+				Expect(actualRegistry.GetRolePermissions(RoleAnonymous)).To(ConsistOf(builtIn.GetRolePermissions(RoleAnonymous)))
+			})
+		})
+
+		It("should not modify the built-in registry", func() {
+			Expect(NewRoleRegistry().GetRolePermissions(RoleAdministrator)).NotTo(ConsistOf(PermissionPolicyWrite))
+		})
+
+		When("the registry is JSON", func() {
+			BeforeEach(func() {
+				data = []byte(`{"roles": {"SecurityAuditor": ["rode.occurrence.read"]}}`)
+			})
+
+			It("should load the roles", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualRegistry.GetRolePermissions("SecurityAuditor")).To(ConsistOf(PermissionOccurrenceRead))
+			})
+		})
+
+		When("a role lists the same permission more than once", func() {
+			BeforeEach(func() {
+				data = []byte(`{"roles": {"SecurityAuditor": ["rode.occurrence.read", "rode.occurrence.read"]}}`)
+			})
+
+			It("should only include the permission once", func() {
+				Expect(actualRegistry.GetRolePermissions("SecurityAuditor")).To(HaveLen(1))
+			})
+		})
+
+		When("a role has no permissions", func() {
+			BeforeEach(func() {
+				data = []byte(`{"roles": {"Anonymous": [], "Administrator": ["rode.policy.read"]}}`)
+			})
+
+			It("should define the role without any permissions", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualRegistry.GetRoleByName(string(RoleAnonymous))).To(Equal(RoleAnonymous))
+				Expect(actualRegistry.GetRolePermissions(RoleAnonymous)).To(BeEmpty())
+			})
+		})
+
+		DescribeTable("invalid registries", func(registry string) {
+			actualRegistry, actualError = ParseRoleRegistry([]byte(registry))
+
+			Expect(actualError).To(HaveOccurred())
+			Expect(actualRegistry).To(BeNil())
+		},
+			Entry("malformed", `roles: [`),
+			Entry("no roles", `roles: {}`),
+			Entry("empty role name", `{"roles": {"": ["rode.policy.read"]}}`),
+			Entry("unknown permission", `{"roles": {"SecurityAuditor": ["rode.occurrence.reed"]}}`),
+		)
+	})
+
+	Context("NewRoleRegistryFromFile", func() {
+		var (
+			dir  string
+			path string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "roles")
+			Expect(err).NotTo(HaveOccurred())
+
+			path = filepath.Join(dir, "roles.yaml")
+			Expect(ioutil.WriteFile(path, []byte("roles:\n  SecurityAuditor:\n    - rode.occurrence.read\n"), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should load the registry", func() {
+			registry, err := NewRoleRegistryFromFile(path)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.GetRolePermissions("SecurityAuditor")).To(ConsistOf(PermissionOccurrenceRead))
+		})
+
+		It("should return an error when the file does not exist", func() {
+			registry, err := NewRoleRegistryFromFile(path + fake.LetterN(5))
+
+			Expect(err).To(HaveOccurred())
+			Expect(registry).To(BeNil())
+		})
+	})
 })
 
 func createPermissionSet(rolePermissions []Permission) *strset.Set {
@@ -0,0 +1,110 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/rode/rode/pkg/signing"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+// VerifyResourceEvaluationResult checks that a resource evaluation was signed by the key returned from the
+// GetEvaluationPublicKey RPC, so that enforcers can trust a result without contacting Rode again.
+// The resource evaluation must be signed, and each policy evaluation must match one of the digests that the signature
+// covers. Policy evaluations that carry their own signature are verified as well.
+func VerifyResourceEvaluationResult(result *pb.ResourceEvaluationResult, publicKeyPem []byte) error {
+	if result == nil || result.ResourceEvaluation == nil {
+		return errors.New("resource evaluation is required")
+	}
+
+	publicKey, err := signing.ParsePublicKey(publicKeyPem)
+	if err != nil {
+		return err
+	}
+
+	keyId, err := signing.KeyId(publicKey)
+	if err != nil {
+		return err
+	}
+
+	resourceEvaluation := result.ResourceEvaluation
+	err = verifyEvaluationSignature(keyId, publicKey, resourceEvaluation.Signature, func(payloadVersion string) ([]byte, error) {
+		return signing.ResourceEvaluationPayload(resourceEvaluation, payloadVersion)
+	})
+	if err != nil {
+		return fmt.Errorf("resource evaluation %s: %v", resourceEvaluation.Id, err)
+	}
+
+	signedDigests := map[string]string{}
+	for _, digest := range resourceEvaluation.PolicyEvaluationDigests {
+		signedDigests[digest.PolicyEvaluationId] = digest.PayloadDigest
+	}
+
+	for _, policyEvaluation := range result.PolicyEvaluations {
+		if policyEvaluation.ResourceEvaluationId != resourceEvaluation.Id {
+			return fmt.Errorf("policy evaluation %s does not belong to resource evaluation %s", policyEvaluation.Id, resourceEvaluation.Id)
+		}
+
+		signedDigest, ok := signedDigests[policyEvaluation.Id]
+		if !ok {
+			return fmt.Errorf("policy evaluation %s is not covered by the signature of resource evaluation %s", policyEvaluation.Id, resourceEvaluation.Id)
+		}
+
+		digest, err := signing.PolicyEvaluationDigest(policyEvaluation, resourceEvaluation.Signature.PayloadVersion)
+		if err != nil {
+			return fmt.Errorf("policy evaluation %s: error encoding evaluation: %v", policyEvaluation.Id, err)
+		}
+
+		if digest != signedDigest {
+			return fmt.Errorf("policy evaluation %s does not match the signed digest", policyEvaluation.Id)
+		}
+
+		if policyEvaluation.Signature == nil {
+			continue
+		}
+
+		policyEvaluation := policyEvaluation
+		err = verifyEvaluationSignature(keyId, publicKey, policyEvaluation.Signature, func(payloadVersion string) ([]byte, error) {
+			return signing.PolicyEvaluationPayload(policyEvaluation, payloadVersion)
+		})
+		if err != nil {
+			return fmt.Errorf("policy evaluation %s: %v", policyEvaluation.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// verifyEvaluationSignature checks the signature against the payload built in the format that was signed, so that
+// evaluations signed by an older version of Rode can still be verified
+func verifyEvaluationSignature(keyId string, publicKey crypto.PublicKey, signature *pb.EvaluationSignature, buildPayload func(payloadVersion string) ([]byte, error)) error {
+	if signature == nil {
+		return errors.New("evaluation is not signed")
+	}
+
+	if signature.KeyId != keyId {
+		return fmt.Errorf("evaluation was signed by key %s, not %s", signature.KeyId, keyId)
+	}
+
+	payload, err := buildPayload(signature.PayloadVersion)
+	if err != nil {
+		return fmt.Errorf("error encoding evaluation: %v", err)
+	}
+
+	return signing.Verify(publicKey, payload, signature.Signature)
+}
@@ -0,0 +1,254 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/pkg/signing"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("VerifyResourceEvaluationResult", func() {
+	var (
+		signer       signing.Signer
+		publicKeyPem []byte
+		result       *pb.ResourceEvaluationResult
+
+		actualError error
+	)
+
+	newSigner := func() signing.Signer {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		s, err := signing.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		Expect(err).NotTo(HaveOccurred())
+
+		return s
+	}
+
+	sign := func(s signing.Signer, payload []byte) *pb.EvaluationSignature {
+		signature, err := s.Sign(payload)
+		Expect(err).NotTo(HaveOccurred())
+
+		return &pb.EvaluationSignature{
+			KeyId:          s.KeyId(),
+			Signature:      signature,
+			PayloadVersion: signing.PayloadVersion,
+		}
+	}
+
+	BeforeEach(func() {
+		signer = newSigner()
+
+		var err error
+		publicKeyPem, err = signing.MarshalPublicKey(signer.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+
+		resourceEvaluationId := fake.UUID()
+		result = &pb.ResourceEvaluationResult{
+			ResourceEvaluation: &pb.ResourceEvaluation{
+				Id:          resourceEvaluationId,
+				Pass:        false,
+				PolicyGroup: fake.LetterN(10),
+				Created:     timestamppb.Now(),
+				ResourceVersion: &pb.ResourceVersion{
+					Version: fake.URL(),
+				},
+			},
+			PolicyEvaluations: []*pb.PolicyEvaluation{
+				{
+					Id:                   fake.UUID(),
+					ResourceEvaluationId: resourceEvaluationId,
+					PolicyVersionId:      fake.UUID() + ".1",
+					Pass:                 false,
+				},
+			},
+		}
+
+		digest, err := signing.PolicyEvaluationDigest(result.PolicyEvaluations[0], signing.PayloadVersion)
+		Expect(err).NotTo(HaveOccurred())
+		result.ResourceEvaluation.PolicyEvaluationDigests = []*pb.PolicyEvaluationDigest{
+			{
+				PolicyEvaluationId: result.PolicyEvaluations[0].Id,
+				PayloadDigest:      digest,
+			},
+		}
+
+		payload, err := signing.ResourceEvaluationPayload(result.ResourceEvaluation, signing.PayloadVersion)
+		Expect(err).NotTo(HaveOccurred())
+		result.ResourceEvaluation.Signature = sign(signer, payload)
+
+		payload, err = signing.PolicyEvaluationPayload(result.PolicyEvaluations[0], signing.PayloadVersion)
+		Expect(err).NotTo(HaveOccurred())
+		result.PolicyEvaluations[0].Signature = sign(signer, payload)
+	})
+
+	JustBeforeEach(func() {
+		actualError = VerifyResourceEvaluationResult(result, publicKeyPem)
+	})
+
+	It("should verify the signatures", func() {
+		Expect(actualError).NotTo(HaveOccurred())
+	})
+
+	When("the resource evaluation was modified", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation.Pass = true
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("invalid signature")))
+		})
+	})
+
+	When("a policy evaluation was modified", func() {
+		BeforeEach(func() {
+			result.PolicyEvaluations[0].Pass = true
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring(result.PolicyEvaluations[0].Id)))
+		})
+	})
+
+	When("fields outside of the signed payload were added", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation.ViolationCounts = map[string]int32{pb.ViolationSeverity_HIGH.String(): 1}
+			result.ResourceEvaluation.SkippedPolicies = []*pb.SkippedPolicy{{PolicyVersionId: fake.UUID()}}
+		})
+
+		It("should verify the signatures", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+		})
+	})
+
+	When("the payload version isn't supported", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation.Signature.PayloadVersion = fake.LetterN(5)
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("unsupported payload version")))
+		})
+	})
+
+	When("the policy evaluations are unsigned", func() {
+		BeforeEach(func() {
+			result.PolicyEvaluations[0].Signature = nil
+		})
+
+		It("should verify them against the resource evaluation", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+		})
+
+		When("an unsigned policy evaluation was modified", func() {
+			BeforeEach(func() {
+				result.PolicyEvaluations[0].Pass = true
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(MatchError(ContainSubstring("does not match the signed digest")))
+			})
+		})
+	})
+
+	When("a policy evaluation isn't covered by the resource evaluation's signature", func() {
+		BeforeEach(func() {
+			result.PolicyEvaluations = append(result.PolicyEvaluations, &pb.PolicyEvaluation{
+				Id:                   fake.UUID(),
+				ResourceEvaluationId: result.ResourceEvaluation.Id,
+				PolicyVersionId:      fake.UUID() + ".1",
+				Pass:                 true,
+			})
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("not covered")))
+		})
+	})
+
+	When("the signed policy evaluation digests were modified", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation.PolicyEvaluationDigests[0].PayloadDigest = fake.LetterN(64)
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("invalid signature")))
+		})
+	})
+
+	When("a policy evaluation belongs to a different resource evaluation", func() {
+		BeforeEach(func() {
+			result.PolicyEvaluations[0].ResourceEvaluationId = fake.UUID()
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("does not belong")))
+		})
+	})
+
+	When("the resource evaluation is unsigned", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation.Signature = nil
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("not signed")))
+		})
+	})
+
+	When("the result was signed by a different key", func() {
+		BeforeEach(func() {
+			var err error
+			publicKeyPem, err = signing.MarshalPublicKey(newSigner().PublicKey())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(MatchError(ContainSubstring("signed by key")))
+		})
+	})
+
+	When("the public key is invalid", func() {
+		BeforeEach(func() {
+			publicKeyPem = []byte(fake.LetterN(10))
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(HaveOccurred())
+		})
+	})
+
+	When("the resource evaluation is missing", func() {
+		BeforeEach(func() {
+			result.ResourceEvaluation = nil
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(HaveOccurred())
+		})
+	})
+})
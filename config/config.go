@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/coreos/go-oidc"
@@ -30,24 +31,58 @@ import (
 type Config struct {
 	Auth          *AuthConfig
 	Elasticsearch *ElasticsearchConfig
+	Evaluation    *EvaluationConfig
+	Events        *EventsConfig
 	Grafeas       *GrafeasConfig
 	Opa           *OpaConfig
+	Webhook       *WebhookConfig
 	Port          int
 	Debug         bool
 }
 
 type GrafeasConfig struct {
-	Host string
+	Host                string
+	MaxBuildOccurrences int
 }
 
 type OpaConfig struct {
-	Host string
+	Host              string
+	Embedded          bool
+	EmbeddedCacheSize int
+}
+
+type EvaluationConfig struct {
+	Workers               int
+	QueueSize             int
+	PolicyConcurrency     int
+	ResourceConcurrency   int
+	MaxOccurrences        int
+	SigningKeyFile        string
+	SignPolicyEvaluations bool
+	AutoEvaluateDebounce  time.Duration
+	ReevaluateWindow      time.Duration
+}
+
+type WebhookConfig struct {
+	Workers        int
+	QueueSize      int
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	Timeout        time.Duration
+}
+
+type EventsConfig struct {
+	SinkUrl   string
+	Source    string
+	QueueSize int
+	Timeout   time.Duration
 }
 
 type AuthConfig struct {
-	Enabled bool
-	Basic   *BasicAuthConfig
-	OIDC    *OIDCAuthConfig
+	Enabled          bool
+	Basic            *BasicAuthConfig
+	OIDC             *OIDCAuthConfig
+	RoleRegistryFile string
 }
 
 type BasicAuthConfig struct {
@@ -59,6 +94,7 @@ type OIDCAuthConfig struct {
 	Issuer                string
 	RequiredAudience      string
 	RoleClaimPath         string
+	RoleMappingFile       string
 	TlsInsecureSkipVerify bool
 	Verifier              *oidc.IDTokenVerifier
 }
@@ -105,8 +141,11 @@ func Build(name string, args []string) (*Config, error) {
 			OIDC:  &OIDCAuthConfig{},
 		},
 		Elasticsearch: &ElasticsearchConfig{},
+		Evaluation:    &EvaluationConfig{},
+		Events:        &EventsConfig{},
 		Grafeas:       &GrafeasConfig{},
 		Opa:           &OpaConfig{},
+		Webhook:       &WebhookConfig{},
 	}
 
 	flags.StringVar(&conf.Auth.Basic.Username, "basic-auth-username", "", "when set, basic auth will be enabled for all endpoints, using the provided username. --basic-auth-password must also be set")
@@ -114,12 +153,17 @@ func Build(name string, args []string) (*Config, error) {
 	flags.StringVar(&conf.Auth.OIDC.Issuer, "oidc-issuer", "", "when set, OIDC based auth will be enabled for all endpoints. the provided issuer will be used to fetch the discovery document in order to validate received JWTs")
 	flags.StringVar(&conf.Auth.OIDC.RequiredAudience, "oidc-required-audience", "", "when set, if OIDC based auth is enabled, this audience must be specified within the `aud` claim of any received JWTs")
 	flags.StringVar(&conf.Auth.OIDC.RoleClaimPath, "oidc-role-claim-path", "roles", "name of the claim containing user roles. a nested claim can be used by adding periods between the key names")
+	flags.StringVar(&conf.Auth.OIDC.RoleMappingFile, "oidc-role-mapping-file", "", "path to a YAML or JSON file that maps claim values, like identity provider groups, to roles")
 	flags.BoolVar(&conf.Auth.OIDC.TlsInsecureSkipVerify, "oidc-tls-insecure-skip-verify", false, "disables TLS certificate verification. intended for testing only")
+	flags.StringVar(&conf.Auth.RoleRegistryFile, "role-registry-file", "", "path to a YAML or JSON file defining the roles and their permissions. the roles are merged over the built-in roles")
 
 	flags.IntVar(&conf.Port, "port", 50051, "the port that the rode gRPC/HTTP API server should listen on")
 	flags.BoolVar(&conf.Debug, "debug", false, "when set, debug mode will be enabled")
 	flags.StringVar(&conf.Grafeas.Host, "grafeas-host", "localhost:8080", "the host to use to connect to grafeas")
+	flags.IntVar(&conf.Grafeas.MaxBuildOccurrences, "grafeas-max-build-occurrences", 1000, "the maximum number of build occurrences used to find the resources related to a resource version. listing the occurrences of a resource version with more fails")
 	flags.StringVar(&conf.Opa.Host, "opa-host", "http://localhost:8181", "the host to use to connect to Open Policy Agent")
+	flags.BoolVar(&conf.Opa.Embedded, "opa-embedded", false, "when set, policies will be compiled and evaluated in-process instead of by the Open Policy Agent instance at --opa-host")
+	flags.IntVar(&conf.Opa.EmbeddedCacheSize, "opa-embedded-cache-size", 500, "the maximum number of compiled policy versions to keep in memory when --opa-embedded is set")
 
 	flags.StringVar(&conf.Elasticsearch.Host, "elasticsearch-host", "http://elasticsearch-master:9200", "the Elasticsearch endpoint used by Grafeas")
 	flags.StringVar(&conf.Elasticsearch.Username, "elasticsearch-username", "", "username for the Grafeas Elasticsearch instance")
@@ -127,6 +171,27 @@ func Build(name string, args []string) (*Config, error) {
 	var elasticsearchRefresh string
 	flags.StringVar(&elasticsearchRefresh, "elasticsearch-refresh", "true", "refresh controls when changes made by a request are made visible to search. Options are \"true\", \"false\", \"wait_for\"")
 
+	flags.IntVar(&conf.Evaluation.Workers, "evaluation-workers", 4, "the number of asynchronous resource evaluations that can run at the same time")
+	flags.IntVar(&conf.Evaluation.QueueSize, "evaluation-queue-size", 100, "the number of asynchronous resource evaluations that can be waiting for a worker. requests beyond this limit are rejected, while re-evaluations and automatic evaluations wait for room")
+	flags.IntVar(&conf.Evaluation.PolicyConcurrency, "evaluation-policy-concurrency", 10, "the number of policies in a policy group that can be evaluated at the same time for a single resource evaluation")
+	flags.IntVar(&conf.Evaluation.ResourceConcurrency, "evaluation-resource-concurrency", 5, "the number of resources that can be evaluated at the same time when several resources are evaluated in one request")
+	flags.IntVar(&conf.Evaluation.MaxOccurrences, "evaluation-max-occurrences", 10000, "the maximum number of occurrences that a resource can have. evaluations of resources with more occurrences fail instead of using a partial set")
+	flags.StringVar(&conf.Evaluation.SigningKeyFile, "evaluation-signing-key-file", "", "path to a PEM encoded ed25519 or ECDSA private key used to sign resource evaluations and attestations")
+	flags.BoolVar(&conf.Evaluation.SignPolicyEvaluations, "evaluation-sign-policy-evaluations", false, "when a signing key is configured, sign each policy evaluation in addition to the resource evaluation")
+	flags.DurationVar(&conf.Evaluation.ReevaluateWindow, "evaluation-reevaluate-window", 0, "when set, resource versions evaluated within this window are re-evaluated in the background after a policy assignment in their policy group changes")
+	flags.DurationVar(&conf.Evaluation.AutoEvaluateDebounce, "evaluation-auto-evaluate-debounce", 10*time.Second, "how long to wait for more occurrences before a resource version is evaluated against the policy groups with auto-evaluate enabled")
+
+	flags.IntVar(&conf.Webhook.Workers, "webhook-workers", 2, "the number of webhook events that can be delivered at the same time")
+	flags.IntVar(&conf.Webhook.QueueSize, "webhook-queue-size", 100, "the number of webhook events that can be waiting for a worker. events beyond this limit are dropped")
+	flags.IntVar(&conf.Webhook.MaxAttempts, "webhook-max-attempts", 5, "the number of times delivery of a webhook event is attempted before it's recorded as failed")
+	flags.DurationVar(&conf.Webhook.InitialBackoff, "webhook-initial-backoff", time.Second, "how long to wait before retrying a failed webhook delivery. the wait doubles after each attempt")
+	flags.DurationVar(&conf.Webhook.Timeout, "webhook-timeout", 10*time.Second, "the timeout for a single webhook delivery attempt")
+
+	flags.StringVar(&conf.Events.SinkUrl, "events-sink-url", "", "when set, CloudEvents are sent to this url in structured JSON mode whenever occurrences, policies, policy groups, policy assignments, or resource evaluations change")
+	flags.StringVar(&conf.Events.Source, "events-source", "rode", "the source attribute of emitted CloudEvents")
+	flags.IntVar(&conf.Events.QueueSize, "events-queue-size", 100, "the number of CloudEvents that can be waiting to be sent. events beyond this limit are dropped")
+	flags.DurationVar(&conf.Events.Timeout, "events-timeout", 10*time.Second, "the timeout for sending a single CloudEvent")
+
 	err := ff.Parse(flags, args, ff.WithEnvVarNoPrefix())
 	if err != nil {
 		return nil, err
@@ -144,6 +209,81 @@ func Build(name string, args []string) (*Config, error) {
 		return nil, errors.New("if Elasticsearch auth is configured, both --elasticsearch-username and --elasticsearch-password must be set")
 	}
 
+	if conf.Grafeas.MaxBuildOccurrences < 1 {
+		return nil, errors.New("--grafeas-max-build-occurrences must be at least 1")
+	}
+
+	if conf.Evaluation.Workers < 1 {
+		return nil, errors.New("--evaluation-workers must be at least 1")
+	}
+
+	if conf.Evaluation.QueueSize < 0 {
+		return nil, errors.New("--evaluation-queue-size cannot be negative")
+	}
+
+	if conf.Evaluation.PolicyConcurrency < 1 {
+		return nil, errors.New("--evaluation-policy-concurrency must be at least 1")
+	}
+
+	if conf.Evaluation.ResourceConcurrency < 1 {
+		return nil, errors.New("--evaluation-resource-concurrency must be at least 1")
+	}
+
+	if conf.Evaluation.MaxOccurrences < 1 {
+		return nil, errors.New("--evaluation-max-occurrences must be at least 1")
+	}
+
+	if conf.Evaluation.AutoEvaluateDebounce < 0 {
+		return nil, errors.New("--evaluation-auto-evaluate-debounce cannot be negative")
+	}
+
+	if conf.Evaluation.ReevaluateWindow < 0 {
+		return nil, errors.New("--evaluation-reevaluate-window cannot be negative")
+	}
+
+	if conf.Webhook.Workers < 1 {
+		return nil, errors.New("--webhook-workers must be at least 1")
+	}
+
+	if conf.Webhook.QueueSize < 0 {
+		return nil, errors.New("--webhook-queue-size cannot be negative")
+	}
+
+	if conf.Webhook.MaxAttempts < 1 {
+		return nil, errors.New("--webhook-max-attempts must be at least 1")
+	}
+
+	if conf.Webhook.InitialBackoff < 0 {
+		return nil, errors.New("--webhook-initial-backoff cannot be negative")
+	}
+
+	if conf.Webhook.Timeout <= 0 {
+		return nil, errors.New("--webhook-timeout must be greater than 0")
+	}
+
+	if conf.Events.SinkUrl != "" {
+		sinkUrl, err := url.Parse(conf.Events.SinkUrl)
+		if err != nil || (sinkUrl.Scheme != "http" && sinkUrl.Scheme != "https") || sinkUrl.Host == "" {
+			return nil, errors.New("--events-sink-url must be an absolute http or https url")
+		}
+	}
+
+	if conf.Events.Source == "" {
+		return nil, errors.New("--events-source cannot be empty")
+	}
+
+	if conf.Events.QueueSize < 0 {
+		return nil, errors.New("--events-queue-size cannot be negative")
+	}
+
+	if conf.Events.Timeout <= 0 {
+		return nil, errors.New("--events-timeout must be greater than 0")
+	}
+
+	if conf.Opa.EmbeddedCacheSize <= 0 {
+		return nil, errors.New("--opa-embedded-cache-size must be greater than 0")
+	}
+
 	if conf.Auth.OIDC.Issuer != "" {
 		oidcCtx := context.Background()
 
@@ -174,6 +314,8 @@ func Build(name string, args []string) (*Config, error) {
 		conf.Auth.OIDC.Verifier = provider.Verifier(oidcConfig)
 	} else if conf.Auth.OIDC.RequiredAudience != "" {
 		return nil, errors.New("the --oidc-required-audience flag cannot be specified without --oidc-issuer")
+	} else if conf.Auth.OIDC.RoleMappingFile != "" {
+		return nil, errors.New("the --oidc-role-mapping-file flag cannot be specified without --oidc-issuer")
 	}
 
 	conf.Auth.Enabled = (conf.Auth.Basic.Username != "" && conf.Auth.Basic.Password != "") || conf.Auth.OIDC.Issuer != ""
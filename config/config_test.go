@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/coreos/go-oidc"
 	"github.com/jarcoal/httpmock"
@@ -50,11 +51,33 @@ var _ = Describe("Config", func() {
 					Host:    "http://elasticsearch-master:9200",
 					Refresh: "true",
 				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
 				Grafeas: &GrafeasConfig{
-					Host: "localhost:8080",
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
 				},
 				Opa: &OpaConfig{
-					Host: "http://localhost:8181",
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
 				},
 				Port:  50051,
 				Debug: false,
@@ -85,11 +108,33 @@ var _ = Describe("Config", func() {
 					Host:    "http://elasticsearch-master:9200",
 					Refresh: "true",
 				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
 				Grafeas: &GrafeasConfig{
-					Host: "localhost:8080",
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
 				},
 				Opa: &OpaConfig{
-					Host: "http://localhost:8181",
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
 				},
 				Port:  50051,
 				Debug: false,
@@ -103,10 +148,60 @@ var _ = Describe("Config", func() {
 			flags:       []string{"--basic-auth-username=foo"},
 			expectError: true,
 		}),
+		Entry("role registry file", &testCase{
+			flags: []string{"--role-registry-file=/etc/rode/roles.yaml"},
+			expected: &Config{
+				Auth: &AuthConfig{
+					Basic: &BasicAuthConfig{},
+					OIDC: &OIDCAuthConfig{
+						RoleClaimPath: "roles",
+					},
+					RoleRegistryFile: "/etc/rode/roles.yaml",
+				},
+				Elasticsearch: &ElasticsearchConfig{
+					Host:    "http://elasticsearch-master:9200",
+					Refresh: "true",
+				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
+				Grafeas: &GrafeasConfig{
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
+				},
+				Opa: &OpaConfig{
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
+				},
+				Port:  50051,
+				Debug: false,
+			},
+		}),
 		Entry("OIDC required audience without issuer", &testCase{
 			flags:       []string{"--oidc-required-audience=foo"},
 			expectError: true,
 		}),
+		Entry("OIDC role mapping file without issuer", &testCase{
+			flags:       []string{"--oidc-role-mapping-file=/etc/rode/role-mappings.yaml"},
+			expectError: true,
+		}),
 		Entry("OPA host", &testCase{
 			flags: []string{"--opa-host=opa.test.na:8181"},
 			expected: &Config{
@@ -116,20 +211,303 @@ var _ = Describe("Config", func() {
 						RoleClaimPath: "roles",
 					},
 				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
+				Grafeas: &GrafeasConfig{
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
+				},
+				Elasticsearch: &ElasticsearchConfig{
+					Host:    "http://elasticsearch-master:9200",
+					Refresh: "true",
+				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Opa: &OpaConfig{
+					Host:              "opa.test.na:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
+				},
+				Port:  50051,
+				Debug: false,
+			},
+		}),
+		Entry("embedded OPA", &testCase{
+			flags: []string{"--opa-embedded", "--opa-embedded-cache-size=50"},
+			expected: &Config{
+				Auth: &AuthConfig{
+					Basic: &BasicAuthConfig{},
+					OIDC: &OIDCAuthConfig{
+						RoleClaimPath: "roles",
+					},
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
+				Grafeas: &GrafeasConfig{
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
+				},
+				Elasticsearch: &ElasticsearchConfig{
+					Host:    "http://elasticsearch-master:9200",
+					Refresh: "true",
+				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Opa: &OpaConfig{
+					Host:              "http://localhost:8181",
+					Embedded:          true,
+					EmbeddedCacheSize: 50,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
+				},
+				Port:  50051,
+				Debug: false,
+			},
+		}),
+		Entry("no embedded OPA cache", &testCase{
+			flags:       []string{"--opa-embedded-cache-size=0"},
+			expectError: true,
+		}),
+		Entry("evaluation workers", &testCase{
+			flags: []string{"--evaluation-workers=10", "--evaluation-queue-size=0", "--evaluation-policy-concurrency=1", "--evaluation-resource-concurrency=2", "--evaluation-max-occurrences=500", "--evaluation-signing-key-file=/etc/rode/signing-key.pem", "--evaluation-sign-policy-evaluations", "--evaluation-auto-evaluate-debounce=1m", "--evaluation-reevaluate-window=24h", "--grafeas-max-build-occurrences=50"},
+			expected: &Config{
+				Auth: &AuthConfig{
+					Basic: &BasicAuthConfig{},
+					OIDC: &OIDCAuthConfig{
+						RoleClaimPath: "roles",
+					},
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
 				Grafeas: &GrafeasConfig{
-					Host: "localhost:8080",
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 50,
 				},
 				Elasticsearch: &ElasticsearchConfig{
 					Host:    "http://elasticsearch-master:9200",
 					Refresh: "true",
 				},
+				Evaluation: &EvaluationConfig{
+					Workers:               10,
+					QueueSize:             0,
+					PolicyConcurrency:     1,
+					ResourceConcurrency:   2,
+					MaxOccurrences:        500,
+					AutoEvaluateDebounce:  time.Minute,
+					ReevaluateWindow:      24 * time.Hour,
+					SigningKeyFile:        "/etc/rode/signing-key.pem",
+					SignPolicyEvaluations: true,
+				},
 				Opa: &OpaConfig{
-					Host: "opa.test.na:8181",
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
 				},
 				Port:  50051,
 				Debug: false,
 			},
 		}),
+		Entry("no evaluation workers", &testCase{
+			flags:       []string{"--evaluation-workers=0"},
+			expectError: true,
+		}),
+		Entry("negative evaluation queue size", &testCase{
+			flags:       []string{"--evaluation-queue-size=-1"},
+			expectError: true,
+		}),
+		Entry("no policy concurrency", &testCase{
+			flags:       []string{"--evaluation-policy-concurrency=0"},
+			expectError: true,
+		}),
+		Entry("no resource concurrency", &testCase{
+			flags:       []string{"--evaluation-resource-concurrency=0"},
+			expectError: true,
+		}),
+		Entry("no max build occurrences", &testCase{
+			flags:       []string{"--grafeas-max-build-occurrences=0"},
+			expectError: true,
+		}),
+		Entry("no max occurrences", &testCase{
+			flags:       []string{"--evaluation-max-occurrences=0"},
+			expectError: true,
+		}),
+		Entry("negative auto-evaluate debounce", &testCase{
+			flags:       []string{"--evaluation-auto-evaluate-debounce=-1s"},
+			expectError: true,
+		}),
+		Entry("negative re-evaluation window", &testCase{
+			flags:       []string{"--evaluation-reevaluate-window=-1h"},
+			expectError: true,
+		}),
+		Entry("webhooks", &testCase{
+			flags: []string{"--webhook-workers=4", "--webhook-queue-size=0", "--webhook-max-attempts=1", "--webhook-initial-backoff=500ms", "--webhook-timeout=1m"},
+			expected: &Config{
+				Auth: &AuthConfig{
+					Basic: &BasicAuthConfig{},
+					OIDC: &OIDCAuthConfig{
+						RoleClaimPath: "roles",
+					},
+				},
+				Elasticsearch: &ElasticsearchConfig{
+					Host:    "http://elasticsearch-master:9200",
+					Refresh: "true",
+				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Events: &EventsConfig{
+					Source:    "rode",
+					QueueSize: 100,
+					Timeout:   10 * time.Second,
+				},
+				Grafeas: &GrafeasConfig{
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
+				},
+				Opa: &OpaConfig{
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        4,
+					QueueSize:      0,
+					MaxAttempts:    1,
+					InitialBackoff: 500 * time.Millisecond,
+					Timeout:        time.Minute,
+				},
+				Port:  50051,
+				Debug: false,
+			},
+		}),
+		Entry("no webhook workers", &testCase{
+			flags:       []string{"--webhook-workers=0"},
+			expectError: true,
+		}),
+		Entry("negative webhook queue size", &testCase{
+			flags:       []string{"--webhook-queue-size=-1"},
+			expectError: true,
+		}),
+		Entry("no webhook attempts", &testCase{
+			flags:       []string{"--webhook-max-attempts=0"},
+			expectError: true,
+		}),
+		Entry("negative webhook backoff", &testCase{
+			flags:       []string{"--webhook-initial-backoff=-1s"},
+			expectError: true,
+		}),
+		Entry("no webhook timeout", &testCase{
+			flags:       []string{"--webhook-timeout=0s"},
+			expectError: true,
+		}),
+		Entry("events", &testCase{
+			flags: []string{"--events-sink-url=https://events.example.com/rode", "--events-source=https://rode.example.com", "--events-queue-size=0", "--events-timeout=1m"},
+			expected: &Config{
+				Auth: &AuthConfig{
+					Basic: &BasicAuthConfig{},
+					OIDC: &OIDCAuthConfig{
+						RoleClaimPath: "roles",
+					},
+				},
+				Elasticsearch: &ElasticsearchConfig{
+					Host:    "http://elasticsearch-master:9200",
+					Refresh: "true",
+				},
+				Evaluation: &EvaluationConfig{
+					Workers:              4,
+					QueueSize:            100,
+					PolicyConcurrency:    10,
+					ResourceConcurrency:  5,
+					MaxOccurrences:       10000,
+					AutoEvaluateDebounce: 10 * time.Second,
+				},
+				Events: &EventsConfig{
+					SinkUrl:   "https://events.example.com/rode",
+					Source:    "https://rode.example.com",
+					QueueSize: 0,
+					Timeout:   time.Minute,
+				},
+				Grafeas: &GrafeasConfig{
+					Host:                "localhost:8080",
+					MaxBuildOccurrences: 1000,
+				},
+				Opa: &OpaConfig{
+					Host:              "http://localhost:8181",
+					EmbeddedCacheSize: 500,
+				},
+				Webhook: &WebhookConfig{
+					Workers:        2,
+					QueueSize:      100,
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					Timeout:        10 * time.Second,
+				},
+				Port:  50051,
+				Debug: false,
+			},
+		}),
+		Entry("relative events sink url", &testCase{
+			flags:       []string{"--events-sink-url=/events"},
+			expectError: true,
+		}),
+		Entry("unsupported events sink url scheme", &testCase{
+			flags:       []string{"--events-sink-url=ftp://events.example.com"},
+			expectError: true,
+		}),
+		Entry("empty events source", &testCase{
+			flags:       []string{"--events-source="},
+			expectError: true,
+		}),
+		Entry("negative events queue size", &testCase{
+			flags:       []string{"--events-queue-size=-1"},
+			expectError: true,
+		}),
+		Entry("no events timeout", &testCase{
+			flags:       []string{"--events-timeout=0s"},
+			expectError: true,
+		}),
 		Entry("Elasticsearch config missing username", &testCase{
 			flags:       []string{"--elasticsearch-password=bar"},
 			expectError: true,
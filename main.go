@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rode/rode/pkg/evaluation"
+	"github.com/rode/rode/pkg/events"
 	"log"
 	"net"
 	"net/http"
@@ -39,9 +40,13 @@ import (
 	"github.com/rode/rode/auth"
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/opa"
+	"github.com/rode/rode/pkg/audit"
 	"github.com/rode/rode/pkg/grafeas"
 	"github.com/rode/rode/pkg/policy"
 	"github.com/rode/rode/pkg/resource"
+	"github.com/rode/rode/pkg/signing"
+	"github.com/rode/rode/pkg/versioned"
+	"github.com/rode/rode/pkg/webhook"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	grafeas_project_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/project_go_proto"
@@ -74,7 +79,21 @@ func main() {
 	}
 
 	roleRegistry := auth.NewRoleRegistry()
-	authenticator := auth.NewAuthenticator(c.Auth, logger.Named("Authenticator"), roleRegistry)
+	if c.Auth.RoleRegistryFile != "" {
+		roleRegistry, err = auth.NewRoleRegistryFromFile(c.Auth.RoleRegistryFile)
+		if err != nil {
+			logger.Fatal("failed to load role registry", zap.String("path", c.Auth.RoleRegistryFile), zap.Error(err))
+		}
+	}
+	roleMapper := auth.NewRoleMapper()
+	if c.Auth.OIDC.RoleMappingFile != "" {
+		roleMapper, err = auth.NewRoleMapperFromFile(c.Auth.OIDC.RoleMappingFile, roleRegistry)
+		if err != nil {
+			logger.Fatal("failed to load role mappings", zap.String("path", c.Auth.OIDC.RoleMappingFile), zap.Error(err))
+		}
+	}
+
+	authenticator := auth.NewAuthenticator(c.Auth, logger.Named("Authenticator"), roleRegistry, roleMapper)
 	authzInterceptor := auth.NewAuthorizationInterceptor(c.Auth, logger.Named("AuthorizationInterceptor"), roleRegistry)
 	recoveryHandler := grpc_recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 		logger.Error("Panic in gRPC handler", zap.Any("panic", p))
@@ -82,28 +101,16 @@ func main() {
 		return status.Errorf(codes.Internal, "Unexpected error")
 	})
 
-	s := grpc.NewServer(
-		grpc_middleware.WithStreamServerChain(
-			grpc_recovery.StreamServerInterceptor(recoveryHandler),
-			grpc_auth.StreamServerInterceptor(authenticator.Authenticate),
-			grpc_auth.StreamServerInterceptor(authzInterceptor.Authorize),
-		),
-
-		grpc_middleware.WithUnaryServerChain(
-			grpc_recovery.UnaryServerInterceptor(recoveryHandler),
-			grpc_auth.UnaryServerInterceptor(authenticator.Authenticate),
-			grpc_auth.UnaryServerInterceptor(authzInterceptor.Authorize),
-		),
-	)
-	if c.Debug {
-		reflection.Register(s)
-	}
-
 	grafeasClientCommon, grafeasClientProjects, err := createGrafeasClients(c.Grafeas.Host)
 	if err != nil {
 		logger.Fatal("failed to connect to grafeas", zap.String("grafeas host", c.Grafeas.Host), zap.Error(err))
 	}
-	opaClient := opa.NewClient(logger.Named("opa"), c.Opa.Host, c.Debug)
+	var opaClient opa.Client
+	if c.Opa.Embedded {
+		opaClient = opa.NewEmbeddedClient(logger.Named("opa"), c.Debug, c.Opa.EmbeddedCacheSize)
+	} else {
+		opaClient = opa.NewClient(logger.Named("opa"), c.Opa.Host, c.Debug)
+	}
 
 	esClient, err := createESClient(logger, c.Elasticsearch.Host, c.Elasticsearch.Username, c.Elasticsearch.Password)
 	if err != nil {
@@ -118,12 +125,30 @@ func main() {
 
 	filterer := filtering.NewFilterer()
 
-	grafeasExtensions := grafeas.NewExtensions(logger.Named("GrafeasExtensions"), grafeasClientCommon)
+	var signer signing.Signer
+	if c.Evaluation.SigningKeyFile != "" {
+		signer, err = signing.NewSignerFromFile(c.Evaluation.SigningKeyFile)
+		if err != nil {
+			logger.Fatal("failed to load evaluation signing key", zap.Error(err))
+		}
+	}
+
+	grafeasExtensions := grafeas.NewExtensions(logger.Named("GrafeasExtensions"), grafeasClientCommon, c.Grafeas.MaxBuildOccurrences)
 	resourceManager := resource.NewManager(logger.Named("Resource Manager"), esutilClient, c.Elasticsearch, indexManager, filterer)
-	policyManager := policy.NewManager(logger.Named("PolicyManager"), esutilClient, c.Elasticsearch, indexManager, filterer)
-	policyGroupManager := policy.NewPolicyGroupManager(logger.Named("PolicyGroupManager"), esutilClient, c.Elasticsearch, indexManager, filterer)
-	policyAssignmentManager := policy.NewAssignmentManager(logger.Named("PolicyAssignmentManager"), esutilClient, c.Elasticsearch, indexManager, filterer)
-	evaluationManager := evaluation.NewManager(logger.Named("EvaluationManager"), esutilClient, c.Elasticsearch, policyManager, policyGroupManager, policyAssignmentManager, grafeasExtensions, opaClient, resourceManager, indexManager, filterer)
+	eventPublisher := events.NewPublisher(logger.Named("EventPublisher"), c.Events)
+	webhookManager := webhook.NewManager(logger.Named("WebhookManager"), esutilClient, c.Elasticsearch, c.Webhook, indexManager, filterer)
+	policyManager := policy.NewManager(logger.Named("PolicyManager"), esutilClient, c.Elasticsearch, indexManager, filterer, webhookManager, eventPublisher)
+	policyGroupManager := policy.NewPolicyGroupManager(logger.Named("PolicyGroupManager"), esutilClient, versioned.NewClient(esClient), c.Elasticsearch, c.Evaluation, indexManager, filterer, eventPublisher)
+	policyAssignmentManager := policy.NewAssignmentManager(logger.Named("PolicyAssignmentManager"), esutilClient, c.Elasticsearch, indexManager, filterer, webhookManager, eventPublisher)
+	waiverManager := policy.NewWaiverManager(logger.Named("WaiverManager"), esutilClient, c.Elasticsearch, indexManager, filterer)
+	evaluationManager := evaluation.NewManager(logger.Named("EvaluationManager"), esutilClient, c.Elasticsearch, c.Evaluation, policyManager, policyGroupManager, policyAssignmentManager, waiverManager, grafeasExtensions, grafeasClientCommon, signer, opaClient, resourceManager, indexManager, filterer, webhookManager, eventPublisher)
+	auditManager := audit.NewManager(logger.Named("AuditManager"), esutilClient, indexManager, filterer)
+	auditInterceptor := audit.NewInterceptor(logger.Named("AuditInterceptor"), esutilClient, c.Elasticsearch, indexManager)
+
+	if c.Evaluation.ReevaluateWindow > 0 {
+		policyAssignmentManager.RegisterChangeHandler(evaluationManager)
+	}
+
 	rodeServer, err := server.NewRodeServer(
 		logger.Named("rode"),
 		grafeasClientCommon,
@@ -134,7 +159,11 @@ func main() {
 		policyManager,
 		policyGroupManager,
 		policyAssignmentManager,
+		waiverManager,
 		evaluationManager,
+		webhookManager,
+		auditManager,
+		eventPublisher,
 	)
 
 	if err != nil {
@@ -142,6 +171,25 @@ func main() {
 	}
 	healthzServer := server.NewHealthzServer(logger.Named("healthz"))
 
+	s := grpc.NewServer(
+		grpc_middleware.WithStreamServerChain(
+			grpc_recovery.StreamServerInterceptor(recoveryHandler),
+			grpc_auth.StreamServerInterceptor(authenticator.Authenticate),
+			grpc_auth.StreamServerInterceptor(authzInterceptor.Authorize),
+		),
+
+		grpc_middleware.WithUnaryServerChain(
+			grpc_recovery.UnaryServerInterceptor(recoveryHandler),
+			grpc_auth.UnaryServerInterceptor(authenticator.Authenticate),
+			// audit before authorizing, so that denied calls are recorded
+			auditInterceptor.Audit,
+			grpc_auth.UnaryServerInterceptor(authzInterceptor.Authorize),
+		),
+	)
+	if c.Debug {
+		reflection.Register(s)
+	}
+
 	pb.RegisterRodeServer(s, rodeServer)
 	grpc_health_v1.RegisterHealthServer(s, healthzServer)
 
@@ -149,6 +197,10 @@ func main() {
 		logger.Fatal("failed to configure service permissions", zap.Error(err))
 	}
 
+	if err := auditInterceptor.LoadServicePermissions(s.GetServiceInfo()); err != nil {
+		logger.Fatal("failed to configure audited methods", zap.Error(err))
+	}
+
 	mux := cmux.New(lis)
 	grpcListener := mux.Match(cmux.HTTP2())
 	httpListener := mux.Match(cmux.HTTP1())
@@ -0,0 +1,204 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/topdown"
+	"github.com/open-policy-agent/opa/util"
+	"go.uber.org/zap"
+)
+
+type embeddedClient struct {
+	logger       *zap.Logger
+	explainQuery bool
+
+	mu        sync.Mutex
+	cacheSize int
+	queries   map[string]*list.Element
+	// recent orders the cached queries from most to least recently used
+	recent *list.List
+}
+
+type preparedPolicy struct {
+	policyId    string
+	regoContent string
+	query       rego.PreparedEvalQuery
+}
+
+// NewEmbeddedClient returns a Client that compiles and evaluates policies in-process, rather than delegating to an
+// Open Policy Agent server. Prepared queries are cached by policy id, which is expected to be a policy version id.
+// Once cacheSize queries are cached, the least recently used query is evicted.
+func NewEmbeddedClient(logger *zap.Logger, explainQuery bool, cacheSize int) Client {
+	return &embeddedClient{
+		logger:       logger,
+		explainQuery: explainQuery,
+		cacheSize:    cacheSize,
+		queries:      map[string]*list.Element{},
+		recent:       list.New(),
+	}
+}
+
+// InitializePolicy compiles the policy and prepares a query for its package, unless it has already been prepared
+func (c *embeddedClient) InitializePolicy(policyId string, policyData string) ClientError {
+	log := c.logger.Named("Initialize Policy").With(zap.String("policy", policyId))
+
+	if existing := c.get(policyId); existing != nil && existing.regoContent == policyData {
+		return nil
+	}
+
+	if _, err := c.prepare(policyId, policyData); err != nil {
+		return err
+	}
+	log.Debug("successfully prepared policy")
+
+	return nil
+}
+
+// EvaluatePolicy evaluates the policy against the provided input. The policy is usually prepared by InitializePolicy,
+// but it's compiled from regoContent if the prepared query has since been evicted from the cache.
+func (c *embeddedClient) EvaluatePolicy(ctx context.Context, policyId, regoContent string, input []byte) (*EvaluatePolicyResponse, error) {
+	log := c.logger.Named("Evaluate Policy").With(zap.String("policy", policyId))
+
+	prepared := c.get(policyId)
+	if prepared == nil || (regoContent != "" && prepared.regoContent != regoContent) {
+		if regoContent == "" {
+			return nil, NewClientError(fmt.Sprintf("policy %s has not been initialized", policyId), OpaClientErrorTypePolicyNotFound, nil)
+		}
+
+		log.Debug("policy is not prepared, compiling it")
+		var err ClientError
+		if prepared, err = c.prepare(policyId, regoContent); err != nil {
+			return nil, err
+		}
+	}
+
+	var parsedInput interface{}
+	if err := util.UnmarshalJSON(input, &parsedInput); err != nil {
+		log.Error("failed to decode policy input", zap.Error(err))
+		return nil, fmt.Errorf("failed to decode policy input: %s", err)
+	}
+
+	options := []rego.EvalOption{rego.EvalInput(parsedInput)}
+	var tracer *topdown.BufferTracer
+	if c.explainQuery {
+		tracer = topdown.NewBufferTracer()
+		options = append(options, rego.EvalQueryTracer(tracer))
+	}
+
+	// OPA only checks for cancellation periodically, so a request that's already been cancelled may still be evaluated
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("error evaluating policy: %s", err)
+	}
+
+	resultSet, err := prepared.query.Eval(ctx, options...)
+	if err != nil {
+		log.Error("error evaluating policy", zap.Error(err))
+		return nil, fmt.Errorf("error evaluating policy: %s", err)
+	}
+
+	response := &EvaluatePolicyResponse{}
+	if len(resultSet) != 0 && len(resultSet[0].Expressions) != 0 {
+		value, err := json.Marshal(resultSet[0].Expressions[0].Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode policy result: %s", err)
+		}
+
+		response.Result = &EvaluatePolicyResult{}
+		if err := json.Unmarshal(value, response.Result); err != nil {
+			log.Error("failed to decode policy result", zap.Error(err))
+			return nil, fmt.Errorf("failed to decode policy result: %s", err)
+		}
+	}
+
+	if tracer != nil {
+		var buf bytes.Buffer
+		topdown.PrettyTrace(&buf, *tracer)
+		explanation := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		response.Explanation = &explanation
+	}
+
+	return response, nil
+}
+
+// prepare compiles the policy, prepares a query for its package, and caches it
+func (c *embeddedClient) prepare(policyId, policyData string) (*preparedPolicy, ClientError) {
+	module, err := ast.ParseModule(policyId, policyData)
+	if err != nil {
+		return nil, NewClientError("error parsing policy", OpaClientErrorTypeLoadPolicy, err)
+	}
+	if module == nil {
+		return nil, NewClientError("policy is empty", OpaClientErrorTypeLoadPolicy, nil)
+	}
+
+	query, err := rego.New(
+		rego.Query(module.Package.Path.String()),
+		rego.ParsedModule(module),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		return nil, NewClientError("error compiling policy", OpaClientErrorTypeLoadPolicy, err)
+	}
+
+	prepared := &preparedPolicy{
+		policyId:    policyId,
+		regoContent: policyData,
+		query:       query,
+	}
+	c.put(prepared)
+
+	return prepared, nil
+}
+
+// get returns the prepared query for the policy, marking it as the most recently used
+func (c *embeddedClient) get(policyId string) *preparedPolicy {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.queries[policyId]
+	if !ok {
+		return nil
+	}
+	c.recent.MoveToFront(element)
+
+	return element.Value.(*preparedPolicy)
+}
+
+// put caches the prepared query, evicting the least recently used queries if the cache is full
+func (c *embeddedClient) put(prepared *preparedPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.queries[prepared.policyId]; ok {
+		element.Value = prepared
+		c.recent.MoveToFront(element)
+		return
+	}
+
+	c.queries[prepared.policyId] = c.recent.PushFront(prepared)
+	for c.recent.Len() > c.cacheSize {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.queries, oldest.Value.(*preparedPolicy).policyId)
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opa
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+var _ = Describe("embedded client", func() {
+	const rodePolicy = `
+package rode.demo
+
+pass {
+	count(violation_count) == 0
+}
+
+violation_count[v] {
+	violations[v].pass == false
+}
+
+violations[result] {
+	result = {
+		"pass": input.occurrences[_].name == "allowed",
+		"id": "allowed_name",
+		"name": "allowed name",
+		"description": "occurrence must be named allowed",
+		"message": "found an occurrence",
+		"severity": "HIGH",
+	}
+}
+`
+
+	var (
+		embedded Client
+		policyId string
+	)
+
+	BeforeEach(func() {
+		embedded = NewEmbeddedClient(logger, false, 10)
+		policyId = fmt.Sprintf("%s.%d", fake.UUID(), fake.Number(1, 10))
+	})
+
+	Context("InitializePolicy", func() {
+		var (
+			policy      string
+			actualError ClientError
+		)
+
+		BeforeEach(func() {
+			policy = rodePolicy
+		})
+
+		JustBeforeEach(func() {
+			actualError = embedded.InitializePolicy(policyId, policy)
+		})
+
+		It("should prepare the policy", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(embedded.(*embeddedClient).queries).To(HaveKey(policyId))
+		})
+
+		When("the policy has already been prepared", func() {
+			var prepared *preparedPolicy
+
+			BeforeEach(func() {
+				Expect(embedded.InitializePolicy(policyId, policy)).To(BeNil())
+				prepared = embedded.(*embeddedClient).get(policyId)
+			})
+
+			It("should reuse the cached query", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(embedded.(*embeddedClient).get(policyId)).To(BeIdenticalTo(prepared))
+			})
+		})
+
+		When("the policy cannot be parsed", func() {
+			BeforeEach(func() {
+				policy = fake.LetterN(10)
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(actualError.Type()).To(Equal(OpaClientErrorTypeLoadPolicy))
+			})
+		})
+
+		When("the policy does not compile", func() {
+			BeforeEach(func() {
+				policy = "package rode.demo\n\npass { undefined_rule }"
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(actualError.Type()).To(Equal(OpaClientErrorTypeLoadPolicy))
+				Expect(embedded.(*embeddedClient).queries).NotTo(HaveKey(policyId))
+			})
+		})
+
+		When("the cache is full", func() {
+			var (
+				recentlyUsedPolicyId string
+				leastRecentPolicyId  string
+			)
+
+			BeforeEach(func() {
+				embedded = NewEmbeddedClient(logger, false, 2)
+				leastRecentPolicyId = fake.UUID()
+				recentlyUsedPolicyId = fake.UUID()
+
+				Expect(embedded.InitializePolicy(leastRecentPolicyId, rodePolicy)).To(BeNil())
+				Expect(embedded.InitializePolicy(recentlyUsedPolicyId, rodePolicy)).To(BeNil())
+				_, err := embedded.EvaluatePolicy(context.Background(), recentlyUsedPolicyId, rodePolicy, []byte("{}"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should evict the least recently used query", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(embedded.(*embeddedClient).queries).To(HaveLen(2))
+				Expect(embedded.(*embeddedClient).queries).To(HaveKey(policyId))
+				Expect(embedded.(*embeddedClient).queries).To(HaveKey(recentlyUsedPolicyId))
+				Expect(embedded.(*embeddedClient).queries).NotTo(HaveKey(leastRecentPolicyId))
+			})
+		})
+	})
+
+	Context("EvaluatePolicy", func() {
+		var (
+			ctx                   context.Context
+			input                 string
+			explainQuery          bool
+			evictBeforeEvaluating bool
+
+			actualResponse *EvaluatePolicyResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = `{"occurrences": [{"name": "allowed"}]}`
+			explainQuery = false
+			evictBeforeEvaluating = false
+		})
+
+		JustBeforeEach(func() {
+			embedded = NewEmbeddedClient(logger, explainQuery, 1)
+			Expect(embedded.InitializePolicy(policyId, rodePolicy)).To(BeNil())
+			if evictBeforeEvaluating {
+				Expect(embedded.InitializePolicy(fake.UUID(), rodePolicy)).To(BeNil())
+				Expect(embedded.(*embeddedClient).queries).NotTo(HaveKey(policyId))
+			}
+
+			actualResponse, actualError = embedded.EvaluatePolicy(ctx, policyId, rodePolicy, []byte(input))
+		})
+
+		It("should return the policy result", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Result).NotTo(BeNil())
+			Expect(actualResponse.Result.Pass).To(BeTrue())
+			Expect(actualResponse.Result.Violations).To(HaveLen(1))
+			Expect(actualResponse.Result.Violations[0].Id).To(Equal("allowed_name"))
+			Expect(actualResponse.Result.Violations[0].Pass).To(BeTrue())
+			Expect(actualResponse.Result.Violations[0].Severity).To(Equal(pb.ViolationSeverity_HIGH))
+			Expect(actualResponse.Explanation).To(BeNil())
+		})
+
+		When("the input fails the policy", func() {
+			BeforeEach(func() {
+				input = `{"occurrences": [{"name": "denied"}]}`
+			})
+
+			It("should return a failing result", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Result.Pass).To(BeFalse())
+				Expect(actualResponse.Result.Violations[0].Pass).To(BeFalse())
+			})
+		})
+
+		When("the policy is evicted from the cache between initializing and evaluating it", func() {
+			BeforeEach(func() {
+				evictBeforeEvaluating = true
+			})
+
+			It("should compile the policy again", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Result.Pass).To(BeTrue())
+				Expect(embedded.(*embeddedClient).queries).To(HaveKey(policyId))
+			})
+		})
+
+		When("explain is enabled", func() {
+			BeforeEach(func() {
+				explainQuery = true
+			})
+
+			It("should include the explanation", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Explanation).NotTo(BeNil())
+				Expect(*actualResponse.Explanation).NotTo(BeEmpty())
+			})
+		})
+
+		When("the context is cancelled", func() {
+			BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(context.Background())
+				cancel()
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(actualResponse).To(BeNil())
+			})
+		})
+
+		When("the input is not valid json", func() {
+			BeforeEach(func() {
+				input = fake.LetterN(10)
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(actualResponse).To(BeNil())
+			})
+		})
+	})
+
+	When("the policy has not been initialized", func() {
+		It("should compile the policy", func() {
+			response, err := embedded.EvaluatePolicy(context.Background(), policyId, rodePolicy, []byte("{}"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Result).NotTo(BeNil())
+			Expect(embedded.(*embeddedClient).queries).To(HaveKey(policyId))
+		})
+
+		It("should return an error if the policy does not compile", func() {
+			response, err := embedded.EvaluatePolicy(context.Background(), policyId, fake.LetterN(10), []byte("{}"))
+
+			Expect(response).To(BeNil())
+			Expect(err.(ClientError).Type()).To(Equal(OpaClientErrorTypeLoadPolicy))
+		})
+
+		It("should return a policy not found error without the policy", func() {
+			response, err := embedded.EvaluatePolicy(context.Background(), policyId, "", []byte("{}"))
+
+			Expect(response).To(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(err.(ClientError).Type()).To(Equal(OpaClientErrorTypePolicyNotFound))
+		})
+	})
+})
@@ -17,6 +17,7 @@ package opa
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	pb "github.com/rode/rode/proto/v1alpha1"
@@ -32,10 +33,11 @@ import (
 //go:generate counterfeiter -generate
 
 // Client is an interface for sending requests to the OPA API
+//
 //counterfeiter:generate . Client
 type Client interface {
 	InitializePolicy(policy string, policyData string) ClientError
-	EvaluatePolicy(policy string, input []byte) (*EvaluatePolicyResponse, error)
+	EvaluatePolicy(ctx context.Context, policyId, policy string, input []byte) (*EvaluatePolicyResponse, error)
 }
 
 type client struct {
@@ -62,6 +64,40 @@ type EvaluatePolicyResult struct {
 	Violations []*pb.EvaluatePolicyViolation `json:"violations"`
 }
 
+// UnmarshalJSON decodes a policy result, converting violation severities from their names (e.g., "HIGH").
+// Unrecognized severities are left unspecified. Waivers are applied by Rode after evaluation, so a policy can't
+// mark its own violations as waived.
+func (r *EvaluatePolicyResult) UnmarshalJSON(data []byte) error {
+	var result struct {
+		Pass       bool              `json:"pass"`
+		Violations []json.RawMessage `json:"violations"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	r.Pass = result.Pass
+	r.Violations = nil
+	for _, rawViolation := range result.Violations {
+		violation := struct {
+			*pb.EvaluatePolicyViolation
+			Severity string `json:"severity"`
+		}{
+			EvaluatePolicyViolation: &pb.EvaluatePolicyViolation{},
+		}
+		if err := json.Unmarshal(rawViolation, &violation); err != nil {
+			return err
+		}
+
+		violation.EvaluatePolicyViolation.Severity = pb.ViolationSeverity(pb.ViolationSeverity_value[strings.ToUpper(violation.Severity)])
+		violation.EvaluatePolicyViolation.Waived = false
+		violation.EvaluatePolicyViolation.WaiverId = ""
+		r.Violations = append(r.Violations, violation.EvaluatePolicyViolation)
+	}
+
+	return nil
+}
+
 // PolicyViolation Rego rule conditions
 type PolicyViolation struct {
 	Conditions []byte
@@ -131,7 +167,7 @@ func (opa *client) loadPolicy(policy string, policyData string) error {
 }
 
 // EvaluatePolicy evaluates OPA policy against provided input
-func (opa *client) EvaluatePolicy(policy string, input []byte) (*EvaluatePolicyResponse, error) {
+func (opa *client) EvaluatePolicy(ctx context.Context, _, policy string, input []byte) (*EvaluatePolicyResponse, error) {
 	log := opa.logger.Named("Evalute Policy")
 
 	request, err := json.Marshal(&EvalutePolicyRequest{Input: input})
@@ -140,7 +176,13 @@ func (opa *client) EvaluatePolicy(policy string, input []byte) (*EvaluatePolicyR
 		return nil, fmt.Errorf("failed to encode OPA input: %s", err)
 	}
 
-	httpResponse, err := opa.httpClient.Post(opa.getDataQueryURL(getOpaPackagePath(policy)), "application/json", bytes.NewReader(request))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, opa.getDataQueryURL(getOpaPackagePath(policy)), bytes.NewReader(request))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OPA request: %s", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := opa.httpClient.Do(httpRequest)
 	if err != nil {
 		log.Error("http request to OPA failed", zap.Error(err))
 		return nil, fmt.Errorf("http request to OPA failed: %s", err)
@@ -15,6 +15,7 @@
 package opa
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -199,7 +200,7 @@ var _ = Describe("opa client", func() {
 		)
 
 		JustBeforeEach(func() {
-			evalutePolicyResponse, expectedErr = Opa.EvaluatePolicy(compilablePolicyMissingRodeFields, input)
+			evalutePolicyResponse, expectedErr = Opa.EvaluatePolicy(context.Background(), opaPolicy, compilablePolicyMissingRodeFields, input)
 		})
 
 		When("OPA returns a valid response", func() {
@@ -299,4 +300,64 @@ var _ = Describe("opa client", func() {
 			})
 		})
 	})
+
+	Context("EvaluatePolicyResult", func() {
+		var (
+			data        string
+			result      *EvaluatePolicyResult
+			actualError error
+		)
+
+		BeforeEach(func() {
+			data = `{"pass": false, "violations": [{"id": "a", "pass": false, "severity": "CRITICAL"}, {"id": "b", "pass": true, "severity": "low"}, {"id": "c", "pass": false}]}`
+		})
+
+		JustBeforeEach(func() {
+			result = &EvaluatePolicyResult{}
+			actualError = json.Unmarshal([]byte(data), result)
+		})
+
+		It("should decode the violation severities", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(result.Pass).To(BeFalse())
+			Expect(result.Violations).To(HaveLen(3))
+			Expect(result.Violations[0].Id).To(Equal("a"))
+			Expect(result.Violations[0].Severity).To(Equal(pb.ViolationSeverity_CRITICAL))
+			Expect(result.Violations[1].Severity).To(Equal(pb.ViolationSeverity_LOW))
+			Expect(result.Violations[2].Severity).To(Equal(pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED))
+		})
+
+		When("the policy marks a violation as waived", func() {
+			BeforeEach(func() {
+				data = `{"pass": false, "violations": [{"id": "a", "pass": false, "waived": true, "waiverId": "b"}]}`
+			})
+
+			It("should ignore the waiver fields", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(result.Violations[0].Waived).To(BeFalse())
+				Expect(result.Violations[0].WaiverId).To(BeEmpty())
+			})
+		})
+
+		When("the severity is not recognized", func() {
+			BeforeEach(func() {
+				data = `{"pass": false, "violations": [{"id": "a", "pass": false, "severity": "SEVERE"}]}`
+			})
+
+			It("should leave the severity unspecified", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(result.Violations[0].Severity).To(Equal(pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED))
+			})
+		})
+
+		When("a violation is malformed", func() {
+			BeforeEach(func() {
+				data = `{"pass": false, "violations": [{"id": 1}]}`
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+			})
+		})
+	})
 })
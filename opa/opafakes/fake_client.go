@@ -2,17 +2,20 @@
 package opafakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/rode/rode/opa"
 )
 
 type FakeClient struct {
-	EvaluatePolicyStub        func(string, []byte) (*opa.EvaluatePolicyResponse, error)
+	EvaluatePolicyStub        func(context.Context, string, string, []byte) (*opa.EvaluatePolicyResponse, error)
 	evaluatePolicyMutex       sync.RWMutex
 	evaluatePolicyArgsForCall []struct {
-		arg1 string
-		arg2 []byte
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []byte
 	}
 	evaluatePolicyReturns struct {
 		result1 *opa.EvaluatePolicyResponse
@@ -38,24 +41,26 @@ type FakeClient struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeClient) EvaluatePolicy(arg1 string, arg2 []byte) (*opa.EvaluatePolicyResponse, error) {
-	var arg2Copy []byte
-	if arg2 != nil {
-		arg2Copy = make([]byte, len(arg2))
-		copy(arg2Copy, arg2)
+func (fake *FakeClient) EvaluatePolicy(arg1 context.Context, arg2 string, arg3 string, arg4 []byte) (*opa.EvaluatePolicyResponse, error) {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
 	}
 	fake.evaluatePolicyMutex.Lock()
 	ret, specificReturn := fake.evaluatePolicyReturnsOnCall[len(fake.evaluatePolicyArgsForCall)]
 	fake.evaluatePolicyArgsForCall = append(fake.evaluatePolicyArgsForCall, struct {
-		arg1 string
-		arg2 []byte
-	}{arg1, arg2Copy})
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
 	stub := fake.EvaluatePolicyStub
 	fakeReturns := fake.evaluatePolicyReturns
-	fake.recordInvocation("EvaluatePolicy", []interface{}{arg1, arg2Copy})
+	fake.recordInvocation("EvaluatePolicy", []interface{}{arg1, arg2, arg3, arg4Copy})
 	fake.evaluatePolicyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -69,17 +74,17 @@ func (fake *FakeClient) EvaluatePolicyCallCount() int {
 	return len(fake.evaluatePolicyArgsForCall)
 }
 
-func (fake *FakeClient) EvaluatePolicyCalls(stub func(string, []byte) (*opa.EvaluatePolicyResponse, error)) {
+func (fake *FakeClient) EvaluatePolicyCalls(stub func(context.Context, string, string, []byte) (*opa.EvaluatePolicyResponse, error)) {
 	fake.evaluatePolicyMutex.Lock()
 	defer fake.evaluatePolicyMutex.Unlock()
 	fake.EvaluatePolicyStub = stub
 }
 
-func (fake *FakeClient) EvaluatePolicyArgsForCall(i int) (string, []byte) {
+func (fake *FakeClient) EvaluatePolicyArgsForCall(i int) (context.Context, string, string, []byte) {
 	fake.evaluatePolicyMutex.RLock()
 	defer fake.evaluatePolicyMutex.RUnlock()
 	argsForCall := fake.evaluatePolicyArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeClient) EvaluatePolicyReturns(result1 *opa.EvaluatePolicyResponse, result2 error) {
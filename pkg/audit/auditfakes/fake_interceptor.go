@@ -0,0 +1,198 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package auditfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rode/rode/pkg/audit"
+	"google.golang.org/grpc"
+)
+
+type FakeInterceptor struct {
+	AuditStub        func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error)
+	auditMutex       sync.RWMutex
+	auditArgsForCall []struct {
+		arg1 context.Context
+		arg2 interface{}
+		arg3 *grpc.UnaryServerInfo
+		arg4 grpc.UnaryHandler
+	}
+	auditReturns struct {
+		result1 interface{}
+		result2 error
+	}
+	auditReturnsOnCall map[int]struct {
+		result1 interface{}
+		result2 error
+	}
+	LoadServicePermissionsStub        func(map[string]grpc.ServiceInfo) error
+	loadServicePermissionsMutex       sync.RWMutex
+	loadServicePermissionsArgsForCall []struct {
+		arg1 map[string]grpc.ServiceInfo
+	}
+	loadServicePermissionsReturns struct {
+		result1 error
+	}
+	loadServicePermissionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInterceptor) Audit(arg1 context.Context, arg2 interface{}, arg3 *grpc.UnaryServerInfo, arg4 grpc.UnaryHandler) (interface{}, error) {
+	fake.auditMutex.Lock()
+	ret, specificReturn := fake.auditReturnsOnCall[len(fake.auditArgsForCall)]
+	fake.auditArgsForCall = append(fake.auditArgsForCall, struct {
+		arg1 context.Context
+		arg2 interface{}
+		arg3 *grpc.UnaryServerInfo
+		arg4 grpc.UnaryHandler
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AuditStub
+	fakeReturns := fake.auditReturns
+	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3, arg4})
+	fake.auditMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInterceptor) AuditCallCount() int {
+	fake.auditMutex.RLock()
+	defer fake.auditMutex.RUnlock()
+	return len(fake.auditArgsForCall)
+}
+
+func (fake *FakeInterceptor) AuditCalls(stub func(context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) (interface{}, error)) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = stub
+}
+
+func (fake *FakeInterceptor) AuditArgsForCall(i int) (context.Context, interface{}, *grpc.UnaryServerInfo, grpc.UnaryHandler) {
+	fake.auditMutex.RLock()
+	defer fake.auditMutex.RUnlock()
+	argsForCall := fake.auditArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInterceptor) AuditReturns(result1 interface{}, result2 error) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	fake.auditReturns = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInterceptor) AuditReturnsOnCall(i int, result1 interface{}, result2 error) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	if fake.auditReturnsOnCall == nil {
+		fake.auditReturnsOnCall = make(map[int]struct {
+			result1 interface{}
+			result2 error
+		})
+	}
+	fake.auditReturnsOnCall[i] = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInterceptor) LoadServicePermissions(arg1 map[string]grpc.ServiceInfo) error {
+	fake.loadServicePermissionsMutex.Lock()
+	ret, specificReturn := fake.loadServicePermissionsReturnsOnCall[len(fake.loadServicePermissionsArgsForCall)]
+	fake.loadServicePermissionsArgsForCall = append(fake.loadServicePermissionsArgsForCall, struct {
+		arg1 map[string]grpc.ServiceInfo
+	}{arg1})
+	stub := fake.LoadServicePermissionsStub
+	fakeReturns := fake.loadServicePermissionsReturns
+	fake.recordInvocation("LoadServicePermissions", []interface{}{arg1})
+	fake.loadServicePermissionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInterceptor) LoadServicePermissionsCallCount() int {
+	fake.loadServicePermissionsMutex.RLock()
+	defer fake.loadServicePermissionsMutex.RUnlock()
+	return len(fake.loadServicePermissionsArgsForCall)
+}
+
+func (fake *FakeInterceptor) LoadServicePermissionsCalls(stub func(map[string]grpc.ServiceInfo) error) {
+	fake.loadServicePermissionsMutex.Lock()
+	defer fake.loadServicePermissionsMutex.Unlock()
+	fake.LoadServicePermissionsStub = stub
+}
+
+func (fake *FakeInterceptor) LoadServicePermissionsArgsForCall(i int) map[string]grpc.ServiceInfo {
+	fake.loadServicePermissionsMutex.RLock()
+	defer fake.loadServicePermissionsMutex.RUnlock()
+	argsForCall := fake.loadServicePermissionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInterceptor) LoadServicePermissionsReturns(result1 error) {
+	fake.loadServicePermissionsMutex.Lock()
+	defer fake.loadServicePermissionsMutex.Unlock()
+	fake.LoadServicePermissionsStub = nil
+	fake.loadServicePermissionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInterceptor) LoadServicePermissionsReturnsOnCall(i int, result1 error) {
+	fake.loadServicePermissionsMutex.Lock()
+	defer fake.loadServicePermissionsMutex.Unlock()
+	fake.LoadServicePermissionsStub = nil
+	if fake.loadServicePermissionsReturnsOnCall == nil {
+		fake.loadServicePermissionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loadServicePermissionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInterceptor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.auditMutex.RLock()
+	defer fake.auditMutex.RUnlock()
+	fake.loadServicePermissionsMutex.RLock()
+	defer fake.loadServicePermissionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInterceptor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ audit.Interceptor = new(FakeInterceptor)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package auditfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rode/rode/pkg/audit"
+	"github.com/rode/rode/proto/v1alpha1"
+)
+
+type FakeManager struct {
+	ListAuditEventsStub        func(context.Context, *v1alpha1.ListAuditEventsRequest) (*v1alpha1.ListAuditEventsResponse, error)
+	listAuditEventsMutex       sync.RWMutex
+	listAuditEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListAuditEventsRequest
+	}
+	listAuditEventsReturns struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}
+	listAuditEventsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) ListAuditEvents(arg1 context.Context, arg2 *v1alpha1.ListAuditEventsRequest) (*v1alpha1.ListAuditEventsResponse, error) {
+	fake.listAuditEventsMutex.Lock()
+	ret, specificReturn := fake.listAuditEventsReturnsOnCall[len(fake.listAuditEventsArgsForCall)]
+	fake.listAuditEventsArgsForCall = append(fake.listAuditEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListAuditEventsRequest
+	}{arg1, arg2})
+	stub := fake.ListAuditEventsStub
+	fakeReturns := fake.listAuditEventsReturns
+	fake.recordInvocation("ListAuditEvents", []interface{}{arg1, arg2})
+	fake.listAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ListAuditEventsCallCount() int {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	return len(fake.listAuditEventsArgsForCall)
+}
+
+func (fake *FakeManager) ListAuditEventsCalls(stub func(context.Context, *v1alpha1.ListAuditEventsRequest) (*v1alpha1.ListAuditEventsResponse, error)) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = stub
+}
+
+func (fake *FakeManager) ListAuditEventsArgsForCall(i int) (context.Context, *v1alpha1.ListAuditEventsRequest) {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	argsForCall := fake.listAuditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) ListAuditEventsReturns(result1 *v1alpha1.ListAuditEventsResponse, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	fake.listAuditEventsReturns = struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListAuditEventsReturnsOnCall(i int, result1 *v1alpha1.ListAuditEventsResponse, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	if fake.listAuditEventsReturnsOnCall == nil {
+		fake.listAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListAuditEventsResponse
+			result2 error
+		})
+	}
+	fake.listAuditEventsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ audit.Manager = new(FakeManager)
@@ -0,0 +1,247 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rode/es-index-manager/indexmanager"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/rode/auth"
+	"github.com/rode/rode/config"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxRequestSummaryLength = 4096
+	redactedValue           = "[REDACTED]"
+	// storeAuditEventTimeout bounds how long a response can be held up by storing its audit event
+	storeAuditEventTimeout = 5 * time.Second
+)
+
+// sensitiveFields are omitted from request summaries, wherever they appear in the request
+var sensitiveFields = map[protoreflect.Name]bool{
+	"password": true,
+	"secret":   true,
+	"token":    true,
+}
+
+// Interceptor records an AuditEvent for each call to an RPC with the audit field set in its rode.v1alpha1.authorization
+// option. Only unary RPCs are audited, as none of the streaming RPCs change state.
+//
+//counterfeiter:generate . Interceptor
+type Interceptor interface {
+	Audit(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error)
+	LoadServicePermissions(serviceInfo map[string]grpc.ServiceInfo) error
+}
+
+type interceptor struct {
+	logger         *zap.Logger
+	esClient       esutil.Client
+	esConfig       *config.ElasticsearchConfig
+	indexManager   indexmanager.IndexManager
+	auditedMethods map[string]bool
+}
+
+func NewInterceptor(
+	logger *zap.Logger,
+	esClient esutil.Client,
+	esConfig *config.ElasticsearchConfig,
+	indexManager indexmanager.IndexManager,
+) Interceptor {
+	return &interceptor{
+		logger:         logger,
+		esClient:       esClient,
+		esConfig:       esConfig,
+		indexManager:   indexManager,
+		auditedMethods: map[string]bool{},
+	}
+}
+
+var newUuid = uuid.New
+
+// LoadServicePermissions finds the methods to audit, using the audit field of the rode.v1alpha1.authorization option
+func (i *interceptor) LoadServicePermissions(serviceInfo map[string]grpc.ServiceInfo) error {
+	for serviceName := range serviceInfo {
+		fd, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+		if err != nil {
+			return err
+		}
+
+		serviceDescriptor := fd.(protoreflect.ServiceDescriptor)
+		for j := 0; j < serviceDescriptor.Methods().Len(); j++ {
+			method := serviceDescriptor.Methods().Get(j)
+			authz := proto.GetExtension(method.Options(), pb.E_Authorization).(*pb.Authorization)
+			if authz.GetAudit() {
+				i.auditedMethods[fmt.Sprintf("/%s/%s", serviceName, method.Name())] = true
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *interceptor) Audit(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !i.auditedMethods[info.FullMethod] {
+		return handler(ctx, request)
+	}
+
+	log := i.logger.Named("Audit").With(zap.String("method", info.FullMethod))
+	response, err := handler(ctx, request)
+
+	requestSummary, truncated := summarize(log, request)
+	auditEvent := &pb.AuditEvent{
+		Id:                      newUuid().String(),
+		Subject:                 auth.SubjectFromContext(ctx),
+		Method:                  info.FullMethod,
+		RequestSummary:          requestSummary,
+		RequestSummaryTruncated: truncated,
+		Code:                    status.Code(err).String(),
+		Success:                 err == nil,
+		Created:                 timestamppb.Now(),
+	}
+	if err != nil {
+		auditEvent.ErrorMessage = status.Convert(err).Message()
+	}
+
+	// the event is stored before the response is returned, so that an audited change is never missing from the audit
+	// log, at the cost of added latency on audited calls: up to storeAuditEventTimeout when Elasticsearch is slow.
+	// The caller's context may be cancelled once the handler returns, but the event should still be stored.
+	storeCtx, cancel := context.WithTimeout(context.Background(), storeAuditEventTimeout)
+	defer cancel()
+
+	if _, storeErr := i.esClient.Create(storeCtx, &esutil.CreateRequest{
+		Index:      auditAlias(i.indexManager),
+		Refresh:    i.esConfig.Refresh.String(),
+		Message:    auditEvent,
+		DocumentId: auditEvent.Id,
+	}); storeErr != nil {
+		log.Error("error storing audit event", zap.Error(storeErr), zap.String("subject", auditEvent.Subject))
+	}
+
+	return response, err
+}
+
+// summarize encodes the request as JSON, replacing the values of sensitive fields. When the summary is too long, it's
+// truncated at a field boundary, and true is returned along with it.
+func summarize(log *zap.Logger, request interface{}) (string, bool) {
+	message, ok := request.(proto.Message)
+	if !ok {
+		return "", false
+	}
+
+	redacted := proto.Clone(message)
+	redact(redacted.ProtoReflect())
+
+	summary, err := protojson.Marshal(redacted)
+	if err != nil {
+		log.Warn("error summarizing request", zap.Error(err))
+		return "", false
+	}
+
+	if len(summary) <= maxRequestSummaryLength {
+		return string(summary), false
+	}
+
+	truncatedSummary, err := truncateSummary(redacted.ProtoReflect().Descriptor(), summary)
+	if err != nil {
+		log.Warn("error truncating request summary", zap.Error(err))
+		return "", true
+	}
+
+	return truncatedSummary, true
+}
+
+// truncateSummary rebuilds the JSON object with the request's fields in the order they're declared, leaving out each
+// field that would make the summary longer than maxRequestSummaryLength. The result is always valid JSON.
+func truncateSummary(descriptor protoreflect.MessageDescriptor, summary []byte) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(summary, &fields); err != nil {
+		return "", err
+	}
+
+	truncated := bytes.NewBufferString("{")
+	for j := 0; j < descriptor.Fields().Len(); j++ {
+		name := descriptor.Fields().Get(j).JSONName()
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return "", err
+		}
+
+		field := bytes.NewBuffer(key)
+		field.WriteByte(':')
+		if err := json.Compact(field, value); err != nil {
+			return "", err
+		}
+
+		separatorLength := 0
+		if truncated.Len() > 1 {
+			separatorLength = 1
+		}
+
+		if truncated.Len()+separatorLength+field.Len()+1 > maxRequestSummaryLength {
+			continue
+		}
+
+		if separatorLength > 0 {
+			truncated.WriteByte(',')
+		}
+		truncated.Write(field.Bytes())
+	}
+	truncated.WriteByte('}')
+
+	return truncated.String(), nil
+}
+
+func redact(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.Kind() == protoreflect.StringKind && !field.IsList() && !field.IsMap() && sensitiveFields[field.Name()]:
+			message.Set(field, protoreflect.ValueOfString(redactedValue))
+		case field.IsList() && field.Kind() == protoreflect.MessageKind:
+			list := value.List()
+			for j := 0; j < list.Len(); j++ {
+				redact(list.Get(j).Message())
+			}
+		case field.IsMap() && field.MapValue().Kind() == protoreflect.MessageKind:
+			value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+				redact(mapValue.Message())
+				return true
+			})
+		case !field.IsList() && !field.IsMap() && field.Kind() == protoreflect.MessageKind:
+			redact(value.Message())
+		}
+
+		return true
+	})
+}
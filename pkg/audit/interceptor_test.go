@@ -0,0 +1,366 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/rode/auth"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("audit interceptor", func() {
+	const (
+		auditedMethod   = "/rode.v1alpha1.Rode/CreateWebhook"
+		unauditedMethod = "/rode.v1alpha1.Rode/GetWebhook"
+	)
+
+	var (
+		ctx                context.Context
+		expectedAuditAlias string
+		esClient           *esutilfakes.FakeClient
+		esConfig           *config.ElasticsearchConfig
+		indexManager       *immocks.FakeIndexManager
+		auditInterceptor   Interceptor
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		esClient = &esutilfakes.FakeClient{}
+		esConfig = &config.ElasticsearchConfig{
+			Refresh: config.RefreshTrue,
+		}
+		indexManager = &immocks.FakeIndexManager{}
+
+		expectedAuditAlias = fake.LetterN(10)
+		indexManager.AliasNameReturns(expectedAuditAlias)
+
+		auditInterceptor = NewInterceptor(logger, esClient, esConfig, indexManager)
+	})
+
+	Context("LoadServicePermissions", func() {
+		var actualError error
+
+		JustBeforeEach(func() {
+			actualError = auditInterceptor.LoadServicePermissions(map[string]grpc.ServiceInfo{
+				"rode.v1alpha1.Rode": {},
+			})
+		})
+
+		It("should audit the methods with the audit option set", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey(auditedMethod))
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey("/rode.v1alpha1.Rode/BatchCreateOccurrences"))
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey("/rode.v1alpha1.Rode/DeletePolicy"))
+		})
+
+		It("should audit resource evaluations and collector registration", func() {
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey("/rode.v1alpha1.Rode/EvaluateResource"))
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey("/rode.v1alpha1.Rode/EvaluateResources"))
+			Expect(auditInterceptor.(*interceptor).auditedMethods).To(HaveKey("/rode.v1alpha1.Rode/RegisterCollector"))
+		})
+
+		It("should audit every method that requires a write or delete permission", func() {
+			methods := pb.File_proto_v1alpha1_rode_proto.Services().ByName("Rode").Methods()
+			for i := 0; i < methods.Len(); i++ {
+				method := methods.Get(i)
+				authz := proto.GetExtension(method.Options(), pb.E_Authorization).(*pb.Authorization)
+
+				for _, permission := range authz.GetPermissions() {
+					if strings.HasSuffix(permission, ".write") || strings.HasSuffix(permission, ".delete") {
+						Expect(authz.Audit).To(BeTrue(), fmt.Sprintf("%s requires %s but isn't audited", method.Name(), permission))
+					}
+				}
+			}
+		})
+
+		It("should not audit methods that only require read permissions", func() {
+			Expect(auditInterceptor.(*interceptor).auditedMethods).NotTo(HaveKey(unauditedMethod))
+			Expect(auditInterceptor.(*interceptor).auditedMethods).NotTo(HaveKey("/rode.v1alpha1.Rode/ListAuditEvents"))
+		})
+
+		When("the service is unknown", func() {
+			It("should return an error", func() {
+				err := auditInterceptor.LoadServicePermissions(map[string]grpc.ServiceInfo{
+					fake.LetterN(10): {},
+				})
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Audit", func() {
+		var (
+			method           string
+			username         string
+			request          *pb.Webhook
+			expectedResponse *pb.Webhook
+			handlerError     error
+			handlerCalls     int
+			storeError       error
+
+			actualResponse interface{}
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			Expect(auditInterceptor.LoadServicePermissions(map[string]grpc.ServiceInfo{
+				"rode.v1alpha1.Rode": {},
+			})).To(Succeed())
+
+			method = auditedMethod
+			username = fake.LetterN(10)
+			ctx = authenticatedContext(username)
+			request = &pb.Webhook{
+				Name:   fake.LetterN(10),
+				Url:    fake.URL(),
+				Secret: fake.LetterN(32),
+			}
+			expectedResponse = &pb.Webhook{Id: fake.UUID()}
+			handlerError = nil
+			handlerCalls = 0
+			storeError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.CreateReturns("", storeError)
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalls++
+				if handlerError != nil {
+					return nil, handlerError
+				}
+
+				return expectedResponse, nil
+			}
+
+			actualResponse, actualError = auditInterceptor.Audit(ctx, request, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		})
+
+		It("should call the handler and return its response", func() {
+			Expect(handlerCalls).To(Equal(1))
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse).To(Equal(expectedResponse))
+		})
+
+		It("should store an audit event", func() {
+			Expect(esClient.CreateCallCount()).To(Equal(1))
+
+			_, createRequest := esClient.CreateArgsForCall(0)
+			auditEvent := createRequest.Message.(*pb.AuditEvent)
+
+			Expect(createRequest.Index).To(Equal(expectedAuditAlias))
+			Expect(createRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(createRequest.DocumentId).To(Equal(auditEvent.Id))
+
+			Expect(auditEvent.Id).NotTo(BeEmpty())
+			Expect(auditEvent.Subject).To(Equal(username))
+			Expect(auditEvent.Method).To(Equal(auditedMethod))
+			Expect(auditEvent.Code).To(Equal(codes.OK.String()))
+			Expect(auditEvent.Success).To(BeTrue())
+			Expect(auditEvent.ErrorMessage).To(BeEmpty())
+			Expect(auditEvent.Created).NotTo(BeNil())
+
+			documentKind, _ := indexManager.AliasNameArgsForCall(0)
+			Expect(documentKind).To(Equal(constants.AuditDocumentKind))
+		})
+
+		It("should summarize the request without including secrets", func() {
+			_, createRequest := esClient.CreateArgsForCall(0)
+			auditEvent := createRequest.Message.(*pb.AuditEvent)
+
+			var summary pb.Webhook
+			Expect(protojson.Unmarshal([]byte(auditEvent.RequestSummary), &summary)).To(Succeed())
+
+			Expect(summary.Name).To(Equal(request.Name))
+			Expect(summary.Url).To(Equal(request.Url))
+			Expect(summary.Secret).To(Equal(redactedValue))
+			Expect(auditEvent.RequestSummary).NotTo(ContainSubstring(request.Secret))
+			Expect(auditEvent.RequestSummaryTruncated).To(BeFalse())
+		})
+
+		It("should not modify the request", func() {
+			Expect(request.Secret).NotTo(Equal(redactedValue))
+		})
+
+		When("the caller is anonymous", func() {
+			BeforeEach(func() {
+				ctx = context.Background()
+			})
+
+			It("should store an audit event without a subject", func() {
+				_, createRequest := esClient.CreateArgsForCall(0)
+
+				Expect(createRequest.Message.(*pb.AuditEvent).Subject).To(BeEmpty())
+			})
+		})
+
+		When("the handler returns an error", func() {
+			BeforeEach(func() {
+				handlerError = status.Error(codes.PermissionDenied, fake.Sentence(5))
+			})
+
+			It("should return the error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(actualError).To(Equal(handlerError))
+			})
+
+			It("should record the failure", func() {
+				_, createRequest := esClient.CreateArgsForCall(0)
+				auditEvent := createRequest.Message.(*pb.AuditEvent)
+
+				Expect(auditEvent.Success).To(BeFalse())
+				Expect(auditEvent.Code).To(Equal(codes.PermissionDenied.String()))
+				Expect(auditEvent.ErrorMessage).To(Equal(status.Convert(handlerError).Message()))
+			})
+		})
+
+		When("the method isn't audited", func() {
+			BeforeEach(func() {
+				method = unauditedMethod
+			})
+
+			It("should call the handler", func() {
+				Expect(handlerCalls).To(Equal(1))
+				Expect(actualResponse).To(Equal(expectedResponse))
+			})
+
+			It("should not store an audit event", func() {
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		It("should limit how long storing the audit event can take", func() {
+			storeCtx, _ := esClient.CreateArgsForCall(0)
+			deadline, ok := storeCtx.Deadline()
+
+			Expect(ok).To(BeTrue())
+			Expect(deadline).To(BeTemporally("<=", time.Now().Add(storeAuditEventTimeout)))
+		})
+
+		When("storing the audit event fails", func() {
+			BeforeEach(func() {
+				storeError = errors.New("create error")
+			})
+
+			It("should still return the handler's response", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse).To(Equal(expectedResponse))
+			})
+		})
+
+		When("the request is large", func() {
+			BeforeEach(func() {
+				request.Name = strings.Repeat("a", maxRequestSummaryLength*2)
+			})
+
+			It("should leave out the fields that don't fit in the summary", func() {
+				_, createRequest := esClient.CreateArgsForCall(0)
+				auditEvent := createRequest.Message.(*pb.AuditEvent)
+
+				Expect(len(auditEvent.RequestSummary)).To(BeNumerically("<=", maxRequestSummaryLength))
+				Expect(auditEvent.RequestSummaryTruncated).To(BeTrue())
+
+				var summary pb.Webhook
+				Expect(protojson.Unmarshal([]byte(auditEvent.RequestSummary), &summary)).To(Succeed())
+				Expect(summary.Name).To(BeEmpty())
+				Expect(summary.Url).To(Equal(request.Url))
+				Expect(summary.Secret).To(Equal(redactedValue))
+			})
+		})
+	})
+
+	Context("summarize", func() {
+		It("should redact secrets in nested messages", func() {
+			secret := fake.LetterN(32)
+			request := &pb.ListWebhooksResponse{
+				Webhooks: []*pb.Webhook{
+					{Name: fake.LetterN(10), Secret: secret},
+				},
+			}
+
+			summary, truncated := summarize(logger, request)
+
+			Expect(truncated).To(BeFalse())
+			Expect(summary).NotTo(ContainSubstring(secret))
+			Expect(summary).To(ContainSubstring(redactedValue))
+			Expect(proto.Equal(request, &pb.ListWebhooksResponse{Webhooks: []*pb.Webhook{{Name: request.Webhooks[0].Name, Secret: secret}}})).To(BeTrue())
+		})
+
+		It("should return an empty summary for requests that aren't protobuf messages", func() {
+			summary, truncated := summarize(logger, fake.Word())
+
+			Expect(summary).To(BeEmpty())
+			Expect(truncated).To(BeFalse())
+		})
+
+		It("should not split multi-byte characters when truncating", func() {
+			request := &pb.Webhook{
+				Name: strings.Repeat("界", maxRequestSummaryLength),
+				Url:  "https://example.com/" + strings.Repeat("界", maxRequestSummaryLength/8),
+			}
+
+			summary, truncated := summarize(logger, request)
+
+			Expect(truncated).To(BeTrue())
+			Expect(utf8.ValidString(summary)).To(BeTrue())
+			Expect(json.Valid([]byte(summary))).To(BeTrue())
+			Expect(summary).To(ContainSubstring(request.Url))
+			Expect(summary).NotTo(ContainSubstring(request.Name))
+		})
+	})
+})
+
+func authenticatedContext(username string) context.Context {
+	password := fake.LetterN(10)
+	authenticator := auth.NewAuthenticator(&config.AuthConfig{
+		Basic: &config.BasicAuthConfig{
+			Username: username,
+			Password: password,
+		},
+		OIDC: &config.OIDCAuthConfig{},
+	}, logger, auth.NewRoleRegistry(), auth.NewRoleMapper())
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", username, password)))
+	ctx := metautils.NiceMD(metadata.New(map[string]string{
+		"authorization": fmt.Sprintf("Basic %s", token),
+	})).ToIncoming(context.Background())
+
+	ctx, err := authenticator.Authenticate(ctx)
+	Expect(err).NotTo(HaveOccurred())
+
+	return ctx
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+
+	"github.com/rode/es-index-manager/indexmanager"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate . Manager
+type Manager interface {
+	ListAuditEvents(context.Context, *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error)
+}
+
+type AuditManager Manager
+
+type manager struct {
+	logger       *zap.Logger
+	esClient     esutil.Client
+	indexManager indexmanager.IndexManager
+	filterer     filtering.Filterer
+}
+
+func NewManager(
+	logger *zap.Logger,
+	esClient esutil.Client,
+	indexManager indexmanager.IndexManager,
+	filterer filtering.Filterer,
+) Manager {
+	return &manager{
+		logger:       logger,
+		esClient:     esClient,
+		indexManager: indexManager,
+		filterer:     filterer,
+	}
+}
+
+func (m *manager) ListAuditEvents(ctx context.Context, request *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	log := m.logger.Named("ListAuditEvents")
+	log.Debug("received request", zap.Any("request", request))
+
+	searchRequest := &esutil.SearchRequest{
+		Index: auditAlias(m.indexManager),
+		Search: &esutil.EsSearch{
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+		},
+	}
+
+	if request.Filter != "" {
+		filterQuery, err := m.filterer.ParseExpression(request.Filter)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, "error creating filter query", err)
+		}
+
+		searchRequest.Search.Query = filterQuery
+	}
+
+	if request.PageSize != 0 {
+		searchRequest.Pagination = &esutil.SearchPaginationOptions{
+			Size:  int(request.PageSize),
+			Token: request.PageToken,
+		}
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, searchRequest)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error searching for audit events", err)
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		NextPageToken: searchResponse.NextPageToken,
+	}
+	for _, hit := range searchResponse.Hits.Hits {
+		var auditEvent pb.AuditEvent
+		if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(hit.Source, &auditEvent); err != nil {
+			return nil, util.GrpcInternalError(log, "error unmarshalling audit event", err)
+		}
+
+		response.AuditEvents = append(response.AuditEvents, &auditEvent)
+	}
+
+	return response, nil
+}
+
+func auditAlias(indexManager indexmanager.IndexManager) string {
+	return indexManager.AliasName(constants.AuditDocumentKind, "")
+}
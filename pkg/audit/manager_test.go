@@ -0,0 +1,186 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("audit manager", func() {
+	var (
+		ctx                = context.Background()
+		expectedAuditAlias string
+		esClient           *esutilfakes.FakeClient
+		indexManager       *immocks.FakeIndexManager
+		filterer           *filteringfakes.FakeFilterer
+		manager            Manager
+	)
+
+	BeforeEach(func() {
+		esClient = &esutilfakes.FakeClient{}
+		indexManager = &immocks.FakeIndexManager{}
+		filterer = &filteringfakes.FakeFilterer{}
+
+		expectedAuditAlias = fake.LetterN(10)
+		indexManager.AliasNameReturns(expectedAuditAlias)
+
+		manager = NewManager(logger, esClient, indexManager, filterer)
+	})
+
+	Context("ListAuditEvents", func() {
+		var (
+			request        *pb.ListAuditEventsRequest
+			auditEvent     *pb.AuditEvent
+			searchResponse *esutil.SearchResponse
+			searchError    error
+
+			actualResponse *pb.ListAuditEventsResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			request = &pb.ListAuditEventsRequest{}
+			auditEvent = &pb.AuditEvent{
+				Id:      fake.UUID(),
+				Subject: fake.Username(),
+				Method:  "/rode.v1alpha1.Rode/CreatePolicy",
+				Code:    codes.OK.String(),
+				Success: true,
+				Created: timestamppb.Now(),
+			}
+			auditEventJson, _ := protojson.Marshal(auditEvent)
+			searchResponse = &esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Hits: []*esutil.EsSearchResponseHit{
+						{ID: auditEvent.Id, Source: auditEventJson},
+					},
+				},
+				NextPageToken: fake.Word(),
+			}
+			searchError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.SearchReturns(searchResponse, searchError)
+
+			actualResponse, actualError = manager.ListAuditEvents(ctx, request)
+		})
+
+		It("should search the audit index, starting with the most recent events", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.SearchArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedAuditAlias))
+			Expect(actualRequest.Search.Query).To(BeNil())
+			Expect(actualRequest.Search.Sort["created"]).To(Equal(esutil.EsSortOrderDescending))
+			Expect(actualRequest.Pagination).To(BeNil())
+
+			documentKind, _ := indexManager.AliasNameArgsForCall(0)
+			Expect(documentKind).To(Equal(constants.AuditDocumentKind))
+		})
+
+		It("should return the audit events", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.AuditEvents).To(HaveLen(1))
+			Expect(proto.Equal(actualResponse.AuditEvents[0], auditEvent)).To(BeTrue())
+			Expect(actualResponse.NextPageToken).To(Equal(searchResponse.NextPageToken))
+		})
+
+		When("a filter is specified", func() {
+			var filterQuery *filtering.Query
+
+			BeforeEach(func() {
+				request.Filter = fake.Word()
+				filterQuery = &filtering.Query{Term: &filtering.Term{"success": "false"}}
+				filterer.ParseExpressionReturns(filterQuery, nil)
+			})
+
+			It("should parse the filter", func() {
+				Expect(filterer.ParseExpressionCallCount()).To(Equal(1))
+				Expect(filterer.ParseExpressionArgsForCall(0)).To(Equal(request.Filter))
+			})
+
+			It("should use the filter query", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(actualRequest.Search.Query).To(Equal(filterQuery))
+			})
+
+			When("the filter is invalid", func() {
+				BeforeEach(func() {
+					filterer.ParseExpressionReturns(nil, errors.New("parse error"))
+				})
+
+				It("should return an error", func() {
+					Expect(actualResponse).To(BeNil())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+					Expect(esClient.SearchCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("pagination is requested", func() {
+			BeforeEach(func() {
+				request.PageSize = fake.Int32()
+				request.PageToken = fake.Word()
+			})
+
+			It("should paginate the search", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(actualRequest.Pagination.Size).To(BeEquivalentTo(request.PageSize))
+				Expect(actualRequest.Pagination.Token).To(Equal(request.PageToken))
+			})
+		})
+
+		When("the search fails", func() {
+			BeforeEach(func() {
+				searchError = errors.New("search error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+
+		When("an audit event can't be unmarshalled", func() {
+			BeforeEach(func() {
+				searchResponse.Hits.Hits[0].Source = invalidJson
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+})
@@ -22,6 +22,11 @@ const (
 	PolicyAssignmentsDocumentKind = "policy-assignments"
 	ResourcesDocumentKind         = "resources"
 	EvaluationsDocumentKind       = "evaluations"
+	EvaluationInputsDocumentKind  = "evaluation-inputs"
+	WaiversDocumentKind           = "waivers"
+	WebhooksDocumentKind          = "webhooks"
+	WebhookDeliveriesDocumentKind = "webhook-deliveries"
+	AuditDocumentKind             = "audit"
 
 	MaxPageSize = 1000
 )
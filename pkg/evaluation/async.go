@@ -0,0 +1,159 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// evaluationJob is a resource evaluation that has been accepted by EvaluateResourceAsync and is waiting for a worker
+type evaluationJob struct {
+	log                *zap.Logger
+	resourceUri        string
+	resourceEvaluation *pb.ResourceEvaluation
+	policyGroup        *pb.PolicyGroup
+	policyAssignments  []*pb.PolicyAssignment
+}
+
+func (m *manager) EvaluateResourceAsync(ctx context.Context, request *pb.ResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error) {
+	log := m.logger.Named("EvaluateResourceAsync").With(zap.Any("request", request))
+
+	return m.queueResourceEvaluation(ctx, log, request, false)
+}
+
+// queueResourceEvaluation stores a pending resource evaluation and hands it off to the evaluation workers. When the queue
+// is full, the evaluation is rejected unless wait is set, in which case it blocks until there's room or the context is done.
+// The worker logs to the caller's logger, so that its messages can be traced back to what started the evaluation.
+func (m *manager) queueResourceEvaluation(ctx context.Context, log *zap.Logger, request *pb.ResourceEvaluationRequest, wait bool) (*pb.ResourceEvaluationResult, error) {
+	resourceEvaluation, policyGroup, policyAssignments, err := m.newResourceEvaluation(ctx, log, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// reserve a place in the queue before storing anything, so that rejected evaluations aren't persisted
+	if wait {
+		select {
+		case m.evaluationSlots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, util.GrpcErrorWithCode(log, "stopped waiting for room in the evaluation queue", ctx.Err(), status.FromContextError(ctx.Err()).Code())
+		}
+	} else {
+		select {
+		case m.evaluationSlots <- struct{}{}:
+		default:
+			return nil, util.GrpcErrorWithCode(log, "too many pending resource evaluations, try again later", nil, codes.ResourceExhausted)
+		}
+	}
+
+	// the overall result isn't known until the evaluation is complete
+	resourceEvaluation.Pass = false
+	resourceEvaluation.State = pb.ResourceEvaluationState_PENDING
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, &pb.ResourceEvaluationResult{ResourceEvaluation: resourceEvaluation}); err != nil {
+		<-m.evaluationSlots
+		return nil, util.GrpcInternalError(log, "error storing pending resource evaluation", err)
+	}
+
+	job := &evaluationJob{
+		log:                log,
+		resourceUri:        request.ResourceUri,
+		resourceEvaluation: proto.Clone(resourceEvaluation).(*pb.ResourceEvaluation),
+		policyGroup:        policyGroup,
+		policyAssignments:  policyAssignments,
+	}
+
+	// the reserved slot guarantees that this won't block for longer than it takes an idle worker to receive the job
+	m.evaluationJobs <- job
+	log.Debug("queued resource evaluation", zap.String("id", resourceEvaluation.Id))
+
+	return &pb.ResourceEvaluationResult{
+		ResourceEvaluation: resourceEvaluation,
+	}, nil
+}
+
+func (m *manager) startEvaluationWorkers() {
+	for i := 0; i < m.evaluationConfig.Workers; i++ {
+		go func() {
+			for job := range m.evaluationJobs {
+				m.runEvaluationJob(context.Background(), job)
+				<-m.evaluationSlots
+			}
+		}()
+	}
+}
+
+func (m *manager) runEvaluationJob(ctx context.Context, job *evaluationJob) {
+	resourceEvaluation := job.resourceEvaluation
+	log := job.log.Named("runEvaluationJob").With(zap.String("id", resourceEvaluation.Id))
+	log.Debug("starting resource evaluation")
+
+	// a panic would otherwise take down the worker, and with it the server, leaving the evaluation pending forever
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("resource evaluation panicked", zap.Any("panic", r), zap.Stack("stack"))
+			m.failResourceEvaluation(ctx, log, resourceEvaluation, "unexpected error during resource evaluation")
+		}
+	}()
+
+	resourceEvaluation.State = pb.ResourceEvaluationState_RUNNING
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_INDEX, &pb.ResourceEvaluationResult{ResourceEvaluation: resourceEvaluation}); err != nil {
+		log.Warn("error marking resource evaluation as running", zap.Error(err))
+	}
+
+	occurrences, err := m.listResourceOccurrences(ctx, log, job.resourceUri)
+	if err != nil {
+		m.failResourceEvaluation(ctx, log, resourceEvaluation, status.Convert(err).Message())
+		return
+	}
+
+	resourceEvaluation.Pass = true
+	policyEvaluations, err := m.evaluatePolicyAssignments(ctx, log, occurrences, resourceEvaluation, job.policyAssignments)
+	if err != nil {
+		m.failResourceEvaluation(ctx, log, resourceEvaluation, status.Convert(err).Message())
+		return
+	}
+
+	resourceEvaluation.State = pb.ResourceEvaluationState_COMPLETE
+	result := &pb.ResourceEvaluationResult{
+		ResourceEvaluation: resourceEvaluation,
+		PolicyEvaluations:  policyEvaluations,
+	}
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_INDEX, result); err != nil {
+		log.Error("error storing resource evaluation results", zap.Error(err))
+		m.failResourceEvaluation(ctx, log, resourceEvaluation, "error storing resource evaluation results")
+		return
+	}
+
+	m.attestResourceEvaluations(ctx, log, job.policyGroup, result)
+
+	log.Debug("finished resource evaluation", zap.Bool("pass", resourceEvaluation.Pass))
+}
+
+func (m *manager) failResourceEvaluation(ctx context.Context, log *zap.Logger, resourceEvaluation *pb.ResourceEvaluation, message string) {
+	resourceEvaluation.Pass = false
+	resourceEvaluation.State = pb.ResourceEvaluationState_FAILED
+	resourceEvaluation.ErrorMessage = message
+
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_INDEX, &pb.ResourceEvaluationResult{ResourceEvaluation: resourceEvaluation}); err != nil {
+		log.Error("error marking resource evaluation as failed", zap.Error(err), zap.String("reason", message))
+	}
+}
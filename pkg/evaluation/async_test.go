@@ -0,0 +1,531 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/opa"
+	"github.com/rode/rode/opa/opafakes"
+	"github.com/rode/rode/pkg/grafeas/grafeasfakes"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	"github.com/rode/rode/pkg/resource/resourcefakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+var _ = Describe("asynchronous evaluations", func() {
+	var (
+		ctx context.Context
+
+		esClient                *esutilfakes.FakeClient
+		evaluationConfig        *config.EvaluationConfig
+		policyManager           *policyfakes.FakeManager
+		policyGroupManager      *policyfakes.FakePolicyGroupManager
+		policyAssignmentManager *policyfakes.FakeAssignmentManager
+		grafeasExtensions       *grafeasfakes.FakeExtensions
+		opaClient               *opafakes.FakeClient
+		resourceManager         *resourcefakes.FakeManager
+		indexManager            *mocks.FakeIndexManager
+
+		evaluationManager *manager
+
+		resourceUri             string
+		policyGroupName         string
+		policyVersionId         string
+		request                 *pb.ResourceEvaluationRequest
+		evaluatePolicyResponse  *opa.EvaluatePolicyResponse
+		evaluatePolicyError     error
+		expectedEvaluationAlias string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		esClient = &esutilfakes.FakeClient{}
+		// no workers are started, so that queued jobs can be run by the test
+		evaluationConfig = &config.EvaluationConfig{
+			QueueSize:         1,
+			PolicyConcurrency: 1,
+			MaxOccurrences:    100,
+		}
+		policyManager = &policyfakes.FakeManager{}
+		policyGroupManager = &policyfakes.FakePolicyGroupManager{}
+		policyAssignmentManager = &policyfakes.FakeAssignmentManager{}
+		grafeasExtensions = &grafeasfakes.FakeExtensions{}
+		opaClient = &opafakes.FakeClient{}
+		resourceManager = &resourcefakes.FakeManager{}
+		indexManager = &mocks.FakeIndexManager{}
+
+		expectedEvaluationAlias = fake.LetterN(10)
+		indexManager.AliasNameReturns(expectedEvaluationAlias)
+
+		resourceUri = fake.URL()
+		policyGroupName = fake.LetterN(10)
+		policyVersionId = fmt.Sprintf("%s.%d", fake.UUID(), fake.Number(1, 9))
+		request = &pb.ResourceEvaluationRequest{
+			ResourceUri: resourceUri,
+			PolicyGroup: policyGroupName,
+		}
+
+		resourceManager.GetResourceVersionReturns(&pb.ResourceVersion{Version: resourceUri}, nil)
+		policyGroupManager.GetPolicyGroupReturns(&pb.PolicyGroup{Name: policyGroupName}, nil)
+		policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{
+			PolicyAssignments: []*pb.PolicyAssignment{
+				{
+					PolicyVersionId: policyVersionId,
+					PolicyGroup:     policyGroupName,
+				},
+			},
+		}, nil)
+		grafeasExtensions.ListAllVersionedResourceOccurrencesReturns([]*grafeas_proto.Occurrence{
+			createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+		}, nil)
+		policyManager.GetPolicyVersionReturns(createRandomPolicyEntity(fake.LetterN(10), 1), nil)
+		esClient.BulkReturns(&esutil.EsBulkResponse{}, nil)
+
+		evaluatePolicyResponse = &opa.EvaluatePolicyResponse{
+			Result: &opa.EvaluatePolicyResult{
+				Pass:       true,
+				Violations: []*pb.EvaluatePolicyViolation{randomViolation()},
+			},
+		}
+		evaluatePolicyError = nil
+	})
+
+	JustBeforeEach(func() {
+		opaClient.EvaluatePolicyReturns(evaluatePolicyResponse, evaluatePolicyError)
+
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient:                esClient,
+			esConfig:                &config.ElasticsearchConfig{Refresh: config.RefreshTrue},
+			evaluationConfig:        evaluationConfig,
+			policyManager:           policyManager,
+			policyGroupManager:      policyGroupManager,
+			policyAssignmentManager: policyAssignmentManager,
+			grafeasExtensions:       grafeasExtensions,
+			opa:                     opaClient,
+			resourceManager:         resourceManager,
+			indexManager:            indexManager,
+		})
+	})
+
+	getStoredResourceEvaluation := func(call int) (*esutil.BulkRequestItem, *pb.ResourceEvaluation) {
+		_, bulkRequest := esClient.BulkArgsForCall(call)
+		Expect(bulkRequest.Index).To(Equal(expectedEvaluationAlias))
+		item := bulkRequest.Items[0]
+
+		Expect(item.Join.Name).To(Equal(resourceEvaluationRelationName))
+
+		return item, item.Message.(*pb.ResourceEvaluation)
+	}
+
+	Context("EvaluateResourceAsync", func() {
+		var (
+			actualResult *pb.ResourceEvaluationResult
+			actualError  error
+		)
+
+		JustBeforeEach(func() {
+			actualResult, actualError = evaluationManager.EvaluateResourceAsync(ctx, request)
+		})
+
+		It("should store a pending resource evaluation", func() {
+			Expect(esClient.BulkCallCount()).To(Equal(1))
+
+			item, resourceEvaluation := getStoredResourceEvaluation(0)
+
+			Expect(item.Operation).To(Equal(esutil.BULK_CREATE))
+			Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_PENDING))
+			Expect(resourceEvaluation.Pass).To(BeFalse())
+			Expect(resourceEvaluation.PolicyGroup).To(Equal(policyGroupName))
+		})
+
+		It("should return the pending resource evaluation without evaluating any policies", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResult.ResourceEvaluation.Id).NotTo(BeEmpty())
+			Expect(actualResult.ResourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_PENDING))
+			Expect(actualResult.PolicyEvaluations).To(BeEmpty())
+			Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+		})
+
+		It("should queue the evaluation", func() {
+			Expect(evaluationManager.evaluationJobs).To(HaveLen(1))
+
+			job := <-evaluationManager.evaluationJobs
+			Expect(job.resourceUri).To(Equal(resourceUri))
+			Expect(job.resourceEvaluation.Id).To(Equal(actualResult.ResourceEvaluation.Id))
+			Expect(job.resourceEvaluation).NotTo(BeIdenticalTo(actualResult.ResourceEvaluation))
+			Expect(job.policyAssignments).To(HaveLen(1))
+		})
+
+		When("the request is invalid", func() {
+			BeforeEach(func() {
+				request.PolicyGroup = ""
+			})
+
+			It("should return an error without queueing the evaluation", func() {
+				Expect(actualResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.BulkCallCount()).To(BeZero())
+				Expect(evaluationManager.evaluationJobs).To(BeEmpty())
+			})
+		})
+
+		When("the pending evaluation cannot be stored", func() {
+			BeforeEach(func() {
+				esClient.BulkReturns(nil, errors.New(fake.Word()))
+			})
+
+			It("should return an error without queueing the evaluation", func() {
+				Expect(actualResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+				Expect(evaluationManager.evaluationJobs).To(BeEmpty())
+			})
+
+			It("should release the reserved place in the queue", func() {
+				Expect(evaluationManager.evaluationSlots).To(BeEmpty())
+			})
+		})
+
+		When("the queue is full", func() {
+			BeforeEach(func() {
+				evaluationConfig.QueueSize = 0
+			})
+
+			It("should return a resource exhausted error", func() {
+				Expect(actualResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.ResourceExhausted))
+			})
+
+			It("should not store the resource evaluation", func() {
+				Expect(esClient.BulkCallCount()).To(BeZero())
+				Expect(evaluationManager.evaluationJobs).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("queueResourceEvaluation", func() {
+		var (
+			log          *zap.Logger
+			cancel       context.CancelFunc
+			actualResult *pb.ResourceEvaluationResult
+			actualError  error
+		)
+
+		BeforeEach(func() {
+			log = logger.Named(fake.LetterN(10))
+			ctx, cancel = context.WithCancel(ctx)
+		})
+
+		JustBeforeEach(func() {
+			actualResult, actualError = evaluationManager.queueResourceEvaluation(ctx, log, request, true)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("should give the job the caller's logger", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+
+			job := <-evaluationManager.evaluationJobs
+			Expect(job.log).To(BeIdenticalTo(log))
+		})
+
+		When("the queue is full and the context is cancelled", func() {
+			BeforeEach(func() {
+				evaluationConfig.QueueSize = 0
+				cancel()
+			})
+
+			It("should return a cancelled error", func() {
+				Expect(actualResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Canceled))
+			})
+		})
+
+		When("the queue is full and the deadline passes", func() {
+			BeforeEach(func() {
+				evaluationConfig.QueueSize = 0
+				ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+			})
+
+			It("should return a deadline exceeded error", func() {
+				Expect(actualResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.DeadlineExceeded))
+			})
+		})
+	})
+
+	Context("runEvaluationJob", func() {
+		var job *evaluationJob
+
+		JustBeforeEach(func() {
+			_, err := evaluationManager.EvaluateResourceAsync(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			job = <-evaluationManager.evaluationJobs
+
+			evaluationManager.runEvaluationJob(ctx, job)
+		})
+
+		It("should mark the resource evaluation as running", func() {
+			item, resourceEvaluation := getStoredResourceEvaluation(1)
+
+			Expect(item.Operation).To(Equal(esutil.BULK_INDEX))
+			Expect(item.DocumentId).To(Equal(job.resourceEvaluation.Id))
+			Expect(resourceEvaluation.Id).To(Equal(job.resourceEvaluation.Id))
+		})
+
+		It("should evaluate the assigned policies", func() {
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(1))
+
+			_, actualPolicyVersionId, _, _ := opaClient.EvaluatePolicyArgsForCall(0)
+			Expect(actualPolicyVersionId).To(Equal(policyVersionId))
+		})
+
+		It("should store the completed evaluation", func() {
+			Expect(esClient.BulkCallCount()).To(Equal(3))
+
+			_, bulkRequest := esClient.BulkArgsForCall(2)
+			Expect(bulkRequest.Items).To(HaveLen(2))
+
+			item, resourceEvaluation := getStoredResourceEvaluation(2)
+			Expect(item.Operation).To(Equal(esutil.BULK_INDEX))
+			Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
+			Expect(resourceEvaluation.Pass).To(BeTrue())
+			Expect(resourceEvaluation.ErrorMessage).To(BeEmpty())
+
+			policyEvaluationItem := bulkRequest.Items[1]
+			Expect(policyEvaluationItem.Operation).To(Equal(esutil.BULK_CREATE))
+			Expect(policyEvaluationItem.Join.Parent).To(Equal(resourceEvaluation.Id))
+		})
+
+		When("a policy fails", func() {
+			BeforeEach(func() {
+				evaluatePolicyResponse.Result.Pass = false
+			})
+
+			It("should complete with a failing result", func() {
+				_, resourceEvaluation := getStoredResourceEvaluation(2)
+
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
+				Expect(resourceEvaluation.Pass).To(BeFalse())
+			})
+		})
+
+		When("a policy doesn't produce a result", func() {
+			BeforeEach(func() {
+				evaluatePolicyResponse.Result = nil
+			})
+
+			It("should fail the policy evaluation", func() {
+				_, bulkRequest := esClient.BulkArgsForCall(2)
+				policyEvaluation := bulkRequest.Items[1].Message.(*pb.PolicyEvaluation)
+				Expect(policyEvaluation.Pass).To(BeFalse())
+
+				_, resourceEvaluation := getStoredResourceEvaluation(2)
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
+				Expect(resourceEvaluation.Pass).To(BeFalse())
+			})
+		})
+
+		When("the evaluation panics", func() {
+			BeforeEach(func() {
+				grafeasExtensions.ListAllVersionedResourceOccurrencesStub = func(context.Context, string, int) ([]*grafeas_proto.Occurrence, error) {
+					panic(fake.Word())
+				}
+			})
+
+			It("should mark the resource evaluation as failed", func() {
+				Expect(esClient.BulkCallCount()).To(Equal(3))
+
+				_, resourceEvaluation := getStoredResourceEvaluation(2)
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_FAILED))
+				Expect(resourceEvaluation.ErrorMessage).NotTo(BeEmpty())
+			})
+		})
+
+		When("a policy evaluation panics", func() {
+			BeforeEach(func() {
+				policyManager.GetPolicyVersionStub = func(context.Context, string) (*pb.PolicyEntity, error) {
+					panic(fake.Word())
+				}
+			})
+
+			It("should mark the resource evaluation as failed", func() {
+				_, resourceEvaluation := getStoredResourceEvaluation(2)
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_FAILED))
+				Expect(resourceEvaluation.ErrorMessage).To(ContainSubstring(policyVersionId))
+			})
+		})
+
+		When("an error occurs during evaluation", func() {
+			BeforeEach(func() {
+				evaluatePolicyError = errors.New(fake.Word())
+			})
+
+			It("should mark the resource evaluation as failed", func() {
+				Expect(esClient.BulkCallCount()).To(Equal(3))
+
+				_, bulkRequest := esClient.BulkArgsForCall(2)
+				Expect(bulkRequest.Items).To(HaveLen(1))
+
+				_, resourceEvaluation := getStoredResourceEvaluation(2)
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_FAILED))
+				Expect(resourceEvaluation.Pass).To(BeFalse())
+				Expect(resourceEvaluation.ErrorMessage).To(ContainSubstring(policyVersionId))
+			})
+		})
+	})
+
+	Context("ScheduleAutoEvaluations", func() {
+		var (
+			otherResourceUri    string
+			autoPolicyGroups    []string
+			listPolicyGroupsErr error
+		)
+
+		BeforeEach(func() {
+			evaluationConfig.QueueSize = 10
+			evaluationConfig.AutoEvaluateDebounce = 50 * time.Millisecond
+
+			otherResourceUri = fake.URL()
+			autoPolicyGroups = []string{fake.LetterN(10), fake.LetterN(10)}
+			listPolicyGroupsErr = nil
+		})
+
+		JustBeforeEach(func() {
+			var policyGroups []*pb.PolicyGroup
+			for _, name := range autoPolicyGroups {
+				policyGroups = append(policyGroups, &pb.PolicyGroup{Name: name, AutoEvaluate: true})
+			}
+			policyGroupManager.ListPolicyGroupsReturns(&pb.ListPolicyGroupsResponse{
+				PolicyGroups: policyGroups,
+			}, listPolicyGroupsErr)
+
+			evaluationManager.ScheduleAutoEvaluations(resourceUri, otherResourceUri)
+			evaluationManager.ScheduleAutoEvaluations(resourceUri)
+		})
+
+		It("should queue one evaluation per resource version and auto-evaluated policy group", func() {
+			Eventually(evaluationManager.evaluationJobs).Should(HaveLen(4))
+			Consistently(evaluationManager.evaluationJobs, 200*time.Millisecond).Should(HaveLen(4))
+
+			var actualPolicyGroups []string
+			for i := 0; i < policyGroupManager.GetPolicyGroupCallCount(); i++ {
+				_, request := policyGroupManager.GetPolicyGroupArgsForCall(i)
+				actualPolicyGroups = append(actualPolicyGroups, request.Name)
+			}
+			Expect(actualPolicyGroups).To(ConsistOf(autoPolicyGroups[0], autoPolicyGroups[1], autoPolicyGroups[0], autoPolicyGroups[1]))
+		})
+
+		It("should only list the policy groups with auto-evaluate enabled", func() {
+			Eventually(policyGroupManager.ListPolicyGroupsCallCount).ShouldNot(BeZero())
+
+			_, request := policyGroupManager.ListPolicyGroupsArgsForCall(0)
+			Expect(request.Filter).To(Equal(`autoEvaluate == "true"`))
+
+			_, err := filtering.NewFilterer().ParseExpression(request.Filter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark the evaluations as system-triggered", func() {
+			Eventually(evaluationManager.evaluationJobs).Should(HaveLen(4))
+
+			job := <-evaluationManager.evaluationJobs
+			Expect(job.resourceEvaluation.Source.SystemTriggered).To(BeTrue())
+			Expect(job.resourceEvaluation.Source.Name).To(Equal(autoEvaluationSourceName))
+		})
+
+		It("should wait for the debounce period before evaluating", func() {
+			Expect(evaluationManager.evaluationJobs).To(BeEmpty())
+			Expect(policyGroupManager.ListPolicyGroupsCallCount()).To(BeZero())
+		})
+
+		When("a policy group doesn't have any assignments", func() {
+			BeforeEach(func() {
+				policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{}, nil)
+			})
+
+			It("should not queue any evaluations", func() {
+				Eventually(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount).Should(Equal(4))
+				Expect(evaluationManager.evaluationJobs).To(BeEmpty())
+			})
+		})
+
+		When("no policy group has auto-evaluate enabled", func() {
+			BeforeEach(func() {
+				autoPolicyGroups = nil
+			})
+
+			It("should stop scheduling evaluations once the policy groups have been listed", func() {
+				Eventually(func() int {
+					evaluationManager.autoEvaluationsMu.Lock()
+					defer evaluationManager.autoEvaluationsMu.Unlock()
+
+					return len(evaluationManager.autoEvaluations)
+				}).Should(BeZero())
+				Eventually(func() *autoEvaluatePolicyGroups {
+					evaluationManager.autoEvaluationsMu.Lock()
+					defer evaluationManager.autoEvaluationsMu.Unlock()
+
+					return evaluationManager.autoEvaluatePolicyGroups
+				}).ShouldNot(BeNil())
+
+				evaluationManager.ScheduleAutoEvaluations(resourceUri)
+
+				evaluationManager.autoEvaluationsMu.Lock()
+				defer evaluationManager.autoEvaluationsMu.Unlock()
+				Expect(evaluationManager.autoEvaluations).To(BeEmpty())
+			})
+		})
+
+		When("the evaluation queue is full", func() {
+			BeforeEach(func() {
+				evaluationConfig.QueueSize = 1
+			})
+
+			It("should not store evaluations that couldn't be queued", func() {
+				Eventually(evaluationManager.evaluationJobs).Should(HaveLen(1))
+				Consistently(esClient.BulkCallCount, 200*time.Millisecond).Should(Equal(1))
+			})
+		})
+
+		When("an error occurs listing policy groups", func() {
+			BeforeEach(func() {
+				listPolicyGroupsErr = errors.New(fake.Word())
+			})
+
+			It("should not queue any evaluations", func() {
+				Eventually(policyGroupManager.ListPolicyGroupsCallCount).Should(Equal(2))
+				Consistently(evaluationManager.evaluationJobs).Should(BeEmpty())
+			})
+		})
+	})
+})
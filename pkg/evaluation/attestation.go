@@ -0,0 +1,159 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/attestation_go_proto"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attestationPayload is the signed content of an attestation occurrence
+type attestationPayload struct {
+	ResourceUri          string `json:"resourceUri"`
+	ResourceEvaluationId string `json:"resourceEvaluationId"`
+	PolicyGroup          string `json:"policyGroup"`
+	Pass                 bool   `json:"pass"`
+	Created              string `json:"created"`
+}
+
+// attestResourceEvaluations creates an ATTESTATION occurrence for each completed resource evaluation when the policy
+// group has attestations enabled. Attestations are a side effect of the evaluation, so errors are logged rather than returned.
+func (m *manager) attestResourceEvaluations(ctx context.Context, log *zap.Logger, policyGroup *pb.PolicyGroup, results ...*pb.ResourceEvaluationResult) {
+	if !policyGroup.Attest || len(results) == 0 {
+		return
+	}
+
+	log = log.With(zap.String("policyGroup", policyGroup.Name))
+	if m.signer == nil {
+		log.Warn("policy group requires attestations, but no signing key has been configured")
+		return
+	}
+
+	noteName, err := m.ensureAttestationNote(ctx, policyGroup.Name)
+	if err != nil {
+		log.Error("error creating attestation note", zap.Error(err))
+		return
+	}
+
+	var occurrences []*grafeas_go_proto.Occurrence
+	for _, result := range results {
+		occurrence, err := m.createAttestationOccurrence(noteName, result.ResourceEvaluation)
+		if err != nil {
+			log.Error("error creating attestation", zap.Error(err), zap.String("id", result.ResourceEvaluation.Id))
+			continue
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	if len(occurrences) == 0 {
+		return
+	}
+
+	if _, err := m.grafeasClient.BatchCreateOccurrences(ctx, &grafeas_go_proto.BatchCreateOccurrencesRequest{
+		Parent:      constants.RodeProjectSlug,
+		Occurrences: occurrences,
+	}); err != nil {
+		log.Error("error storing attestation occurrences", zap.Error(err))
+	}
+}
+
+func (m *manager) createAttestationOccurrence(noteName string, resourceEvaluation *pb.ResourceEvaluation) (*grafeas_go_proto.Occurrence, error) {
+	payload, err := json.Marshal(&attestationPayload{
+		ResourceUri:          resourceEvaluation.ResourceVersion.Version,
+		ResourceEvaluationId: resourceEvaluation.Id,
+		PolicyGroup:          resourceEvaluation.PolicyGroup,
+		Pass:                 resourceEvaluation.Pass,
+		Created:              resourceEvaluation.Created.AsTime().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := m.signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grafeas_go_proto.Occurrence{
+		Resource: &grafeas_go_proto.Resource{
+			Uri: resourceEvaluation.ResourceVersion.Version,
+		},
+		NoteName: noteName,
+		Kind:     common_go_proto.NoteKind_ATTESTATION,
+		Details: &grafeas_go_proto.Occurrence_Attestation{
+			Attestation: &attestation_go_proto.Details{
+				Attestation: &attestation_go_proto.Attestation{
+					Signature: &attestation_go_proto.Attestation_GenericSignedAttestation{
+						GenericSignedAttestation: &attestation_go_proto.GenericSignedAttestation{
+							ContentType:       attestation_go_proto.GenericSignedAttestation_CONTENT_TYPE_UNSPECIFIED,
+							SerializedPayload: payload,
+							Signatures: []*common_go_proto.Signature{
+								{
+									Signature:   signature,
+									PublicKeyId: m.signer.KeyId(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// ensureAttestationNote creates the attestation note for a policy group the first time it's needed, returning the note name
+func (m *manager) ensureAttestationNote(ctx context.Context, policyGroup string) (string, error) {
+	noteId := fmt.Sprintf("rode-policy-group-%s-attestation", policyGroup)
+	noteName := fmt.Sprintf("%s/notes/%s", constants.RodeProjectSlug, noteId)
+	if _, ok := m.attestationNotes.Load(noteName); ok {
+		return noteName, nil
+	}
+
+	_, err := m.grafeasClient.CreateNote(ctx, &grafeas_go_proto.CreateNoteRequest{
+		Parent: constants.RodeProjectSlug,
+		NoteId: noteId,
+		Note: &grafeas_go_proto.Note{
+			ShortDescription: fmt.Sprintf("Rode policy group %s", policyGroup),
+			LongDescription:  fmt.Sprintf("Attestations for resources evaluated against the %s policy group", policyGroup),
+			Kind:             common_go_proto.NoteKind_ATTESTATION,
+			Type: &grafeas_go_proto.Note_AttestationAuthority{
+				AttestationAuthority: &attestation_go_proto.Authority{
+					Hint: &attestation_go_proto.Authority_Hint{
+						HumanReadableName: fmt.Sprintf("rode/%s", policyGroup),
+					},
+				},
+			},
+		},
+	})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return "", err
+	}
+
+	m.attestationNotes.Store(noteName, true)
+
+	return noteName, nil
+}
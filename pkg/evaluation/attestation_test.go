@@ -0,0 +1,209 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/mocks"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/signing/signingfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("attestations", func() {
+	var (
+		ctx               context.Context
+		grafeasClient     *mocks.FakeGrafeasV1Beta1Client
+		signer            *signingfakes.FakeSigner
+		evaluationManager *manager
+
+		policyGroup       *pb.PolicyGroup
+		results           []*pb.ResourceEvaluationResult
+		expectedSignature []byte
+		expectedKeyId     string
+		expectedNoteName  string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		grafeasClient = &mocks.FakeGrafeasV1Beta1Client{}
+		signer = &signingfakes.FakeSigner{}
+
+		policyGroup = &pb.PolicyGroup{
+			Name:   fake.LetterN(10),
+			Attest: true,
+		}
+		expectedNoteName = fmt.Sprintf("projects/rode/notes/rode-policy-group-%s-attestation", policyGroup.Name)
+		results = []*pb.ResourceEvaluationResult{
+			randomAttestedResult(policyGroup.Name),
+			randomAttestedResult(policyGroup.Name),
+		}
+
+		expectedSignature = []byte(fake.LetterN(20))
+		expectedKeyId = fake.LetterN(64)
+		signer.SignReturns(expectedSignature, nil)
+		signer.KeyIdReturns(expectedKeyId)
+
+		evaluationManager = newTestManager(&managerDependencies{
+			grafeasClient: grafeasClient,
+			signer:        signer,
+		})
+	})
+
+	Context("attestResourceEvaluations", func() {
+		JustBeforeEach(func() {
+			evaluationManager.attestResourceEvaluations(ctx, logger, policyGroup, results...)
+		})
+
+		It("should create the attestation note for the policy group", func() {
+			Expect(grafeasClient.CreateNoteCallCount()).To(Equal(1))
+
+			_, request, _ := grafeasClient.CreateNoteArgsForCall(0)
+
+			Expect(request.Parent).To(Equal(constants.RodeProjectSlug))
+			Expect(request.NoteId).To(Equal(fmt.Sprintf("rode-policy-group-%s-attestation", policyGroup.Name)))
+			Expect(request.Note.Kind).To(Equal(grafeas_common_proto.NoteKind_ATTESTATION))
+			Expect(request.Note.GetAttestationAuthority().Hint.HumanReadableName).To(ContainSubstring(policyGroup.Name))
+		})
+
+		It("should create an attestation occurrence for each evaluation in a single request", func() {
+			Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(1))
+
+			_, request, _ := grafeasClient.BatchCreateOccurrencesArgsForCall(0)
+
+			Expect(request.Parent).To(Equal(constants.RodeProjectSlug))
+			Expect(request.Occurrences).To(HaveLen(len(results)))
+
+			for i, occurrence := range request.Occurrences {
+				resourceEvaluation := results[i].ResourceEvaluation
+
+				Expect(occurrence.NoteName).To(Equal(expectedNoteName))
+				Expect(occurrence.Kind).To(Equal(grafeas_common_proto.NoteKind_ATTESTATION))
+				Expect(occurrence.Resource.Uri).To(Equal(resourceEvaluation.ResourceVersion.Version))
+
+				signedAttestation := occurrence.GetAttestation().Attestation.GetGenericSignedAttestation()
+				Expect(signedAttestation.Signatures).To(HaveLen(1))
+				Expect(signedAttestation.Signatures[0].Signature).To(Equal(expectedSignature))
+				Expect(signedAttestation.Signatures[0].PublicKeyId).To(Equal(expectedKeyId))
+
+				var payload attestationPayload
+				Expect(json.Unmarshal(signedAttestation.SerializedPayload, &payload)).To(Succeed())
+				Expect(payload.ResourceEvaluationId).To(Equal(resourceEvaluation.Id))
+				Expect(payload.ResourceUri).To(Equal(resourceEvaluation.ResourceVersion.Version))
+				Expect(payload.PolicyGroup).To(Equal(policyGroup.Name))
+				Expect(payload.Pass).To(Equal(resourceEvaluation.Pass))
+			}
+		})
+
+		It("should sign the serialized payload", func() {
+			Expect(signer.SignCallCount()).To(Equal(len(results)))
+
+			_, request, _ := grafeasClient.BatchCreateOccurrencesArgsForCall(0)
+			signedAttestation := request.Occurrences[0].GetAttestation().Attestation.GetGenericSignedAttestation()
+
+			Expect(signer.SignArgsForCall(0)).To(Equal(signedAttestation.SerializedPayload))
+		})
+
+		When("the policy group doesn't require attestations", func() {
+			BeforeEach(func() {
+				policyGroup.Attest = false
+			})
+
+			It("should not create an attestation", func() {
+				Expect(grafeasClient.CreateNoteCallCount()).To(Equal(0))
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a signing key has not been configured", func() {
+			BeforeEach(func() {
+				evaluationManager.signer = nil
+			})
+
+			It("should not create an attestation", func() {
+				Expect(grafeasClient.CreateNoteCallCount()).To(Equal(0))
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the attestation note already exists", func() {
+			BeforeEach(func() {
+				grafeasClient.CreateNoteReturns(nil, status.Error(codes.AlreadyExists, "note already exists"))
+			})
+
+			It("should create the attestation occurrences", func() {
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(1))
+			})
+		})
+
+		When("creating the attestation note fails", func() {
+			BeforeEach(func() {
+				grafeasClient.CreateNoteReturns(nil, errors.New("create note error"))
+			})
+
+			It("should not create the attestation occurrences", func() {
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("signing an attestation fails", func() {
+			BeforeEach(func() {
+				signer.SignReturnsOnCall(0, nil, errors.New("sign error"))
+			})
+
+			It("should only create the attestations that were signed", func() {
+				_, request, _ := grafeasClient.BatchCreateOccurrencesArgsForCall(0)
+
+				Expect(request.Occurrences).To(HaveLen(len(results) - 1))
+			})
+		})
+
+		When("the policy group has already been attested", func() {
+			BeforeEach(func() {
+				evaluationManager.attestResourceEvaluations(ctx, logger, policyGroup, results...)
+			})
+
+			It("should reuse the attestation note", func() {
+				Expect(grafeasClient.CreateNoteCallCount()).To(Equal(1))
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
+
+func randomAttestedResult(policyGroup string) *pb.ResourceEvaluationResult {
+	return &pb.ResourceEvaluationResult{
+		ResourceEvaluation: &pb.ResourceEvaluation{
+			Id:          fake.UUID(),
+			Pass:        fake.Bool(),
+			PolicyGroup: policyGroup,
+			Created:     timestamppb.Now(),
+			State:       pb.ResourceEvaluationState_COMPLETE,
+			ResourceVersion: &pb.ResourceVersion{
+				Version: fake.URL(),
+			},
+		},
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"time"
+
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	autoEvaluationSourceName = "rode-auto-evaluate"
+
+	// autoEvaluatePolicyGroupsTTL is how long the list of policy groups with auto-evaluate enabled is reused before it's
+	// fetched again, so a policy group that opts in may take up to this long to be picked up
+	autoEvaluatePolicyGroupsTTL = 30 * time.Second
+)
+
+// pendingAutoEvaluation is a resource version that's waiting for the debounce period to elapse before it's evaluated
+type pendingAutoEvaluation struct {
+	timer *time.Timer
+}
+
+// autoEvaluatePolicyGroups is a cached list of the policy groups with auto-evaluate enabled
+type autoEvaluatePolicyGroups struct {
+	names   []string
+	expires time.Time
+}
+
+// ScheduleAutoEvaluations queues evaluations of the resource versions against every policy group with auto-evaluate enabled.
+// Collectors tend to send occurrences in bursts, so the evaluations only start once no new occurrences have been
+// scheduled for a resource version during EvaluationConfig.AutoEvaluateDebounce.
+func (m *manager) ScheduleAutoEvaluations(resourceUris ...string) {
+	m.autoEvaluationsMu.Lock()
+	defer m.autoEvaluationsMu.Unlock()
+
+	if m.autoEvaluatePolicyGroups != nil && time.Now().Before(m.autoEvaluatePolicyGroups.expires) && len(m.autoEvaluatePolicyGroups.names) == 0 {
+		return
+	}
+
+	for _, resourceUri := range resourceUris {
+		if pending, ok := m.autoEvaluations[resourceUri]; ok && pending.timer.Stop() {
+			pending.timer.Reset(m.evaluationConfig.AutoEvaluateDebounce)
+			continue
+		}
+
+		resourceUri := resourceUri
+		pending := &pendingAutoEvaluation{}
+		m.autoEvaluations[resourceUri] = pending
+		pending.timer = time.AfterFunc(m.evaluationConfig.AutoEvaluateDebounce, func() {
+			m.autoEvaluationsMu.Lock()
+			if m.autoEvaluations[resourceUri] == pending {
+				delete(m.autoEvaluations, resourceUri)
+			}
+			m.autoEvaluationsMu.Unlock()
+
+			m.autoEvaluate(context.Background(), resourceUri)
+		})
+	}
+}
+
+// autoEvaluate starts an asynchronous evaluation of the resource version for each policy group with auto-evaluate enabled.
+// There's no caller to return errors to, so they're logged instead.
+func (m *manager) autoEvaluate(ctx context.Context, resourceUri string) {
+	log := m.logger.Named("autoEvaluate").With(zap.String("resourceUri", resourceUri))
+
+	policyGroups, err := m.listAutoEvaluatePolicyGroups(ctx)
+	if err != nil {
+		log.Error("error listing policy groups", zap.Error(err))
+		return
+	}
+
+	for _, policyGroup := range policyGroups {
+		// wait for room in the queue, since there's no caller that could retry a rejected evaluation
+		_, err := m.queueResourceEvaluation(ctx, log.With(zap.String("policyGroup", policyGroup)), &pb.ResourceEvaluationRequest{
+			ResourceUri: resourceUri,
+			PolicyGroup: policyGroup,
+			Source: &pb.ResourceEvaluationSource{
+				Name:            autoEvaluationSourceName,
+				SystemTriggered: true,
+			},
+		}, true)
+
+		if err == nil {
+			continue
+		}
+
+		// a policy group without assignments can't be evaluated, but that's expected while it's being set up
+		if status.Code(err) == codes.FailedPrecondition {
+			log.Debug("skipping automatic evaluation", zap.String("policyGroup", policyGroup), zap.Error(err))
+		} else {
+			log.Error("error starting automatic evaluation", zap.String("policyGroup", policyGroup), zap.Error(err))
+		}
+	}
+}
+
+// listAutoEvaluatePolicyGroups returns the names of the policy groups with auto-evaluate enabled, using the cached list
+// when it hasn't expired yet
+func (m *manager) listAutoEvaluatePolicyGroups(ctx context.Context) ([]string, error) {
+	m.autoEvaluationsMu.Lock()
+	cached := m.autoEvaluatePolicyGroups
+	m.autoEvaluationsMu.Unlock()
+
+	if cached != nil && time.Now().Before(cached.expires) {
+		return cached.names, nil
+	}
+
+	response, err := m.policyGroupManager.ListPolicyGroups(ctx, &pb.ListPolicyGroupsRequest{
+		Filter:   `autoEvaluate == "true"`,
+		PageSize: constants.MaxPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, policyGroup := range response.PolicyGroups {
+		names = append(names, policyGroup.Name)
+	}
+
+	m.autoEvaluationsMu.Lock()
+	m.autoEvaluatePolicyGroups = &autoEvaluatePolicyGroups{
+		names:   names,
+		expires: time.Now().Add(autoEvaluatePolicyGroupsTTL),
+	}
+	m.autoEvaluationsMu.Unlock()
+
+	return names, nil
+}
@@ -10,6 +10,34 @@ import (
 )
 
 type FakeManager struct {
+	AnalyzePolicyAssignmentImpactStub        func(context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error)
+	analyzePolicyAssignmentImpactMutex       sync.RWMutex
+	analyzePolicyAssignmentImpactArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest
+	}
+	analyzePolicyAssignmentImpactReturns struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}
+	analyzePolicyAssignmentImpactReturnsOnCall map[int]struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}
+	BatchEvaluateResourceStub        func(context.Context, *v1alpha1.BatchEvaluateResourceRequest) (*v1alpha1.BatchEvaluateResourceResponse, error)
+	batchEvaluateResourceMutex       sync.RWMutex
+	batchEvaluateResourceArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.BatchEvaluateResourceRequest
+	}
+	batchEvaluateResourceReturns struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}
+	batchEvaluateResourceReturnsOnCall map[int]struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}
 	EvaluatePolicyStub        func(context.Context, *v1alpha1.EvaluatePolicyRequest) (*v1alpha1.EvaluatePolicyResponse, error)
 	evaluatePolicyMutex       sync.RWMutex
 	evaluatePolicyArgsForCall []struct {
@@ -38,6 +66,48 @@ type FakeManager struct {
 		result1 *v1alpha1.ResourceEvaluationResult
 		result2 error
 	}
+	EvaluateResourceAsyncStub        func(context.Context, *v1alpha1.ResourceEvaluationRequest) (*v1alpha1.ResourceEvaluationResult, error)
+	evaluateResourceAsyncMutex       sync.RWMutex
+	evaluateResourceAsyncArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResourceEvaluationRequest
+	}
+	evaluateResourceAsyncReturns struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}
+	evaluateResourceAsyncReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}
+	EvaluateResourcesStub        func(context.Context, *v1alpha1.EvaluateResourcesRequest) (*v1alpha1.EvaluateResourcesResponse, error)
+	evaluateResourcesMutex       sync.RWMutex
+	evaluateResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.EvaluateResourcesRequest
+	}
+	evaluateResourcesReturns struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}
+	evaluateResourcesReturnsOnCall map[int]struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}
+	GetEvaluationPublicKeyStub        func(context.Context, *v1alpha1.GetEvaluationPublicKeyRequest) (*v1alpha1.EvaluationPublicKey, error)
+	getEvaluationPublicKeyMutex       sync.RWMutex
+	getEvaluationPublicKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetEvaluationPublicKeyRequest
+	}
+	getEvaluationPublicKeyReturns struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}
+	getEvaluationPublicKeyReturnsOnCall map[int]struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}
 	GetResourceEvaluationStub        func(context.Context, *v1alpha1.GetResourceEvaluationRequest) (*v1alpha1.ResourceEvaluationResult, error)
 	getResourceEvaluationMutex       sync.RWMutex
 	getResourceEvaluationArgsForCall []struct {
@@ -52,6 +122,34 @@ type FakeManager struct {
 		result1 *v1alpha1.ResourceEvaluationResult
 		result2 error
 	}
+	GetResourceEvaluationInputStub        func(context.Context, *v1alpha1.GetResourceEvaluationInputRequest) (*v1alpha1.ResourceEvaluationInput, error)
+	getResourceEvaluationInputMutex       sync.RWMutex
+	getResourceEvaluationInputArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationInputRequest
+	}
+	getResourceEvaluationInputReturns struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}
+	getResourceEvaluationInputReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}
+	GetResourceEvaluationStatementStub        func(context.Context, *v1alpha1.GetResourceEvaluationStatementRequest) (*v1alpha1.DsseEnvelope, error)
+	getResourceEvaluationStatementMutex       sync.RWMutex
+	getResourceEvaluationStatementArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationStatementRequest
+	}
+	getResourceEvaluationStatementReturns struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}
+	getResourceEvaluationStatementReturnsOnCall map[int]struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}
 	ListResourceEvaluationsStub        func(context.Context, *v1alpha1.ListResourceEvaluationsRequest) (*v1alpha1.ListResourceEvaluationsResponse, error)
 	listResourceEvaluationsMutex       sync.RWMutex
 	listResourceEvaluationsArgsForCall []struct {
@@ -66,10 +164,190 @@ type FakeManager struct {
 		result1 *v1alpha1.ListResourceEvaluationsResponse
 		result2 error
 	}
+	ListStaleResourceEvaluationsStub        func(context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest) (*v1alpha1.ListStaleResourceEvaluationsResponse, error)
+	listStaleResourceEvaluationsMutex       sync.RWMutex
+	listStaleResourceEvaluationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListStaleResourceEvaluationsRequest
+	}
+	listStaleResourceEvaluationsReturns struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}
+	listStaleResourceEvaluationsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}
+	PolicyAssignmentChangedStub        func(string)
+	policyAssignmentChangedMutex       sync.RWMutex
+	policyAssignmentChangedArgsForCall []struct {
+		arg1 string
+	}
+	ReplayResourceEvaluationStub        func(context.Context, *v1alpha1.ReplayResourceEvaluationRequest) (*v1alpha1.ReplayResourceEvaluationResponse, error)
+	replayResourceEvaluationMutex       sync.RWMutex
+	replayResourceEvaluationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ReplayResourceEvaluationRequest
+	}
+	replayResourceEvaluationReturns struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}
+	replayResourceEvaluationReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}
+	ScheduleAutoEvaluationsStub        func(...string)
+	scheduleAutoEvaluationsMutex       sync.RWMutex
+	scheduleAutoEvaluationsArgsForCall []struct {
+		arg1 []string
+	}
+	WatchResourceEvaluationsStub        func(*v1alpha1.WatchResourceEvaluationsRequest, v1alpha1.Rode_WatchResourceEvaluationsServer) error
+	watchResourceEvaluationsMutex       sync.RWMutex
+	watchResourceEvaluationsArgsForCall []struct {
+		arg1 *v1alpha1.WatchResourceEvaluationsRequest
+		arg2 v1alpha1.Rode_WatchResourceEvaluationsServer
+	}
+	watchResourceEvaluationsReturns struct {
+		result1 error
+	}
+	watchResourceEvaluationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeManager) AnalyzePolicyAssignmentImpact(arg1 context.Context, arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	ret, specificReturn := fake.analyzePolicyAssignmentImpactReturnsOnCall[len(fake.analyzePolicyAssignmentImpactArgsForCall)]
+	fake.analyzePolicyAssignmentImpactArgsForCall = append(fake.analyzePolicyAssignmentImpactArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest
+	}{arg1, arg2})
+	stub := fake.AnalyzePolicyAssignmentImpactStub
+	fakeReturns := fake.analyzePolicyAssignmentImpactReturns
+	fake.recordInvocation("AnalyzePolicyAssignmentImpact", []interface{}{arg1, arg2})
+	fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) AnalyzePolicyAssignmentImpactCallCount() int {
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
+	return len(fake.analyzePolicyAssignmentImpactArgsForCall)
+}
+
+func (fake *FakeManager) AnalyzePolicyAssignmentImpactCalls(stub func(context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error)) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = stub
+}
+
+func (fake *FakeManager) AnalyzePolicyAssignmentImpactArgsForCall(i int) (context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest) {
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
+	argsForCall := fake.analyzePolicyAssignmentImpactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) AnalyzePolicyAssignmentImpactReturns(result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse, result2 error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = nil
+	fake.analyzePolicyAssignmentImpactReturns = struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) AnalyzePolicyAssignmentImpactReturnsOnCall(i int, result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse, result2 error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = nil
+	if fake.analyzePolicyAssignmentImpactReturnsOnCall == nil {
+		fake.analyzePolicyAssignmentImpactReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+			result2 error
+		})
+	}
+	fake.analyzePolicyAssignmentImpactReturnsOnCall[i] = struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) BatchEvaluateResource(arg1 context.Context, arg2 *v1alpha1.BatchEvaluateResourceRequest) (*v1alpha1.BatchEvaluateResourceResponse, error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	ret, specificReturn := fake.batchEvaluateResourceReturnsOnCall[len(fake.batchEvaluateResourceArgsForCall)]
+	fake.batchEvaluateResourceArgsForCall = append(fake.batchEvaluateResourceArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.BatchEvaluateResourceRequest
+	}{arg1, arg2})
+	stub := fake.BatchEvaluateResourceStub
+	fakeReturns := fake.batchEvaluateResourceReturns
+	fake.recordInvocation("BatchEvaluateResource", []interface{}{arg1, arg2})
+	fake.batchEvaluateResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) BatchEvaluateResourceCallCount() int {
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
+	return len(fake.batchEvaluateResourceArgsForCall)
+}
+
+func (fake *FakeManager) BatchEvaluateResourceCalls(stub func(context.Context, *v1alpha1.BatchEvaluateResourceRequest) (*v1alpha1.BatchEvaluateResourceResponse, error)) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = stub
+}
+
+func (fake *FakeManager) BatchEvaluateResourceArgsForCall(i int) (context.Context, *v1alpha1.BatchEvaluateResourceRequest) {
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
+	argsForCall := fake.batchEvaluateResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) BatchEvaluateResourceReturns(result1 *v1alpha1.BatchEvaluateResourceResponse, result2 error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = nil
+	fake.batchEvaluateResourceReturns = struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) BatchEvaluateResourceReturnsOnCall(i int, result1 *v1alpha1.BatchEvaluateResourceResponse, result2 error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = nil
+	if fake.batchEvaluateResourceReturnsOnCall == nil {
+		fake.batchEvaluateResourceReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.BatchEvaluateResourceResponse
+			result2 error
+		})
+	}
+	fake.batchEvaluateResourceReturnsOnCall[i] = struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) EvaluatePolicy(arg1 context.Context, arg2 *v1alpha1.EvaluatePolicyRequest) (*v1alpha1.EvaluatePolicyResponse, error) {
 	fake.evaluatePolicyMutex.Lock()
 	ret, specificReturn := fake.evaluatePolicyReturnsOnCall[len(fake.evaluatePolicyArgsForCall)]
@@ -200,6 +478,201 @@ func (fake *FakeManager) EvaluateResourceReturnsOnCall(i int, result1 *v1alpha1.
 	}{result1, result2}
 }
 
+func (fake *FakeManager) EvaluateResourceAsync(arg1 context.Context, arg2 *v1alpha1.ResourceEvaluationRequest) (*v1alpha1.ResourceEvaluationResult, error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	ret, specificReturn := fake.evaluateResourceAsyncReturnsOnCall[len(fake.evaluateResourceAsyncArgsForCall)]
+	fake.evaluateResourceAsyncArgsForCall = append(fake.evaluateResourceAsyncArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResourceEvaluationRequest
+	}{arg1, arg2})
+	stub := fake.EvaluateResourceAsyncStub
+	fakeReturns := fake.evaluateResourceAsyncReturns
+	fake.recordInvocation("EvaluateResourceAsync", []interface{}{arg1, arg2})
+	fake.evaluateResourceAsyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) EvaluateResourceAsyncCallCount() int {
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	return len(fake.evaluateResourceAsyncArgsForCall)
+}
+
+func (fake *FakeManager) EvaluateResourceAsyncCalls(stub func(context.Context, *v1alpha1.ResourceEvaluationRequest) (*v1alpha1.ResourceEvaluationResult, error)) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = stub
+}
+
+func (fake *FakeManager) EvaluateResourceAsyncArgsForCall(i int) (context.Context, *v1alpha1.ResourceEvaluationRequest) {
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	argsForCall := fake.evaluateResourceAsyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) EvaluateResourceAsyncReturns(result1 *v1alpha1.ResourceEvaluationResult, result2 error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = nil
+	fake.evaluateResourceAsyncReturns = struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) EvaluateResourceAsyncReturnsOnCall(i int, result1 *v1alpha1.ResourceEvaluationResult, result2 error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = nil
+	if fake.evaluateResourceAsyncReturnsOnCall == nil {
+		fake.evaluateResourceAsyncReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResourceEvaluationResult
+			result2 error
+		})
+	}
+	fake.evaluateResourceAsyncReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) EvaluateResources(arg1 context.Context, arg2 *v1alpha1.EvaluateResourcesRequest) (*v1alpha1.EvaluateResourcesResponse, error) {
+	fake.evaluateResourcesMutex.Lock()
+	ret, specificReturn := fake.evaluateResourcesReturnsOnCall[len(fake.evaluateResourcesArgsForCall)]
+	fake.evaluateResourcesArgsForCall = append(fake.evaluateResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.EvaluateResourcesRequest
+	}{arg1, arg2})
+	stub := fake.EvaluateResourcesStub
+	fakeReturns := fake.evaluateResourcesReturns
+	fake.recordInvocation("EvaluateResources", []interface{}{arg1, arg2})
+	fake.evaluateResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) EvaluateResourcesCallCount() int {
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	return len(fake.evaluateResourcesArgsForCall)
+}
+
+func (fake *FakeManager) EvaluateResourcesCalls(stub func(context.Context, *v1alpha1.EvaluateResourcesRequest) (*v1alpha1.EvaluateResourcesResponse, error)) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = stub
+}
+
+func (fake *FakeManager) EvaluateResourcesArgsForCall(i int) (context.Context, *v1alpha1.EvaluateResourcesRequest) {
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	argsForCall := fake.evaluateResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) EvaluateResourcesReturns(result1 *v1alpha1.EvaluateResourcesResponse, result2 error) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = nil
+	fake.evaluateResourcesReturns = struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) EvaluateResourcesReturnsOnCall(i int, result1 *v1alpha1.EvaluateResourcesResponse, result2 error) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = nil
+	if fake.evaluateResourcesReturnsOnCall == nil {
+		fake.evaluateResourcesReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.EvaluateResourcesResponse
+			result2 error
+		})
+	}
+	fake.evaluateResourcesReturnsOnCall[i] = struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetEvaluationPublicKey(arg1 context.Context, arg2 *v1alpha1.GetEvaluationPublicKeyRequest) (*v1alpha1.EvaluationPublicKey, error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	ret, specificReturn := fake.getEvaluationPublicKeyReturnsOnCall[len(fake.getEvaluationPublicKeyArgsForCall)]
+	fake.getEvaluationPublicKeyArgsForCall = append(fake.getEvaluationPublicKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetEvaluationPublicKeyRequest
+	}{arg1, arg2})
+	stub := fake.GetEvaluationPublicKeyStub
+	fakeReturns := fake.getEvaluationPublicKeyReturns
+	fake.recordInvocation("GetEvaluationPublicKey", []interface{}{arg1, arg2})
+	fake.getEvaluationPublicKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetEvaluationPublicKeyCallCount() int {
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
+	return len(fake.getEvaluationPublicKeyArgsForCall)
+}
+
+func (fake *FakeManager) GetEvaluationPublicKeyCalls(stub func(context.Context, *v1alpha1.GetEvaluationPublicKeyRequest) (*v1alpha1.EvaluationPublicKey, error)) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = stub
+}
+
+func (fake *FakeManager) GetEvaluationPublicKeyArgsForCall(i int) (context.Context, *v1alpha1.GetEvaluationPublicKeyRequest) {
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
+	argsForCall := fake.getEvaluationPublicKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) GetEvaluationPublicKeyReturns(result1 *v1alpha1.EvaluationPublicKey, result2 error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = nil
+	fake.getEvaluationPublicKeyReturns = struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetEvaluationPublicKeyReturnsOnCall(i int, result1 *v1alpha1.EvaluationPublicKey, result2 error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = nil
+	if fake.getEvaluationPublicKeyReturnsOnCall == nil {
+		fake.getEvaluationPublicKeyReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.EvaluationPublicKey
+			result2 error
+		})
+	}
+	fake.getEvaluationPublicKeyReturnsOnCall[i] = struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) GetResourceEvaluation(arg1 context.Context, arg2 *v1alpha1.GetResourceEvaluationRequest) (*v1alpha1.ResourceEvaluationResult, error) {
 	fake.getResourceEvaluationMutex.Lock()
 	ret, specificReturn := fake.getResourceEvaluationReturnsOnCall[len(fake.getResourceEvaluationArgsForCall)]
@@ -265,6 +738,136 @@ func (fake *FakeManager) GetResourceEvaluationReturnsOnCall(i int, result1 *v1al
 	}{result1, result2}
 }
 
+func (fake *FakeManager) GetResourceEvaluationInput(arg1 context.Context, arg2 *v1alpha1.GetResourceEvaluationInputRequest) (*v1alpha1.ResourceEvaluationInput, error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	ret, specificReturn := fake.getResourceEvaluationInputReturnsOnCall[len(fake.getResourceEvaluationInputArgsForCall)]
+	fake.getResourceEvaluationInputArgsForCall = append(fake.getResourceEvaluationInputArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationInputRequest
+	}{arg1, arg2})
+	stub := fake.GetResourceEvaluationInputStub
+	fakeReturns := fake.getResourceEvaluationInputReturns
+	fake.recordInvocation("GetResourceEvaluationInput", []interface{}{arg1, arg2})
+	fake.getResourceEvaluationInputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetResourceEvaluationInputCallCount() int {
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	return len(fake.getResourceEvaluationInputArgsForCall)
+}
+
+func (fake *FakeManager) GetResourceEvaluationInputCalls(stub func(context.Context, *v1alpha1.GetResourceEvaluationInputRequest) (*v1alpha1.ResourceEvaluationInput, error)) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = stub
+}
+
+func (fake *FakeManager) GetResourceEvaluationInputArgsForCall(i int) (context.Context, *v1alpha1.GetResourceEvaluationInputRequest) {
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	argsForCall := fake.getResourceEvaluationInputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) GetResourceEvaluationInputReturns(result1 *v1alpha1.ResourceEvaluationInput, result2 error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = nil
+	fake.getResourceEvaluationInputReturns = struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetResourceEvaluationInputReturnsOnCall(i int, result1 *v1alpha1.ResourceEvaluationInput, result2 error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = nil
+	if fake.getResourceEvaluationInputReturnsOnCall == nil {
+		fake.getResourceEvaluationInputReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResourceEvaluationInput
+			result2 error
+		})
+	}
+	fake.getResourceEvaluationInputReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatement(arg1 context.Context, arg2 *v1alpha1.GetResourceEvaluationStatementRequest) (*v1alpha1.DsseEnvelope, error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	ret, specificReturn := fake.getResourceEvaluationStatementReturnsOnCall[len(fake.getResourceEvaluationStatementArgsForCall)]
+	fake.getResourceEvaluationStatementArgsForCall = append(fake.getResourceEvaluationStatementArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationStatementRequest
+	}{arg1, arg2})
+	stub := fake.GetResourceEvaluationStatementStub
+	fakeReturns := fake.getResourceEvaluationStatementReturns
+	fake.recordInvocation("GetResourceEvaluationStatement", []interface{}{arg1, arg2})
+	fake.getResourceEvaluationStatementMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatementCallCount() int {
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
+	return len(fake.getResourceEvaluationStatementArgsForCall)
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatementCalls(stub func(context.Context, *v1alpha1.GetResourceEvaluationStatementRequest) (*v1alpha1.DsseEnvelope, error)) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = stub
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatementArgsForCall(i int) (context.Context, *v1alpha1.GetResourceEvaluationStatementRequest) {
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
+	argsForCall := fake.getResourceEvaluationStatementArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatementReturns(result1 *v1alpha1.DsseEnvelope, result2 error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = nil
+	fake.getResourceEvaluationStatementReturns = struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetResourceEvaluationStatementReturnsOnCall(i int, result1 *v1alpha1.DsseEnvelope, result2 error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = nil
+	if fake.getResourceEvaluationStatementReturnsOnCall == nil {
+		fake.getResourceEvaluationStatementReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.DsseEnvelope
+			result2 error
+		})
+	}
+	fake.getResourceEvaluationStatementReturnsOnCall[i] = struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) ListResourceEvaluations(arg1 context.Context, arg2 *v1alpha1.ListResourceEvaluationsRequest) (*v1alpha1.ListResourceEvaluationsResponse, error) {
 	fake.listResourceEvaluationsMutex.Lock()
 	ret, specificReturn := fake.listResourceEvaluationsReturnsOnCall[len(fake.listResourceEvaluationsArgsForCall)]
@@ -330,17 +933,297 @@ func (fake *FakeManager) ListResourceEvaluationsReturnsOnCall(i int, result1 *v1
 	}{result1, result2}
 }
 
+func (fake *FakeManager) ListStaleResourceEvaluations(arg1 context.Context, arg2 *v1alpha1.ListStaleResourceEvaluationsRequest) (*v1alpha1.ListStaleResourceEvaluationsResponse, error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	ret, specificReturn := fake.listStaleResourceEvaluationsReturnsOnCall[len(fake.listStaleResourceEvaluationsArgsForCall)]
+	fake.listStaleResourceEvaluationsArgsForCall = append(fake.listStaleResourceEvaluationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListStaleResourceEvaluationsRequest
+	}{arg1, arg2})
+	stub := fake.ListStaleResourceEvaluationsStub
+	fakeReturns := fake.listStaleResourceEvaluationsReturns
+	fake.recordInvocation("ListStaleResourceEvaluations", []interface{}{arg1, arg2})
+	fake.listStaleResourceEvaluationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ListStaleResourceEvaluationsCallCount() int {
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
+	return len(fake.listStaleResourceEvaluationsArgsForCall)
+}
+
+func (fake *FakeManager) ListStaleResourceEvaluationsCalls(stub func(context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest) (*v1alpha1.ListStaleResourceEvaluationsResponse, error)) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = stub
+}
+
+func (fake *FakeManager) ListStaleResourceEvaluationsArgsForCall(i int) (context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest) {
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
+	argsForCall := fake.listStaleResourceEvaluationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) ListStaleResourceEvaluationsReturns(result1 *v1alpha1.ListStaleResourceEvaluationsResponse, result2 error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = nil
+	fake.listStaleResourceEvaluationsReturns = struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListStaleResourceEvaluationsReturnsOnCall(i int, result1 *v1alpha1.ListStaleResourceEvaluationsResponse, result2 error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = nil
+	if fake.listStaleResourceEvaluationsReturnsOnCall == nil {
+		fake.listStaleResourceEvaluationsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+			result2 error
+		})
+	}
+	fake.listStaleResourceEvaluationsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) PolicyAssignmentChanged(arg1 string) {
+	fake.policyAssignmentChangedMutex.Lock()
+	fake.policyAssignmentChangedArgsForCall = append(fake.policyAssignmentChangedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PolicyAssignmentChangedStub
+	fake.recordInvocation("PolicyAssignmentChanged", []interface{}{arg1})
+	fake.policyAssignmentChangedMutex.Unlock()
+	if stub != nil {
+		fake.PolicyAssignmentChangedStub(arg1)
+	}
+}
+
+func (fake *FakeManager) PolicyAssignmentChangedCallCount() int {
+	fake.policyAssignmentChangedMutex.RLock()
+	defer fake.policyAssignmentChangedMutex.RUnlock()
+	return len(fake.policyAssignmentChangedArgsForCall)
+}
+
+func (fake *FakeManager) PolicyAssignmentChangedCalls(stub func(string)) {
+	fake.policyAssignmentChangedMutex.Lock()
+	defer fake.policyAssignmentChangedMutex.Unlock()
+	fake.PolicyAssignmentChangedStub = stub
+}
+
+func (fake *FakeManager) PolicyAssignmentChangedArgsForCall(i int) string {
+	fake.policyAssignmentChangedMutex.RLock()
+	defer fake.policyAssignmentChangedMutex.RUnlock()
+	argsForCall := fake.policyAssignmentChangedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) ReplayResourceEvaluation(arg1 context.Context, arg2 *v1alpha1.ReplayResourceEvaluationRequest) (*v1alpha1.ReplayResourceEvaluationResponse, error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	ret, specificReturn := fake.replayResourceEvaluationReturnsOnCall[len(fake.replayResourceEvaluationArgsForCall)]
+	fake.replayResourceEvaluationArgsForCall = append(fake.replayResourceEvaluationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ReplayResourceEvaluationRequest
+	}{arg1, arg2})
+	stub := fake.ReplayResourceEvaluationStub
+	fakeReturns := fake.replayResourceEvaluationReturns
+	fake.recordInvocation("ReplayResourceEvaluation", []interface{}{arg1, arg2})
+	fake.replayResourceEvaluationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ReplayResourceEvaluationCallCount() int {
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	return len(fake.replayResourceEvaluationArgsForCall)
+}
+
+func (fake *FakeManager) ReplayResourceEvaluationCalls(stub func(context.Context, *v1alpha1.ReplayResourceEvaluationRequest) (*v1alpha1.ReplayResourceEvaluationResponse, error)) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = stub
+}
+
+func (fake *FakeManager) ReplayResourceEvaluationArgsForCall(i int) (context.Context, *v1alpha1.ReplayResourceEvaluationRequest) {
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	argsForCall := fake.replayResourceEvaluationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) ReplayResourceEvaluationReturns(result1 *v1alpha1.ReplayResourceEvaluationResponse, result2 error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = nil
+	fake.replayResourceEvaluationReturns = struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ReplayResourceEvaluationReturnsOnCall(i int, result1 *v1alpha1.ReplayResourceEvaluationResponse, result2 error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = nil
+	if fake.replayResourceEvaluationReturnsOnCall == nil {
+		fake.replayResourceEvaluationReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ReplayResourceEvaluationResponse
+			result2 error
+		})
+	}
+	fake.replayResourceEvaluationReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ScheduleAutoEvaluations(arg1 ...string) {
+	fake.scheduleAutoEvaluationsMutex.Lock()
+	fake.scheduleAutoEvaluationsArgsForCall = append(fake.scheduleAutoEvaluationsArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.ScheduleAutoEvaluationsStub
+	fake.recordInvocation("ScheduleAutoEvaluations", []interface{}{arg1})
+	fake.scheduleAutoEvaluationsMutex.Unlock()
+	if stub != nil {
+		fake.ScheduleAutoEvaluationsStub(arg1...)
+	}
+}
+
+func (fake *FakeManager) ScheduleAutoEvaluationsCallCount() int {
+	fake.scheduleAutoEvaluationsMutex.RLock()
+	defer fake.scheduleAutoEvaluationsMutex.RUnlock()
+	return len(fake.scheduleAutoEvaluationsArgsForCall)
+}
+
+func (fake *FakeManager) ScheduleAutoEvaluationsCalls(stub func(...string)) {
+	fake.scheduleAutoEvaluationsMutex.Lock()
+	defer fake.scheduleAutoEvaluationsMutex.Unlock()
+	fake.ScheduleAutoEvaluationsStub = stub
+}
+
+func (fake *FakeManager) ScheduleAutoEvaluationsArgsForCall(i int) []string {
+	fake.scheduleAutoEvaluationsMutex.RLock()
+	defer fake.scheduleAutoEvaluationsMutex.RUnlock()
+	argsForCall := fake.scheduleAutoEvaluationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) WatchResourceEvaluations(arg1 *v1alpha1.WatchResourceEvaluationsRequest, arg2 v1alpha1.Rode_WatchResourceEvaluationsServer) error {
+	fake.watchResourceEvaluationsMutex.Lock()
+	ret, specificReturn := fake.watchResourceEvaluationsReturnsOnCall[len(fake.watchResourceEvaluationsArgsForCall)]
+	fake.watchResourceEvaluationsArgsForCall = append(fake.watchResourceEvaluationsArgsForCall, struct {
+		arg1 *v1alpha1.WatchResourceEvaluationsRequest
+		arg2 v1alpha1.Rode_WatchResourceEvaluationsServer
+	}{arg1, arg2})
+	stub := fake.WatchResourceEvaluationsStub
+	fakeReturns := fake.watchResourceEvaluationsReturns
+	fake.recordInvocation("WatchResourceEvaluations", []interface{}{arg1, arg2})
+	fake.watchResourceEvaluationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) WatchResourceEvaluationsCallCount() int {
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
+	return len(fake.watchResourceEvaluationsArgsForCall)
+}
+
+func (fake *FakeManager) WatchResourceEvaluationsCalls(stub func(*v1alpha1.WatchResourceEvaluationsRequest, v1alpha1.Rode_WatchResourceEvaluationsServer) error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = stub
+}
+
+func (fake *FakeManager) WatchResourceEvaluationsArgsForCall(i int) (*v1alpha1.WatchResourceEvaluationsRequest, v1alpha1.Rode_WatchResourceEvaluationsServer) {
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
+	argsForCall := fake.watchResourceEvaluationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) WatchResourceEvaluationsReturns(result1 error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = nil
+	fake.watchResourceEvaluationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) WatchResourceEvaluationsReturnsOnCall(i int, result1 error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = nil
+	if fake.watchResourceEvaluationsReturnsOnCall == nil {
+		fake.watchResourceEvaluationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.watchResourceEvaluationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
 	fake.evaluatePolicyMutex.RLock()
 	defer fake.evaluatePolicyMutex.RUnlock()
 	fake.evaluateResourceMutex.RLock()
 	defer fake.evaluateResourceMutex.RUnlock()
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
 	fake.getResourceEvaluationMutex.RLock()
 	defer fake.getResourceEvaluationMutex.RUnlock()
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
 	fake.listResourceEvaluationsMutex.RLock()
 	defer fake.listResourceEvaluationsMutex.RUnlock()
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
+	fake.policyAssignmentChangedMutex.RLock()
+	defer fake.policyAssignmentChangedMutex.RUnlock()
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	fake.scheduleAutoEvaluationsMutex.RLock()
+	defer fake.scheduleAutoEvaluationsMutex.RUnlock()
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
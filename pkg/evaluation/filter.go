@@ -0,0 +1,415 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// queryStringEscapeRegex matches the escaped special characters in the query strings built for the contains function
+var queryStringEscapeRegex = regexp.MustCompile(`\\(.)`)
+
+// newFilterDocument converts a message into the same JSON document that's stored in Elasticsearch, so that a filter
+// query can be matched against it in memory
+func newFilterDocument(message proto.Message) (map[string]interface{}, error) {
+	messageJson, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(messageJson))
+	decoder.UseNumber()
+
+	var document map[string]interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// validateFilterQuery checks that a query produced by filtering.Filterer only uses the clauses that
+// matchesFilterQuery supports, and only refers to fields of the message, so that an unsupported filter is rejected
+// rather than never matching.
+func validateFilterQuery(query interface{}, message protoreflect.MessageDescriptor) error {
+	switch q := query.(type) {
+	case *filtering.Query:
+		return validateQuery(q, message)
+	case *filtering.Bool:
+		return validateBool(q, message)
+	}
+
+	return fmt.Errorf("unsupported filter clause %T", query)
+}
+
+func validateQuery(query *filtering.Query, message protoreflect.MessageDescriptor) error {
+	if query == nil {
+		return nil
+	}
+
+	if query.HasParent != nil {
+		return fmt.Errorf("has_parent filters are not supported")
+	}
+
+	if query.Bool != nil {
+		if err := validateBool(query.Bool, message); err != nil {
+			return err
+		}
+	}
+
+	var fields []string
+	if query.Term != nil {
+		for field := range *query.Term {
+			fields = append(fields, field)
+		}
+	}
+	if query.Prefix != nil {
+		for field := range *query.Prefix {
+			fields = append(fields, field)
+		}
+	}
+	if query.Range != nil {
+		for field := range *query.Range {
+			fields = append(fields, field)
+		}
+	}
+	if query.QueryString != nil {
+		queryString := query.QueryString.Query
+		if len(queryString) < 2 || !strings.HasPrefix(queryString, "*") || !strings.HasSuffix(queryString, "*") {
+			return fmt.Errorf("unsupported query string %q, only contains is supported", queryString)
+		}
+		fields = append(fields, query.QueryString.DefaultField)
+	}
+	if query.Nested != nil {
+		fields = append(fields, query.Nested.Path)
+		if err := validateQuery(query.Nested.Query, message); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range fields {
+		if err := validateFilterField(field, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateBool(query *filtering.Bool, message protoreflect.MessageDescriptor) error {
+	var clauses []interface{}
+	if query.Must != nil {
+		clauses = append(clauses, *query.Must...)
+	}
+	if query.MustNot != nil {
+		clauses = append(clauses, *query.MustNot...)
+	}
+	if query.Should != nil {
+		clauses = append(clauses, *query.Should...)
+	}
+
+	for _, clause := range clauses {
+		if err := validateFilterQuery(clause, message); err != nil {
+			return err
+		}
+	}
+
+	if query.Term != nil {
+		for field := range *query.Term {
+			if err := validateFilterField(field, message); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateFilterField checks that each part of a dotted field path is the JSON name of a field in the message.
+// Well-known types such as timestamps are stored as a single value, so their fields can't be filtered on.
+func validateFilterField(field string, message protoreflect.MessageDescriptor) error {
+	if field == "_id" {
+		return nil
+	}
+
+	current := message
+	for _, name := range strings.Split(field, ".") {
+		if current == nil || strings.HasPrefix(string(current.FullName()), "google.protobuf.") {
+			return fmt.Errorf("unsupported filter field %q", field)
+		}
+
+		fieldDescriptor := current.Fields().ByJSONName(name)
+		if fieldDescriptor == nil {
+			return fmt.Errorf("unknown filter field %q", field)
+		}
+
+		current = fieldDescriptor.Message()
+	}
+
+	return nil
+}
+
+// matchesFilterQuery reports whether the document matches a query produced by filtering.Filterer. It follows the
+// Elasticsearch semantics for the keyword, boolean, numeric and date fields that rode documents are mapped to.
+// The query should be checked with validateFilterQuery first.
+func matchesFilterQuery(query interface{}, document map[string]interface{}) bool {
+	switch q := query.(type) {
+	case *filtering.Query:
+		return matchesQuery(q, document)
+	case *filtering.Bool:
+		return matchesBool(q, document)
+	}
+
+	return false
+}
+
+func matchesQuery(query *filtering.Query, document map[string]interface{}) bool {
+	if query == nil {
+		return true
+	}
+
+	if query.Bool != nil && !matchesBool(query.Bool, document) {
+		return false
+	}
+
+	if query.Term != nil && !matchesFields(*query.Term, document, func(value, expected string) bool {
+		return value == expected
+	}) {
+		return false
+	}
+
+	if query.Prefix != nil && !matchesFields(*query.Prefix, document, strings.HasPrefix) {
+		return false
+	}
+
+	if query.QueryString != nil {
+		substring := queryStringEscapeRegex.ReplaceAllString(strings.Trim(query.QueryString.Query, "*"), "$1")
+		if !anyFieldValue(document, query.QueryString.DefaultField, func(value string) bool {
+			return strings.Contains(value, substring)
+		}) {
+			return false
+		}
+	}
+
+	if query.Range != nil {
+		for field, operator := range *query.Range {
+			if !anyFieldValue(document, field, func(value string) bool {
+				return matchesRange(value, operator)
+			}) {
+				return false
+			}
+		}
+	}
+
+	if query.Nested != nil && !matchesNested(query.Nested, document) {
+		return false
+	}
+
+	// parent documents aren't available in memory, so has_parent filters are rejected by validateFilterQuery
+	return query.HasParent == nil
+}
+
+func matchesBool(query *filtering.Bool, document map[string]interface{}) bool {
+	if query.Must != nil {
+		for _, clause := range *query.Must {
+			if !matchesFilterQuery(clause, document) {
+				return false
+			}
+		}
+	}
+
+	if query.MustNot != nil {
+		for _, clause := range *query.MustNot {
+			if matchesFilterQuery(clause, document) {
+				return false
+			}
+		}
+	}
+
+	if query.Should != nil && len(*query.Should) > 0 {
+		matched := false
+		for _, clause := range *query.Should {
+			if matchesFilterQuery(clause, document) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if query.Term != nil {
+		return matchesFields(*query.Term, document, func(value, expected string) bool {
+			return value == expected
+		})
+	}
+
+	return true
+}
+
+// matchesNested requires a single element of the nested field to match the whole query, rather than each clause
+// matching a different element
+func matchesNested(query *filtering.Nested, document map[string]interface{}) bool {
+	for _, element := range fieldValues(document, query.Path) {
+		scoped := map[string]interface{}{}
+		current := scoped
+		keys := strings.Split(query.Path, ".")
+		for _, key := range keys[:len(keys)-1] {
+			next := map[string]interface{}{}
+			current[key] = next
+			current = next
+		}
+		current[keys[len(keys)-1]] = element
+
+		if matchesQuery(query.Query, scoped) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesFields(terms map[string]string, document map[string]interface{}, match func(value, expected string) bool) bool {
+	for field, expected := range terms {
+		if !anyFieldValue(document, field, func(value string) bool {
+			return match(value, expected)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// anyFieldValue reports whether any of the values of a field match. As in Elasticsearch, a field inside an array is
+// treated as having every value found in the array's elements.
+func anyFieldValue(document map[string]interface{}, field string, match func(value string) bool) bool {
+	if field == "_id" {
+		field = "id"
+	}
+
+	for _, value := range fieldValues(document, field) {
+		if stringValue, ok := filterValueString(value); ok && match(stringValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fieldValues(document map[string]interface{}, field string) []interface{} {
+	values := []interface{}{document}
+	for _, key := range strings.Split(field, ".") {
+		var next []interface{}
+		for _, value := range values {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch child := object[key].(type) {
+			case nil:
+			case []interface{}:
+				next = append(next, child...)
+			default:
+				next = append(next, child)
+			}
+		}
+		values = next
+	}
+
+	return values
+}
+
+func filterValueString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	}
+
+	return "", false
+}
+
+func matchesRange(value string, operator *filtering.RangeOperator) bool {
+	bounds := []struct {
+		bound string
+		match func(comparison int) bool
+	}{
+		{operator.Greater, func(c int) bool { return c > 0 }},
+		{operator.GreaterEquals, func(c int) bool { return c >= 0 }},
+		{operator.Less, func(c int) bool { return c < 0 }},
+		{operator.LessEquals, func(c int) bool { return c <= 0 }},
+	}
+
+	for _, b := range bounds {
+		if b.bound != "" && !b.match(compareFilterValues(value, b.bound)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareFilterValues compares numbers and RFC 3339 timestamps by value, and everything else as strings
+func compareFilterValues(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return compareFloats(x, y)
+		}
+	}
+
+	if x, err := time.Parse(time.RFC3339, a); err == nil {
+		if y, err := time.Parse(time.RFC3339, b); err == nil {
+			switch {
+			case x.Before(y):
+				return -1
+			case x.After(y):
+				return 1
+			}
+
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+func compareFloats(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+
+	return 0
+}
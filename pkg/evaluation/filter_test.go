@@ -0,0 +1,123 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("in-memory filters", func() {
+	var document map[string]interface{}
+
+	BeforeEach(func() {
+		var err error
+		document, err = newFilterDocument(&pb.ResourceEvaluation{
+			Id:          "abc-123",
+			PolicyGroup: "prod",
+			State:       pb.ResourceEvaluationState_COMPLETE,
+			Created:     timestamppb.New(time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)),
+			ResourceVersion: &pb.ResourceVersion{
+				Version: "harbor.example.com/app@sha256:123",
+				Names:   []string{"harbor.example.com/app:latest", "harbor.example.com/app:v1"},
+			},
+			SkippedPolicies: []*pb.SkippedPolicy{
+				{PolicyAssignmentId: "first", PolicyVersionId: "policy.1"},
+				{PolicyAssignmentId: "second", PolicyVersionId: "policy.2"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("matching", func(expression string, expected bool) {
+		query, err := filtering.NewFilterer().ParseExpression(expression)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(matchesFilterQuery(query, document)).To(Equal(expected))
+	},
+		Entry("equal string", `policyGroup == "prod"`, true),
+		Entry("different string", `policyGroup == "staging"`, false),
+		Entry("unpopulated boolean", `pass == "false"`, true),
+		Entry("enum", `state == "COMPLETE"`, true),
+		Entry("id", `id == "abc-123"`, true),
+		Entry("not equal", `policyGroup != "prod"`, false),
+		Entry("and", `policyGroup == "prod" && state == "FAILED"`, false),
+		Entry("or", `policyGroup == "staging" || state == "COMPLETE"`, true),
+		Entry("nested field", `resourceVersion.version == "harbor.example.com/app@sha256:123"`, true),
+		Entry("array element", `resourceVersion.names == "harbor.example.com/app:v1"`, true),
+		Entry("starts with", `resourceVersion.version.startsWith("harbor.example.com/")`, true),
+		Entry("contains", `resourceVersion.version.contains("app@sha256")`, true),
+		Entry("does not contain", `resourceVersion.version.contains("other")`, false),
+		Entry("date after", `created > "2021-05-31T00:00:00Z"`, true),
+		Entry("date before", `created < "2021-05-31T00:00:00Z"`, false),
+		Entry("nested filter on one element", `skippedPolicies.nestedFilter(policyAssignmentId == "first" && policyVersionId == "policy.1")`, true),
+		Entry("nested filter across elements", `skippedPolicies.nestedFilter(policyAssignmentId == "first" && policyVersionId == "policy.2")`, false),
+		Entry("unpopulated field", `errorMessage == "boom"`, false),
+	)
+
+	DescribeTable("validation", func(expression string, valid bool) {
+		query, err := filtering.NewFilterer().ParseExpression(expression)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = validateFilterQuery(query, (&pb.ResourceEvaluation{}).ProtoReflect().Descriptor())
+
+		if valid {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("known field", `policyGroup == "prod"`, true),
+		Entry("id", `id == "abc-123"`, true),
+		Entry("nested field", `resourceVersion.version.startsWith("harbor")`, true),
+		Entry("boolean expression", `policyGroup == "prod" && (pass == "false" || state != "COMPLETE")`, true),
+		Entry("nested filter", `skippedPolicies.nestedFilter(policyAssignmentId == "first")`, true),
+		Entry("date range", `created > "2021-05-31T00:00:00Z"`, true),
+		Entry("unknown field", `policy_group == "prod"`, false),
+		Entry("unknown nested field", `resourceVersion.digest == "sha256:123"`, false),
+		Entry("unknown field in a boolean expression", `policyGroup == "prod" || other == "value"`, false),
+		Entry("unknown field in a nested filter", `skippedPolicies.nestedFilter(other == "first")`, false),
+		Entry("field of a scalar", `policyGroup.name == "prod"`, false),
+		Entry("field of a timestamp", `created.seconds > "0"`, false),
+	)
+
+	It("should reject has_parent filters", func() {
+		query := &filtering.Query{
+			HasParent: &filtering.HasParent{
+				ParentType: "resource",
+				Query:      &filtering.Query{Term: &filtering.Term{"policyGroup": "prod"}},
+			},
+		}
+
+		Expect(validateFilterQuery(query, (&pb.ResourceEvaluation{}).ProtoReflect().Descriptor())).NotTo(Succeed())
+	})
+
+	It("should reject query strings other than contains", func() {
+		query := &filtering.Query{
+			QueryString: &filtering.QueryString{
+				DefaultField: "policyGroup",
+				Query:        "prod OR staging",
+			},
+		}
+
+		Expect(validateFilterQuery(query, (&pb.ResourceEvaluation{}).ProtoReflect().Descriptor())).NotTo(Succeed())
+	})
+})
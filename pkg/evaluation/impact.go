@@ -0,0 +1,262 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultImpactAnalysisLimit = 10
+	maxImpactAnalysisLimit     = 100
+)
+
+func (m *manager) AnalyzePolicyAssignmentImpact(ctx context.Context, request *pb.AnalyzePolicyAssignmentImpactRequest) (*pb.AnalyzePolicyAssignmentImpactResponse, error) {
+	log := m.logger.Named("AnalyzePolicyAssignmentImpact").With(zap.Any("request", request))
+
+	if request.Id == "" {
+		return nil, util.GrpcErrorWithCode(log, "policy assignment id is required", nil, codes.InvalidArgument)
+	}
+
+	if request.PolicyVersionId == "" {
+		return nil, util.GrpcErrorWithCode(log, "policy version id is required", nil, codes.InvalidArgument)
+	}
+
+	limit := int(request.Limit)
+	if limit < 0 || limit > maxImpactAnalysisLimit {
+		return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("limit must be between 0 and %d", maxImpactAnalysisLimit), nil, codes.InvalidArgument)
+	}
+	if limit == 0 {
+		limit = defaultImpactAnalysisLimit
+	}
+
+	policyAssignment, err := m.policyAssignmentManager.GetPolicyAssignment(ctx, &pb.GetPolicyAssignmentRequest{Id: request.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if policyIdFromVersionId(request.PolicyVersionId) != policyIdFromVersionId(policyAssignment.PolicyVersionId) {
+		return nil, util.GrpcErrorWithCode(log, "candidate policy version must be a version of the assigned policy", nil, codes.InvalidArgument)
+	}
+
+	currentPolicy, err := m.getPolicyVersion(ctx, log, policyAssignment.PolicyVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	candidatePolicy, err := m.getPolicyVersion(ctx, log, request.PolicyVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyGroups, err := m.policyGroupsUsingAssignment(ctx, log, policyAssignment)
+	if err != nil {
+		return nil, err
+	}
+
+	// the limit is applied after the selector, so that resource versions the assignment doesn't apply to aren't counted
+	var latestResourceEvaluations []*pb.ResourceEvaluation
+	for _, policyGroup := range policyGroups {
+		groupResourceEvaluations, err := m.listLatestResourceEvaluations(ctx, log, policyGroup, time.Time{}, constants.MaxPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		latestResourceEvaluations = append(latestResourceEvaluations, groupResourceEvaluations...)
+	}
+	sort.SliceStable(latestResourceEvaluations, func(i, j int) bool {
+		return latestResourceEvaluations[i].Created.AsTime().After(latestResourceEvaluations[j].Created.AsTime())
+	})
+
+	var resourceEvaluations []*pb.ResourceEvaluation
+	for _, resourceEvaluation := range latestResourceEvaluations {
+		if len(resourceEvaluations) == limit {
+			break
+		}
+
+		if policyAssignmentSelectsResource(policyAssignment, resourceEvaluation.ResourceVersion) {
+			resourceEvaluations = append(resourceEvaluations, resourceEvaluation)
+		}
+	}
+
+	response := &pb.AnalyzePolicyAssignmentImpactResponse{
+		PolicyAssignment: policyAssignment,
+		PolicyVersionId:  request.PolicyVersionId,
+	}
+	for _, resourceEvaluation := range resourceEvaluations {
+		occurrences, err := m.replayOccurrences(ctx, log, resourceEvaluation)
+		if err != nil {
+			return nil, err
+		}
+		input := &pb.EvaluatePolicyInput{
+			Occurrences: occurrences,
+		}
+
+		// both versions are evaluated against the same input, so that only the policy changes are reflected in the result
+		currentResponse, err := m.evaluatePolicy(ctx, policyAssignment.PolicyVersionId, currentPolicy.RegoContent, input)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, fmt.Sprintf("error evaluating policy version %s", policyAssignment.PolicyVersionId), err)
+		}
+
+		candidateResponse, err := m.evaluatePolicy(ctx, request.PolicyVersionId, candidatePolicy.RegoContent, input)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, fmt.Sprintf("error evaluating policy version %s", request.PolicyVersionId), err)
+		}
+
+		currentResult := policyResult(currentResponse)
+		candidateResult := policyResult(candidateResponse)
+		impact := &pb.PolicyVersionImpact{
+			ResourceVersion:      resourceEvaluation.ResourceVersion,
+			ResourceEvaluationId: resourceEvaluation.Id,
+			PolicyGroup:          resourceEvaluation.PolicyGroup,
+			CurrentPass:          currentResult.Pass,
+			CandidatePass:        candidateResult.Pass,
+			Violations:           diffViolations(currentResult.Violations, candidateResult.Violations),
+		}
+
+		if impact.CurrentPass && !impact.CandidatePass {
+			response.NewlyFailing++
+		}
+		if !impact.CurrentPass && impact.CandidatePass {
+			response.NewlyPassing++
+		}
+
+		response.Impacts = append(response.Impacts, impact)
+	}
+
+	return response, nil
+}
+
+// policyGroupsUsingAssignment returns the policy assignment's policy group, along with the descendants that inherit the
+// assignment. Descendants that assign the same policy themselves, or inherit it from a closer ancestor, are left out.
+func (m *manager) policyGroupsUsingAssignment(ctx context.Context, log *zap.Logger, policyAssignment *pb.PolicyAssignment) ([]string, error) {
+	policyGroups := []string{policyAssignment.PolicyGroup}
+
+	for _, policyGroup := range m.inheritingPolicyGroups(ctx, log, policyAssignment.PolicyGroup)[1:] {
+		response, err := m.policyGroupManager.ResolvePolicyGroupAssignments(ctx, &pb.ResolvePolicyGroupAssignmentsRequest{Name: policyGroup})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resolvedAssignment := range response.PolicyAssignments {
+			if resolvedAssignment.Id == policyAssignment.Id {
+				policyGroups = append(policyGroups, policyGroup)
+				break
+			}
+		}
+	}
+
+	return policyGroups, nil
+}
+
+func (m *manager) getPolicyVersion(ctx context.Context, log *zap.Logger, policyVersionId string) (*pb.PolicyEntity, error) {
+	policyEntity, err := m.policyManager.GetPolicyVersion(ctx, policyVersionId)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error fetching policy version", err)
+	}
+	if policyEntity == nil {
+		return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("policy version %s not found", policyVersionId), nil, codes.NotFound)
+	}
+
+	return policyEntity, nil
+}
+
+// listLatestResourceEvaluations returns the most recent completed resource evaluation in the policy group for each of the
+// most recently evaluated resource versions. When since is set, only resource versions evaluated after that time are included.
+func (m *manager) listLatestResourceEvaluations(ctx context.Context, log *zap.Logger, policyGroup string, since time.Time, limit int) ([]*pb.ResourceEvaluation, error) {
+	queries := filtering.Must{
+		&filtering.Query{
+			Term: &filtering.Term{
+				"policyGroup": policyGroup,
+			},
+		},
+	}
+
+	if !since.IsZero() {
+		queries = append(queries, &filtering.Query{
+			Range: &filtering.Range{
+				"created": &filtering.RangeOperator{
+					GreaterEquals: since.UTC().Format(time.RFC3339),
+				},
+			},
+		})
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, &esutil.SearchRequest{
+		Index: m.indexManager.AliasName(constants.EvaluationsDocumentKind, ""),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Bool: &filtering.Bool{
+					Must: &queries,
+					MustNot: &filtering.MustNot{
+						&filtering.Query{
+							Term: &filtering.Term{
+								"state": pb.ResourceEvaluationState_PENDING.String(),
+							},
+						},
+						&filtering.Query{
+							Term: &filtering.Term{
+								"state": pb.ResourceEvaluationState_RUNNING.String(),
+							},
+						},
+						&filtering.Query{
+							Term: &filtering.Term{
+								"state": pb.ResourceEvaluationState_FAILED.String(),
+							},
+						},
+					},
+				},
+			},
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+			Collapse: &esutil.EsSearchCollapse{
+				Field: "resourceVersion.version",
+			},
+		},
+	})
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error searching for resource evaluations", err)
+	}
+
+	var resourceEvaluations []*pb.ResourceEvaluation
+	for _, hit := range searchResponse.Hits.Hits {
+		if len(resourceEvaluations) == limit {
+			break
+		}
+
+		var resourceEvaluation pb.ResourceEvaluation
+		err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(hit.Source, &resourceEvaluation)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, "error unmarshalling resource evaluation", err)
+		}
+
+		resourceEvaluations = append(resourceEvaluations, &resourceEvaluation)
+	}
+
+	return resourceEvaluations, nil
+}
@@ -0,0 +1,462 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/opa"
+	"github.com/rode/rode/opa/opafakes"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("policy assignment impact analysis", func() {
+	var (
+		ctx context.Context
+
+		esClient                *esutilfakes.FakeClient
+		policyManager           *policyfakes.FakeManager
+		policyGroupManager      *policyfakes.FakePolicyGroupManager
+		policyAssignmentManager *policyfakes.FakeAssignmentManager
+		opaClient               *opafakes.FakeClient
+		indexManager            *mocks.FakeIndexManager
+
+		evaluationManager Manager
+
+		request                  *pb.AnalyzePolicyAssignmentImpactRequest
+		policyAssignment         *pb.PolicyAssignment
+		candidatePolicyVersionId string
+		resourceEvaluations      []*pb.ResourceEvaluation
+		currentResults           map[string]bool
+		candidateResults         map[string]bool
+		expectedEvaluationsAlias string
+		getPolicyAssignmentError error
+		searchError              error
+		missingPolicyVersionId   string
+
+		actualResponse *pb.AnalyzePolicyAssignmentImpactResponse
+		actualError    error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		esClient = &esutilfakes.FakeClient{}
+		policyManager = &policyfakes.FakeManager{}
+		policyGroupManager = &policyfakes.FakePolicyGroupManager{}
+		policyAssignmentManager = &policyfakes.FakeAssignmentManager{}
+		opaClient = &opafakes.FakeClient{}
+		indexManager = &mocks.FakeIndexManager{}
+
+		expectedEvaluationsAlias = fake.LetterN(10)
+		indexManager.AliasNameReturns(expectedEvaluationsAlias)
+
+		policyId := fake.UUID()
+		policyAssignment = &pb.PolicyAssignment{
+			Id:              fake.UUID(),
+			PolicyVersionId: fmt.Sprintf("%s.1", policyId),
+			PolicyGroup:     fake.LetterN(10),
+		}
+		candidatePolicyVersionId = fmt.Sprintf("%s.2", policyId)
+
+		resourceEvaluations = nil
+		currentResults = map[string]bool{}
+		candidateResults = map[string]bool{}
+		// the first resource starts failing, the second starts passing, and the third is unchanged. The most recent
+		// evaluation comes first, as it does from Elasticsearch.
+		for i, results := range [][2]bool{{true, false}, {false, true}, {true, true}} {
+			resourceEvaluation := &pb.ResourceEvaluation{
+				Id:          fake.UUID(),
+				PolicyGroup: policyAssignment.PolicyGroup,
+				Created:     timestamppb.New(time.Now().Add(-time.Duration(i) * time.Minute)),
+				ResourceVersion: &pb.ResourceVersion{
+					Version: fmt.Sprintf("%s-%d", fake.URL(), i),
+				},
+			}
+			resourceEvaluations = append(resourceEvaluations, resourceEvaluation)
+			currentResults[resourceEvaluation.Id] = results[0]
+			candidateResults[resourceEvaluation.Id] = results[1]
+		}
+
+		request = &pb.AnalyzePolicyAssignmentImpactRequest{
+			Id:              policyAssignment.Id,
+			PolicyVersionId: candidatePolicyVersionId,
+		}
+
+		getPolicyAssignmentError = nil
+		searchError = nil
+		missingPolicyVersionId = ""
+
+		policyGroupManager.ListPolicyGroupsReturns(&pb.ListPolicyGroupsResponse{}, nil)
+		policyManager.GetPolicyVersionStub = func(_ context.Context, id string) (*pb.PolicyEntity, error) {
+			if id == missingPolicyVersionId {
+				return nil, nil
+			}
+
+			return &pb.PolicyEntity{Id: id, RegoContent: id}, nil
+		}
+		// the stored input for each resource evaluation contains a single occurrence named after the evaluation
+		esClient.GetStub = func(_ context.Context, request *esutil.GetRequest) (*esutil.EsGetResponse, error) {
+			occurrence := &grafeas_proto.Occurrence{
+				Name: request.DocumentId,
+				Kind: grafeas_common_proto.NoteKind_BUILD,
+			}
+			source, _ := protojson.Marshal(&pb.ResourceEvaluationInput{
+				ResourceEvaluationId: request.DocumentId,
+				Input: &pb.EvaluatePolicyInput{
+					Occurrences: []*grafeas_proto.Occurrence{occurrence},
+				},
+			})
+
+			return &esutil.EsGetResponse{Found: true, Source: source}, nil
+		}
+		opaClient.EvaluatePolicyStub = func(_ context.Context, policyVersionId, _ string, input []byte) (*opa.EvaluatePolicyResponse, error) {
+			var evaluatePolicyInput pb.EvaluatePolicyInput
+			Expect(protojson.Unmarshal(input, &evaluatePolicyInput)).To(Succeed())
+			resourceEvaluationId := evaluatePolicyInput.Occurrences[0].Name
+
+			pass := currentResults[resourceEvaluationId]
+			if policyVersionId == candidatePolicyVersionId {
+				pass = candidateResults[resourceEvaluationId]
+			}
+
+			return &opa.EvaluatePolicyResponse{
+				Result: &opa.EvaluatePolicyResult{
+					Pass: pass,
+					Violations: []*pb.EvaluatePolicyViolation{
+						{Id: "rule", Pass: pass},
+					},
+				},
+			}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		policyAssignmentManager.GetPolicyAssignmentReturns(policyAssignment, getPolicyAssignmentError)
+
+		esClient.SearchStub = func(_ context.Context, searchRequest *esutil.SearchRequest) (*esutil.SearchResponse, error) {
+			if searchError != nil {
+				return nil, searchError
+			}
+
+			policyGroup := (*searchRequest.Search.Query.Bool.Must)[0].(*filtering.Query).Term
+			var hits []*esutil.EsSearchResponseHit
+			for _, resourceEvaluation := range resourceEvaluations {
+				if (*policyGroup)["policyGroup"] != resourceEvaluation.PolicyGroup {
+					continue
+				}
+
+				source, _ := protojson.Marshal(resourceEvaluation)
+				hits = append(hits, &esutil.EsSearchResponseHit{ID: resourceEvaluation.Id, Source: source})
+			}
+
+			return &esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Total: &esutil.EsSearchResponseTotal{Value: len(hits)},
+					Hits:  hits,
+				},
+			}, nil
+		}
+
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient:                esClient,
+			evaluationConfig:        &config.EvaluationConfig{MaxOccurrences: 100},
+			policyManager:           policyManager,
+			policyGroupManager:      policyGroupManager,
+			policyAssignmentManager: policyAssignmentManager,
+			opa:                     opaClient,
+			indexManager:            indexManager,
+		})
+		actualResponse, actualError = evaluationManager.AnalyzePolicyAssignmentImpact(ctx, request)
+	})
+
+	It("should fetch the policy assignment", func() {
+		Expect(policyAssignmentManager.GetPolicyAssignmentCallCount()).To(Equal(1))
+
+		_, getPolicyAssignmentRequest := policyAssignmentManager.GetPolicyAssignmentArgsForCall(0)
+		Expect(getPolicyAssignmentRequest.Id).To(Equal(policyAssignment.Id))
+	})
+
+	It("should search for the latest evaluation of each resource version in the policy group", func() {
+		Expect(esClient.SearchCallCount()).To(Equal(1))
+
+		_, searchRequest := esClient.SearchArgsForCall(0)
+		Expect(searchRequest.Index).To(Equal(expectedEvaluationsAlias))
+		Expect(searchRequest.Search.Collapse.Field).To(Equal("resourceVersion.version"))
+		Expect(searchRequest.Search.Sort["created"]).To(Equal(esutil.EsSortOrderDescending))
+
+		must := *searchRequest.Search.Query.Bool.Must
+		Expect(must[0].(*filtering.Query).Term).To(Equal(&filtering.Term{"policyGroup": policyAssignment.PolicyGroup}))
+	})
+
+	It("should evaluate the assigned and candidate policy versions for each resource version", func() {
+		Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(len(resourceEvaluations) * 2))
+	})
+
+	It("should report the resource versions whose results would change", func() {
+		Expect(actualError).NotTo(HaveOccurred())
+		Expect(actualResponse.PolicyAssignment).To(Equal(policyAssignment))
+		Expect(actualResponse.PolicyVersionId).To(Equal(candidatePolicyVersionId))
+		Expect(actualResponse.NewlyFailing).To(BeEquivalentTo(1))
+		Expect(actualResponse.NewlyPassing).To(BeEquivalentTo(1))
+		Expect(actualResponse.Impacts).To(HaveLen(len(resourceEvaluations)))
+
+		for i, impact := range actualResponse.Impacts {
+			resourceEvaluation := resourceEvaluations[i]
+
+			Expect(impact.ResourceEvaluationId).To(Equal(resourceEvaluation.Id))
+			Expect(impact.PolicyGroup).To(Equal(policyAssignment.PolicyGroup))
+			Expect(impact.ResourceVersion.Version).To(Equal(resourceEvaluation.ResourceVersion.Version))
+			Expect(impact.CurrentPass).To(Equal(currentResults[resourceEvaluation.Id]))
+			Expect(impact.CandidatePass).To(Equal(candidateResults[resourceEvaluation.Id]))
+		}
+
+		Expect(actualResponse.Impacts[0].Violations[0].Change).To(Equal(pb.EvaluatePolicyViolationChange_NOW_FAILING))
+		Expect(actualResponse.Impacts[1].Violations[0].Change).To(Equal(pb.EvaluatePolicyViolationChange_NOW_PASSING))
+		Expect(actualResponse.Impacts[2].Violations[0].Change).To(Equal(pb.EvaluatePolicyViolationChange_UNCHANGED))
+	})
+
+	It("should not store anything", func() {
+		Expect(esClient.BulkCallCount()).To(BeZero())
+		Expect(esClient.CreateCallCount()).To(BeZero())
+	})
+
+	When("a limit is specified", func() {
+		BeforeEach(func() {
+			request.Limit = 2
+		})
+
+		It("should only analyze that many resource versions", func() {
+			Expect(actualResponse.Impacts).To(HaveLen(2))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(4))
+		})
+	})
+
+	When("the policy assignment has a selector", func() {
+		BeforeEach(func() {
+			policyAssignment.Selector = &pb.PolicyAssignmentSelector{
+				ResourceTypes: []pb.ResourceType{pb.ResourceType_DOCKER},
+			}
+			resourceEvaluations[1].ResourceVersion.Version = "harbor.localhost/rode-demo/node-app@sha256:" + fake.LetterN(64)
+		})
+
+		It("should only analyze the resource versions that the selector matches", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Impacts).To(HaveLen(1))
+			Expect(actualResponse.Impacts[0].ResourceEvaluationId).To(Equal(resourceEvaluations[1].Id))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(2))
+		})
+
+		When("a limit is specified", func() {
+			BeforeEach(func() {
+				request.Limit = 1
+				resourceEvaluations[0].ResourceVersion.Version = "harbor.localhost/rode-demo/other-app@sha256:" + fake.LetterN(64)
+				resourceEvaluations[0], resourceEvaluations[2] = resourceEvaluations[2], resourceEvaluations[0]
+				resourceEvaluations[0].Created, resourceEvaluations[2].Created = resourceEvaluations[2].Created, resourceEvaluations[0].Created
+			})
+
+			It("should not count the resource versions that the selector doesn't match", func() {
+				Expect(actualResponse.Impacts).To(HaveLen(1))
+				Expect(actualResponse.Impacts[0].ResourceEvaluationId).To(Equal(resourceEvaluations[1].Id))
+			})
+		})
+	})
+
+	When("descendant policy groups inherit the assignment", func() {
+		var (
+			childPolicyGroup      string
+			overridingPolicyGroup string
+			resolveError          error
+		)
+
+		BeforeEach(func() {
+			childPolicyGroup = fake.LetterN(10)
+			overridingPolicyGroup = fake.LetterN(10)
+			resolveError = nil
+			policyGroupManager.ListPolicyGroupsReturns(&pb.ListPolicyGroupsResponse{
+				PolicyGroups: []*pb.PolicyGroup{
+					{Name: policyAssignment.PolicyGroup},
+					{Name: childPolicyGroup, Parents: []string{policyAssignment.PolicyGroup}},
+					{Name: overridingPolicyGroup, Parents: []string{childPolicyGroup}},
+				},
+			}, nil)
+			policyGroupManager.ResolvePolicyGroupAssignmentsStub = func(_ context.Context, request *pb.ResolvePolicyGroupAssignmentsRequest) (*pb.ResolvePolicyGroupAssignmentsResponse, error) {
+				if resolveError != nil {
+					return nil, resolveError
+				}
+
+				resolvedAssignment := policyAssignment
+				if request.Name == overridingPolicyGroup {
+					resolvedAssignment = &pb.PolicyAssignment{
+						Id:              fake.UUID(),
+						PolicyVersionId: policyAssignment.PolicyVersionId,
+						PolicyGroup:     overridingPolicyGroup,
+					}
+				}
+
+				return &pb.ResolvePolicyGroupAssignmentsResponse{
+					PolicyAssignments: []*pb.PolicyAssignment{resolvedAssignment},
+				}, nil
+			}
+
+			// the newest evaluation is in the child policy group, and the overriding policy group isn't analyzed
+			resourceEvaluations[0].PolicyGroup = childPolicyGroup
+			resourceEvaluations[0].Created = timestamppb.New(time.Now().Add(time.Hour))
+			resourceEvaluations[2].PolicyGroup = overridingPolicyGroup
+		})
+
+		It("should search the policy groups that use the assignment", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(2))
+
+			_, searchRequest := esClient.SearchArgsForCall(1)
+			must := *searchRequest.Search.Query.Bool.Must
+			Expect(must[0].(*filtering.Query).Term).To(Equal(&filtering.Term{"policyGroup": childPolicyGroup}))
+		})
+
+		It("should analyze the resource versions evaluated in those policy groups, most recent first", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Impacts).To(HaveLen(2))
+			Expect(actualResponse.Impacts[0].ResourceEvaluationId).To(Equal(resourceEvaluations[0].Id))
+			Expect(actualResponse.Impacts[0].PolicyGroup).To(Equal(childPolicyGroup))
+			Expect(actualResponse.Impacts[1].ResourceEvaluationId).To(Equal(resourceEvaluations[1].Id))
+			Expect(actualResponse.Impacts[1].PolicyGroup).To(Equal(policyAssignment.PolicyGroup))
+		})
+
+		When("the assignments of a descendant cannot be resolved", func() {
+			BeforeEach(func() {
+				resolveError = status.Error(codes.Internal, fake.Word())
+			})
+
+			It("should return the error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	When("a policy version doesn't produce a result", func() {
+		BeforeEach(func() {
+			opaClient.EvaluatePolicyStub = nil
+			opaClient.EvaluatePolicyReturns(&opa.EvaluatePolicyResponse{}, nil)
+		})
+
+		It("should report it as failing", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Impacts).To(HaveLen(len(resourceEvaluations)))
+			Expect(actualResponse.Impacts[0].CurrentPass).To(BeFalse())
+			Expect(actualResponse.Impacts[0].CandidatePass).To(BeFalse())
+		})
+	})
+
+	When("the candidate policy version belongs to a different policy", func() {
+		BeforeEach(func() {
+			request.PolicyVersionId = fmt.Sprintf("%s.1", fake.UUID())
+		})
+
+		It("should return an invalid argument error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
+			Expect(esClient.SearchCallCount()).To(BeZero())
+		})
+	})
+
+	When("the policy assignment cannot be found", func() {
+		BeforeEach(func() {
+			getPolicyAssignmentError = status.Error(codes.NotFound, fake.Word())
+		})
+
+		It("should return the error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			Expect(esClient.SearchCallCount()).To(BeZero())
+		})
+	})
+
+	When("the candidate policy version does not exist", func() {
+		BeforeEach(func() {
+			missingPolicyVersionId = candidatePolicyVersionId
+		})
+
+		It("should return a not found error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+		})
+	})
+
+	When("searching for resource evaluations fails", func() {
+		BeforeEach(func() {
+			searchError = errors.New(fake.Word())
+		})
+
+		It("should return an error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+		})
+	})
+
+	When("no resource versions have been evaluated in the policy group", func() {
+		BeforeEach(func() {
+			resourceEvaluations = nil
+		})
+
+		It("should return an empty analysis", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Impacts).To(BeEmpty())
+			Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+		})
+	})
+
+	DescribeTable("invalid requests",
+		func(modifyRequest func(*pb.AnalyzePolicyAssignmentImpactRequest)) {
+			modifyRequest(request)
+
+			response, err := evaluationManager.AnalyzePolicyAssignmentImpact(ctx, request)
+
+			Expect(response).To(BeNil())
+			Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+		},
+		Entry("missing assignment id", func(request *pb.AnalyzePolicyAssignmentImpactRequest) {
+			request.Id = ""
+		}),
+		Entry("missing policy version id", func(request *pb.AnalyzePolicyAssignmentImpactRequest) {
+			request.PolicyVersionId = ""
+		}),
+		Entry("negative limit", func(request *pb.AnalyzePolicyAssignmentImpactRequest) {
+			request.Limit = -1
+		}),
+		Entry("limit too large", func(request *pb.AnalyzePolicyAssignmentImpactRequest) {
+			request.Limit = maxImpactAnalysisLimit + 1
+		}),
+	)
+})
@@ -0,0 +1,138 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// hashEvaluatePolicyInput returns the hex-encoded SHA-256 digest of the canonical JSON encoding of the input: the
+// protojson encoding, re-encoded with sorted keys, no insignificant whitespace and no HTML escaping. protojson output
+// is intentionally unstable and the deterministic protobuf encoding isn't canonical across versions, so neither is
+// hashed directly.
+func hashEvaluatePolicyInput(input *pb.EvaluatePolicyInput) (string, error) {
+	data, err := canonicalJson(input)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// canonicalJson encodes the message with protojson, then decodes and re-encodes it with encoding/json, which sorts
+// object keys and doesn't add whitespace. Numbers are kept as they were written by protojson.
+func canonicalJson(message proto.Message) ([]byte, error) {
+	messageJson, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(messageJson))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// recordResourceEvaluationInput builds the policy input from the occurrences, records the occurrence names and input hash
+// on the resource evaluation, and stores the input so that it can be retrieved later. It returns the input to evaluate.
+func (m *manager) recordResourceEvaluationInput(ctx context.Context, log *zap.Logger, resourceEvaluation *pb.ResourceEvaluation, occurrences []*grafeas_go_proto.Occurrence) (*pb.EvaluatePolicyInput, error) {
+	input := &pb.EvaluatePolicyInput{
+		Occurrences: occurrences,
+	}
+
+	inputHash, err := hashEvaluatePolicyInput(input)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error hashing policy input", err)
+	}
+
+	occurrenceNames := make([]string, len(occurrences))
+	for i, occurrence := range occurrences {
+		occurrenceNames[i] = occurrence.Name
+	}
+	resourceEvaluation.OccurrenceNames = occurrenceNames
+	resourceEvaluation.InputHash = inputHash
+
+	if _, err := m.esClient.Create(ctx, &esutil.CreateRequest{
+		Index:      m.indexManager.AliasName(constants.EvaluationInputsDocumentKind, ""),
+		Refresh:    m.esConfig.Refresh.String(),
+		DocumentId: resourceEvaluation.Id,
+		Message: &pb.ResourceEvaluationInput{
+			ResourceEvaluationId: resourceEvaluation.Id,
+			InputHash:            inputHash,
+			Input:                input,
+			Created:              timestamppb.Now(),
+		},
+	}); err != nil {
+		return nil, util.GrpcInternalError(log, "error storing resource evaluation input", err)
+	}
+
+	return input, nil
+}
+
+func (m *manager) GetResourceEvaluationInput(ctx context.Context, request *pb.GetResourceEvaluationInputRequest) (*pb.ResourceEvaluationInput, error) {
+	log := m.logger.Named("GetResourceEvaluationInput").With(zap.String("id", request.Id))
+
+	if request.Id == "" {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation id is required", nil, codes.InvalidArgument)
+	}
+
+	response, err := m.esClient.Get(ctx, &esutil.GetRequest{
+		Index:      m.indexManager.AliasName(constants.EvaluationInputsDocumentKind, ""),
+		DocumentId: request.Id,
+	})
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error retrieving resource evaluation input", err)
+	}
+
+	if !response.Found {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation input not found", nil, codes.NotFound)
+	}
+
+	var resourceEvaluationInput pb.ResourceEvaluationInput
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(response.Source, &resourceEvaluationInput); err != nil {
+		return nil, util.GrpcInternalError(log, "error unmarshalling resource evaluation input", err)
+	}
+
+	return &resourceEvaluationInput, nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("resource evaluation inputs", func() {
+	Context("hashEvaluatePolicyInput", func() {
+		var input *pb.EvaluatePolicyInput
+
+		BeforeEach(func() {
+			input = &pb.EvaluatePolicyInput{
+				Occurrences: []*grafeas_proto.Occurrence{
+					createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+					createRandomOccurrence(grafeas_common_proto.NoteKind_VULNERABILITY),
+				},
+			}
+		})
+
+		It("should return the same hash for equal inputs", func() {
+			expectedHash, err := hashEvaluatePolicyInput(input)
+			Expect(err).NotTo(HaveOccurred())
+
+			actualHash, err := hashEvaluatePolicyInput(proto.Clone(input).(*pb.EvaluatePolicyInput))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualHash).To(Equal(expectedHash))
+			Expect(actualHash).To(HaveLen(64))
+		})
+
+		It("should return a different hash when an occurrence changes", func() {
+			originalHash, err := hashEvaluatePolicyInput(input)
+			Expect(err).NotTo(HaveOccurred())
+
+			input.Occurrences[1].Remediation = fake.LetterN(11)
+			updatedHash, err := hashEvaluatePolicyInput(input)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(updatedHash).NotTo(Equal(originalHash))
+		})
+
+		It("should hash the JSON encoding of the input with sorted keys", func() {
+			input = &pb.EvaluatePolicyInput{
+				Occurrences: []*grafeas_proto.Occurrence{
+					{
+						Resource:    &grafeas_proto.Resource{Uri: "git://example.com/repo@abc"},
+						Remediation: "upgrade to >= 1.2.3",
+						Name:        "projects/rode/occurrences/123",
+					},
+				},
+			}
+			canonicalInput := `{"occurrences":[{"name":"projects/rode/occurrences/123","remediation":"upgrade to >= 1.2.3","resource":{"uri":"git://example.com/repo@abc"}}]}`
+			expectedDigest := sha256.Sum256([]byte(canonicalInput))
+
+			actualHash, err := hashEvaluatePolicyInput(input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualHash).To(Equal(hex.EncodeToString(expectedDigest[:])))
+		})
+	})
+
+	Context("GetResourceEvaluationInput", func() {
+		var (
+			ctx          context.Context
+			esClient     *esutilfakes.FakeClient
+			indexManager *mocks.FakeIndexManager
+
+			evaluationManager Manager
+
+			request       *pb.GetResourceEvaluationInputRequest
+			expectedInput *pb.ResourceEvaluationInput
+			expectedAlias string
+
+			getResponse *esutil.EsGetResponse
+			getError    error
+
+			actualInput *pb.ResourceEvaluationInput
+			actualError error
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			esClient = &esutilfakes.FakeClient{}
+			indexManager = &mocks.FakeIndexManager{}
+
+			expectedAlias = fake.LetterN(10)
+			indexManager.AliasNameReturns(expectedAlias)
+
+			request = &pb.GetResourceEvaluationInputRequest{
+				Id: fake.UUID(),
+			}
+			expectedInput = &pb.ResourceEvaluationInput{
+				ResourceEvaluationId: request.Id,
+				InputHash:            fake.LetterN(64),
+				Input: &pb.EvaluatePolicyInput{
+					Occurrences: []*grafeas_proto.Occurrence{
+						createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+					},
+				},
+				Created: timestamppb.Now(),
+			}
+			source, _ := protojson.Marshal(expectedInput)
+			getResponse = &esutil.EsGetResponse{
+				Id:     request.Id,
+				Found:  true,
+				Source: source,
+			}
+			getError = nil
+
+			evaluationManager = newTestManager(&managerDependencies{
+				esClient:     esClient,
+				indexManager: indexManager,
+			})
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturns(getResponse, getError)
+
+			actualInput, actualError = evaluationManager.GetResourceEvaluationInput(ctx, request)
+		})
+
+		It("should fetch the input by resource evaluation id", func() {
+			Expect(esClient.GetCallCount()).To(Equal(1))
+
+			_, getRequest := esClient.GetArgsForCall(0)
+			Expect(getRequest.Index).To(Equal(expectedAlias))
+			Expect(getRequest.DocumentId).To(Equal(request.Id))
+
+			documentKind, _ := indexManager.AliasNameArgsForCall(0)
+			Expect(documentKind).To(Equal(constants.EvaluationInputsDocumentKind))
+		})
+
+		It("should return the stored input", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualInput).To(Equal(expectedInput))
+		})
+
+		When("the id is missing", func() {
+			BeforeEach(func() {
+				request.Id = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualInput).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.GetCallCount()).To(BeZero())
+			})
+		})
+
+		When("the input does not exist", func() {
+			BeforeEach(func() {
+				getResponse.Found = false
+			})
+
+			It("should return a not found error", func() {
+				Expect(actualInput).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("an error occurs retrieving the input", func() {
+			BeforeEach(func() {
+				getError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualInput).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+
+		When("the stored input is malformed", func() {
+			BeforeEach(func() {
+				getResponse.Source = []byte(fake.LetterN(10))
+			})
+
+			It("should return an error", func() {
+				Expect(actualInput).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+})
@@ -16,7 +16,9 @@ package evaluation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/rode/es-index-manager/indexmanager"
@@ -25,14 +27,19 @@ import (
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/opa"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events"
 	"github.com/rode/rode/pkg/grafeas"
 	"github.com/rode/rode/pkg/policy"
 	"github.com/rode/rode/pkg/resource"
+	"github.com/rode/rode/pkg/signing"
 	"github.com/rode/rode/pkg/util"
+	"github.com/rode/rode/pkg/webhook"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -42,9 +49,21 @@ import (
 //counterfeiter:generate . Manager
 type Manager interface {
 	EvaluateResource(context.Context, *pb.ResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error)
+	EvaluateResourceAsync(context.Context, *pb.ResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error)
+	BatchEvaluateResource(context.Context, *pb.BatchEvaluateResourceRequest) (*pb.BatchEvaluateResourceResponse, error)
+	EvaluateResources(context.Context, *pb.EvaluateResourcesRequest) (*pb.EvaluateResourcesResponse, error)
+	GetResourceEvaluationInput(context.Context, *pb.GetResourceEvaluationInputRequest) (*pb.ResourceEvaluationInput, error)
+	ReplayResourceEvaluation(context.Context, *pb.ReplayResourceEvaluationRequest) (*pb.ReplayResourceEvaluationResponse, error)
+	AnalyzePolicyAssignmentImpact(context.Context, *pb.AnalyzePolicyAssignmentImpactRequest) (*pb.AnalyzePolicyAssignmentImpactResponse, error)
+	GetResourceEvaluationStatement(context.Context, *pb.GetResourceEvaluationStatementRequest) (*pb.DsseEnvelope, error)
+	GetEvaluationPublicKey(context.Context, *pb.GetEvaluationPublicKeyRequest) (*pb.EvaluationPublicKey, error)
 	GetResourceEvaluation(context.Context, *pb.GetResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error)
 	ListResourceEvaluations(context.Context, *pb.ListResourceEvaluationsRequest) (*pb.ListResourceEvaluationsResponse, error)
 	EvaluatePolicy(ctx context.Context, request *pb.EvaluatePolicyRequest) (*pb.EvaluatePolicyResponse, error)
+	ScheduleAutoEvaluations(resourceUris ...string)
+	ListStaleResourceEvaluations(context.Context, *pb.ListStaleResourceEvaluationsRequest) (*pb.ListStaleResourceEvaluationsResponse, error)
+	PolicyAssignmentChanged(policyGroup string)
+	WatchResourceEvaluations(*pb.WatchResourceEvaluationsRequest, pb.Rode_WatchResourceEvaluationsServer) error
 }
 
 const (
@@ -56,148 +75,539 @@ const (
 type EvaluationManager Manager
 
 type manager struct {
-	logger                  *zap.Logger
-	esConfig                *config.ElasticsearchConfig
-	esClient                esutil.Client
-	policyManager           policy.Manager
-	policyGroupManager      policy.PolicyGroupManager
-	policyAssignmentManager policy.AssignmentManager
-	grafeasExtensions       grafeas.Extensions
-	opa                     opa.Client
-	resourceManager         resource.Manager
-	indexManager            indexmanager.IndexManager
-	filterer                filtering.Filterer
+	logger                   *zap.Logger
+	esConfig                 *config.ElasticsearchConfig
+	evaluationConfig         *config.EvaluationConfig
+	esClient                 esutil.Client
+	policyManager            policy.Manager
+	policyGroupManager       policy.PolicyGroupManager
+	policyAssignmentManager  policy.AssignmentManager
+	waiverManager            policy.WaiverManager
+	grafeasExtensions        grafeas.Extensions
+	grafeasClient            grafeas_go_proto.GrafeasV1Beta1Client
+	signer                   signing.Signer
+	opa                      opa.Client
+	resourceManager          resource.Manager
+	indexManager             indexmanager.IndexManager
+	filterer                 filtering.Filterer
+	webhookNotifier          webhook.Notifier
+	eventPublisher           events.Publisher
+	evaluationJobs           chan *evaluationJob
+	evaluationSlots          chan struct{}
+	attestationNotes         sync.Map
+	autoEvaluationsMu        sync.Mutex
+	autoEvaluations          map[string]*pendingAutoEvaluation
+	autoEvaluatePolicyGroups *autoEvaluatePolicyGroups
+	reevaluationsMu          sync.Mutex
+	reevaluations            map[string]bool
+	watchersMu               sync.RWMutex
+	watchers                 map[*resourceEvaluationWatcher]struct{}
 }
 
 func NewManager(
 	logger *zap.Logger,
 	esClient esutil.Client,
 	esConfig *config.ElasticsearchConfig,
+	evaluationConfig *config.EvaluationConfig,
 	policyManager policy.Manager,
 	policyGroupManager policy.PolicyGroupManager,
 	policyAssignmentManager policy.AssignmentManager,
+	waiverManager policy.WaiverManager,
 	grafeasExtensions grafeas.Extensions,
+	grafeasClient grafeas_go_proto.GrafeasV1Beta1Client,
+	signer signing.Signer,
 	opa opa.Client,
 	resourceManager resource.Manager,
 	indexManager indexmanager.IndexManager,
 	filterer filtering.Filterer,
+	webhookNotifier webhook.Notifier,
+	eventPublisher events.Publisher,
 ) Manager {
-	return &manager{
+	m := &manager{
 		logger:                  logger,
 		esClient:                esClient,
 		esConfig:                esConfig,
+		evaluationConfig:        evaluationConfig,
 		policyManager:           policyManager,
 		policyGroupManager:      policyGroupManager,
 		policyAssignmentManager: policyAssignmentManager,
+		waiverManager:           waiverManager,
 		grafeasExtensions:       grafeasExtensions,
+		grafeasClient:           grafeasClient,
+		signer:                  signer,
 		opa:                     opa,
 		resourceManager:         resourceManager,
 		indexManager:            indexManager,
 		filterer:                filterer,
+		webhookNotifier:         webhookNotifier,
+		eventPublisher:          eventPublisher,
+		evaluationJobs:          make(chan *evaluationJob, evaluationConfig.QueueSize),
+		evaluationSlots:         make(chan struct{}, evaluationConfig.QueueSize+evaluationConfig.Workers),
+		autoEvaluations:         map[string]*pendingAutoEvaluation{},
+		reevaluations:           map[string]bool{},
+		watchers:                map[*resourceEvaluationWatcher]struct{}{},
 	}
+	m.startEvaluationWorkers()
+
+	return m
 }
 
 func (m *manager) EvaluateResource(ctx context.Context, request *pb.ResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error) {
 	log := m.logger.Named("EvaluateResource").With(zap.Any("request", request))
 
+	resourceEvaluation, policyGroup, policyAssignments, err := m.newResourceEvaluation(ctx, log, request)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := m.listResourceOccurrences(ctx, log, request.ResourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	policyEvaluations, err := m.evaluatePolicyAssignments(ctx, log, occurrences, resourceEvaluation, policyAssignments)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceEvaluation.State = pb.ResourceEvaluationState_COMPLETE
+	result := &pb.ResourceEvaluationResult{
+		ResourceEvaluation: resourceEvaluation,
+		PolicyEvaluations:  policyEvaluations,
+	}
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, result); err != nil {
+		return nil, util.GrpcInternalError(log, "error storing resource evaluation results", err)
+	}
+
+	m.attestResourceEvaluations(ctx, log, policyGroup, result)
+
+	return result, nil
+}
+
+func (m *manager) BatchEvaluateResource(ctx context.Context, request *pb.BatchEvaluateResourceRequest) (*pb.BatchEvaluateResourceResponse, error) {
+	log := m.logger.Named("BatchEvaluateResource").With(zap.Any("request", request))
+
 	if request.ResourceUri == "" {
 		return nil, util.GrpcErrorWithCode(log, "resource uri is required", nil, codes.InvalidArgument)
 	}
 
+	if len(request.PolicyGroups) == 0 {
+		return nil, util.GrpcErrorWithCode(log, "at least one policy group is required", nil, codes.InvalidArgument)
+	}
+
+	policyGroups := map[string]bool{}
+	for _, policyGroup := range request.PolicyGroups {
+		if policyGroup == "" {
+			return nil, util.GrpcErrorWithCode(log, "policy group names cannot be empty", nil, codes.InvalidArgument)
+		}
+		if policyGroups[policyGroup] {
+			return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("policy group %s was specified more than once", policyGroup), nil, codes.InvalidArgument)
+		}
+		policyGroups[policyGroup] = true
+	}
+
+	resourceVersion, err := m.resourceManager.GetResourceVersion(ctx, request.ResourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	// look up every policy group before evaluating anything, so that a bad group name doesn't result in partial results
+	resourceEvaluations := make([]*pb.ResourceEvaluation, len(request.PolicyGroups))
+	policyGroupsToEvaluate := make([]*pb.PolicyGroup, len(request.PolicyGroups))
+	policyAssignments := make([][]*pb.PolicyAssignment, len(request.PolicyGroups))
+	for i, policyGroup := range request.PolicyGroups {
+		resourceEvaluations[i], policyGroupsToEvaluate[i], policyAssignments[i], err = m.newPolicyGroupEvaluation(ctx, log, resourceVersion, policyGroup, request.Source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// occurrences are only fetched once and shared between each policy group
+	occurrences, err := m.listResourceOccurrences(ctx, log, request.ResourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.BatchEvaluateResourceResponse{
+		Pass: true,
+	}
+	for i, resourceEvaluation := range resourceEvaluations {
+		policyEvaluations, err := m.evaluatePolicyAssignments(ctx, log, occurrences, resourceEvaluation, policyAssignments[i])
+		if err != nil {
+			return nil, err
+		}
+
+		resourceEvaluation.State = pb.ResourceEvaluationState_COMPLETE
+		if !resourceEvaluation.Pass {
+			response.Pass = false
+		}
+
+		response.Results = append(response.Results, &pb.ResourceEvaluationResult{
+			ResourceEvaluation: resourceEvaluation,
+			PolicyEvaluations:  policyEvaluations,
+		})
+	}
+
+	if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, response.Results...); err != nil {
+		return nil, util.GrpcInternalError(log, "error storing resource evaluation results", err)
+	}
+
+	for i, result := range response.Results {
+		m.attestResourceEvaluations(ctx, log, policyGroupsToEvaluate[i], result)
+	}
+
+	return response, nil
+}
+
+func (m *manager) EvaluateResources(ctx context.Context, request *pb.EvaluateResourcesRequest) (*pb.EvaluateResourcesResponse, error) {
+	log := m.logger.Named("EvaluateResources").With(zap.Any("request", request))
+
 	if request.PolicyGroup == "" {
 		return nil, util.GrpcErrorWithCode(log, "policy group is required", nil, codes.InvalidArgument)
 	}
 
-	resourceVersion, err := m.resourceManager.GetResourceVersion(ctx, request.ResourceUri)
+	if len(request.ResourceUris) == 0 {
+		return nil, util.GrpcErrorWithCode(log, "at least one resource uri is required", nil, codes.InvalidArgument)
+	}
+
+	resourceUris := map[string]bool{}
+	for _, resourceUri := range request.ResourceUris {
+		if resourceUri == "" {
+			return nil, util.GrpcErrorWithCode(log, "resource uris cannot be empty", nil, codes.InvalidArgument)
+		}
+		if resourceUris[resourceUri] {
+			return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("resource uri %s was specified more than once", resourceUri), nil, codes.InvalidArgument)
+		}
+		resourceUris[resourceUri] = true
+	}
+
+	policyGroup, policyAssignments, err := m.getPolicyGroupAssignments(ctx, log, request.PolicyGroup)
 	if err != nil {
 		return nil, err
 	}
 
-	// get the policy group to evaluate against
-	policyGroup, err := m.policyGroupManager.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: request.PolicyGroup})
+	response := &pb.EvaluateResourcesResponse{
+		BatchId: uuid.New().String(),
+		Pass:    true,
+		Results: make([]*pb.EvaluateResourcesResult, len(request.ResourceUris)),
+	}
+
+	// each resource is evaluated independently, so an error only affects the result for that resource
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, m.evaluationConfig.ResourceConcurrency)
+resources:
+	for i, resourceUri := range request.ResourceUris {
+		response.Results[i] = &pb.EvaluateResourcesResult{
+			ResourceUri: resourceUri,
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			// the evaluations that have already started still write to the response, so wait for them before returning
+			break resources
+		}
+
+		wg.Add(1)
+		go func(result *pb.EvaluateResourcesResult) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			resourceLog := log.With(zap.String("resourceUri", result.ResourceUri))
+			resourceEvaluationResult, err := m.evaluateBatchResource(ctx, resourceLog, result.ResourceUri, policyGroup, policyAssignments, request.Source, response.BatchId)
+			if err != nil {
+				result.Error = status.Convert(err).Message()
+				return
+			}
+
+			result.Result = resourceEvaluationResult
+		}(response.Results[i])
+	}
+	wg.Wait()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation was cancelled", ctxErr, status.FromContextError(ctxErr).Code())
+	}
+
+	var evaluationResults []*pb.ResourceEvaluationResult
+	for _, result := range response.Results {
+		if result.Result == nil {
+			response.Pass = false
+			continue
+		}
+
+		if !result.Result.ResourceEvaluation.Pass {
+			response.Pass = false
+		}
+		evaluationResults = append(evaluationResults, result.Result)
+	}
+
+	if len(evaluationResults) != 0 {
+		if err := m.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, evaluationResults...); err != nil {
+			return nil, util.GrpcInternalError(log, "error storing resource evaluation results", err)
+		}
+
+		m.attestResourceEvaluations(ctx, log, policyGroup, evaluationResults...)
+	}
+
+	return response, nil
+}
+
+// evaluateBatchResource evaluates a single resource as part of EvaluateResources. The result is not stored.
+func (m *manager) evaluateBatchResource(ctx context.Context, log *zap.Logger, resourceUri string, policyGroup *pb.PolicyGroup, policyAssignments []*pb.PolicyAssignment, source *pb.ResourceEvaluationSource, batchId string) (*pb.ResourceEvaluationResult, error) {
+	resourceVersion, err := m.resourceManager.GetResourceVersion(ctx, resourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := m.listResourceOccurrences(ctx, log, resourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceEvaluation := createResourceEvaluation(resourceVersion, policyGroup, source)
+	resourceEvaluation.BatchId = batchId
+
+	policyEvaluations, err := m.evaluatePolicyAssignments(ctx, log, occurrences, resourceEvaluation, policyAssignments)
 	if err != nil {
 		return nil, err
 	}
 
+	resourceEvaluation.State = pb.ResourceEvaluationState_COMPLETE
+
+	return &pb.ResourceEvaluationResult{
+		ResourceEvaluation: resourceEvaluation,
+		PolicyEvaluations:  policyEvaluations,
+	}, nil
+}
+
+// newResourceEvaluation validates the request and looks up everything needed to evaluate the resource, returning
+// the resource evaluation that will be stored along with the policy group and the assignments that should be evaluated
+func (m *manager) newResourceEvaluation(ctx context.Context, log *zap.Logger, request *pb.ResourceEvaluationRequest) (*pb.ResourceEvaluation, *pb.PolicyGroup, []*pb.PolicyAssignment, error) {
+	if request.ResourceUri == "" {
+		return nil, nil, nil, util.GrpcErrorWithCode(log, "resource uri is required", nil, codes.InvalidArgument)
+	}
+
+	if request.PolicyGroup == "" {
+		return nil, nil, nil, util.GrpcErrorWithCode(log, "policy group is required", nil, codes.InvalidArgument)
+	}
+
+	resourceVersion, err := m.resourceManager.GetResourceVersion(ctx, request.ResourceUri)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return m.newPolicyGroupEvaluation(ctx, log, resourceVersion, request.PolicyGroup, request.Source)
+}
+
+// newPolicyGroupEvaluation creates a resource evaluation for a single policy group, along with the policy group and the
+// assignments that should be evaluated. An error is returned if the policy group doesn't exist or has no assignments.
+func (m *manager) newPolicyGroupEvaluation(ctx context.Context, log *zap.Logger, resourceVersion *pb.ResourceVersion, policyGroupName string, source *pb.ResourceEvaluationSource) (*pb.ResourceEvaluation, *pb.PolicyGroup, []*pb.PolicyAssignment, error) {
+	policyGroup, policyAssignments, err := m.getPolicyGroupAssignments(ctx, log, policyGroupName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return createResourceEvaluation(resourceVersion, policyGroup, source), policyGroup, policyAssignments, nil
+}
+
+// getPolicyGroupAssignments fetches a policy group and its assignments, including those inherited from its parents.
+// An error is returned if there are no assignments.
+func (m *manager) getPolicyGroupAssignments(ctx context.Context, log *zap.Logger, policyGroupName string) (*pb.PolicyGroup, []*pb.PolicyAssignment, error) {
+	// get the policy group to evaluate against
+	policyGroup, err := m.policyGroupManager.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: policyGroupName})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// get policy group assignments
-	listPolicyAssignmentsResponse, err := m.policyAssignmentManager.ListPolicyAssignments(ctx, &pb.ListPolicyAssignmentsRequest{
-		PolicyGroup: policyGroup.Name,
+	resolvedAssignments, err := m.policyGroupManager.ResolvePolicyGroupAssignments(ctx, &pb.ResolvePolicyGroupAssignmentsRequest{
+		Name: policyGroup.Name,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if len(listPolicyAssignmentsResponse.PolicyAssignments) == 0 {
-		return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("policy group %s has no policy assignments", policyGroup.Name), nil, codes.FailedPrecondition)
+	if len(resolvedAssignments.PolicyAssignments) == 0 {
+		return nil, nil, util.GrpcErrorWithCode(log, fmt.Sprintf("policy group %s has no policy assignments", policyGroup.Name), nil, codes.FailedPrecondition)
 	}
 
-	// fetch occurrences from grafeas
-	occurrences, nextPage, err := m.grafeasExtensions.ListVersionedResourceOccurrences(ctx, request.ResourceUri, "", constants.MaxPageSize)
+	return policyGroup, resolvedAssignments.PolicyAssignments, nil
+}
+
+func createResourceEvaluation(resourceVersion *pb.ResourceVersion, policyGroup *pb.PolicyGroup, source *pb.ResourceEvaluationSource) *pb.ResourceEvaluation {
+	// the uri has already been validated by the resource manager, but an unknown type shouldn't prevent the evaluation
+	resourceType := pb.ResourceType_RESOURCE_TYPE_UNSPECIFIED
+	if rodeResource, err := resource.ResourceFromUri(resourceVersion.Version); err == nil {
+		resourceType = rodeResource.Type
+	}
+
+	return &pb.ResourceEvaluation{
+		Id:                uuid.New().String(),
+		Pass:              true, // defaults to true, but will be set to false if any policy evaluations fail
+		Source:            source,
+		Created:           timestamppb.Now(),
+		ResourceVersion:   resourceVersion,
+		PolicyGroup:       policyGroup.Name,
+		SeverityThreshold: policyGroup.SeverityThreshold,
+		ResourceType:      resourceType,
+	}
+}
+
+// listResourceOccurrences fetches every occurrence for a resource version from grafeas. Rather than evaluating against a
+// partial set of occurrences, an error is returned if the resource has more than EvaluationConfig.MaxOccurrences.
+func (m *manager) listResourceOccurrences(ctx context.Context, log *zap.Logger, resourceUri string) ([]*grafeas_go_proto.Occurrence, error) {
+	occurrences, err := m.grafeasExtensions.ListAllVersionedResourceOccurrences(ctx, resourceUri, m.evaluationConfig.MaxOccurrences)
+	if errors.Is(err, grafeas.ErrTooManyOccurrences) {
+		return nil, util.GrpcErrorWithCode(log, err.Error(), nil, codes.FailedPrecondition)
+	}
 	if err != nil {
 		return nil, util.GrpcInternalError(log, "error listing occurrences", err)
 	}
-	if nextPage != "" {
-		log.Warn(fmt.Sprintf("listing occurrences for resource %s resulted in more than %d occurrences, proceeding with evaluation anyway", request.ResourceUri, constants.MaxPageSize))
+
+	return occurrences, nil
+}
+
+// evaluatePolicyAssignments evaluates each assigned policy version whose selector matches the resource against the
+// resource's occurrences, at most EvaluationConfig.PolicyConcurrency at a time.
+// The policy input is stored before any policies are evaluated, so that a stored evaluation always has a retrievable input.
+// resourceEvaluation.Pass is set to false if any of the enforced policy evaluations fail with an unwaived violation that
+// meets the policy group's severity threshold.
+func (m *manager) evaluatePolicyAssignments(ctx context.Context, log *zap.Logger, occurrences []*grafeas_go_proto.Occurrence, resourceEvaluation *pb.ResourceEvaluation, policyAssignments []*pb.PolicyAssignment) ([]*pb.PolicyEvaluation, error) {
+	policyAssignments = selectPolicyAssignments(log, resourceEvaluation, policyAssignments)
+
+	input, err := m.recordResourceEvaluationInput(ctx, log, resourceEvaluation, occurrences)
+	if err != nil {
+		return nil, err
 	}
 
-	resourceEvaluation := &pb.ResourceEvaluation{
-		Id:              uuid.New().String(),
-		Pass:            true, // defaults to true, but will be set to false if any policy evaluations fail
-		Source:          request.Source,
-		Created:         timestamppb.Now(),
-		ResourceVersion: resourceVersion,
-		PolicyGroup:     policyGroup.Name,
+	// policy versions are evaluated concurrently, but results are kept in the same order as the assignments
+	policyEvaluations := make([]*pb.PolicyEvaluation, len(policyAssignments))
+	group, groupCtx := errgroup.WithContext(ctx)
+	semaphore := make(chan struct{}, m.evaluationConfig.PolicyConcurrency)
+assignments:
+	for i, policyAssignment := range policyAssignments {
+		select {
+		case semaphore <- struct{}{}:
+		case <-groupCtx.Done():
+			break assignments
+		}
+
+		i, policyAssignment := i, policyAssignment
+		group.Go(func() (err error) {
+			defer func() { <-semaphore }()
+			// the worker can't recover a panic from another goroutine, so turn it into an error here
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error("policy evaluation panicked", zap.Any("panic", r), zap.Stack("stack"))
+					err = util.GrpcInternalError(log, fmt.Sprintf("unexpected error evaluating policy version %s", policyAssignment.PolicyVersionId), nil)
+				}
+			}()
+
+			policyEvaluation, err := m.evaluatePolicyAssignment(groupCtx, log, resourceEvaluation.Id, policyAssignment, input)
+			if err != nil {
+				return err
+			}
+
+			policyEvaluations[i] = policyEvaluation
+			return nil
+		})
 	}
-	bulkRequestItems := []*esutil.BulkRequestItem{
-		{
-			Operation:  esutil.BULK_CREATE,
-			Message:    resourceEvaluation,
-			DocumentId: resourceEvaluation.Id,
-			Join: &esutil.EsJoin{
-				Field: evaluationDocumentJoinField,
-				Name:  resourceEvaluationRelationName,
-			},
-		},
+
+	err = group.Wait()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation was cancelled", ctxErr, status.FromContextError(ctxErr).Code())
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	var policyEvaluations []*pb.PolicyEvaluation
-	for _, policyAssignment := range listPolicyAssignmentsResponse.PolicyAssignments {
-		policyEntity, err := m.policyManager.GetPolicyVersion(ctx, policyAssignment.PolicyVersionId)
-		if err != nil {
-			return nil, util.GrpcInternalError(log, "error fetching policy version", err)
-		}
-		if policyEntity == nil {
-			return nil, util.GrpcInternalError(log, "policy version does not exist", nil)
-		}
+	if err := m.applyWaivers(ctx, log, resourceEvaluation.ResourceVersion.GetVersion(), policyEvaluations); err != nil {
+		return nil, err
+	}
 
-		evaluatePolicyResponse, err := m.evaluatePolicy(ctx, policyAssignment.PolicyVersionId, policyEntity.RegoContent, occurrences)
-		if err != nil {
-			return nil, util.GrpcInternalError(log, fmt.Sprintf("error evaluating policy version %s", policyAssignment.PolicyVersionId), err)
+	resourceEvaluation.ViolationCounts = countViolationsBySeverity(policyEvaluations)
+
+	// policies in audit mode are reported, but don't affect the overall result
+	for _, policyEvaluation := range policyEvaluations {
+		if policyEvaluation.Pass || policyEvaluation.Waived {
+			continue
 		}
 
-		if !evaluatePolicyResponse.Result.Pass {
+		if policyEvaluation.EnforcementMode == pb.PolicyEnforcementMode_AUDIT {
+			resourceEvaluation.AuditFailures = append(resourceEvaluation.AuditFailures, policyEvaluation.PolicyVersionId)
+		} else if meetsSeverityThreshold(policyEvaluation, resourceEvaluation.SeverityThreshold) {
 			resourceEvaluation.Pass = false
 		}
+	}
 
-		policyEvaluation := &pb.PolicyEvaluation{
-			Id:                   uuid.New().String(),
-			ResourceEvaluationId: resourceEvaluation.Id,
-			PolicyVersionId:      policyAssignment.PolicyVersionId,
-			Pass:                 evaluatePolicyResponse.Result.Pass,
-			Violations:           evaluatePolicyResponse.Result.Violations,
-		}
+	return policyEvaluations, nil
+}
+
+func (m *manager) evaluatePolicyAssignment(ctx context.Context, log *zap.Logger, resourceEvaluationId string, policyAssignment *pb.PolicyAssignment, input *pb.EvaluatePolicyInput) (*pb.PolicyEvaluation, error) {
+	policyEntity, err := m.policyManager.GetPolicyVersion(ctx, policyAssignment.PolicyVersionId)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error fetching policy version", err)
+	}
+	if policyEntity == nil {
+		return nil, util.GrpcInternalError(log, "policy version does not exist", nil)
+	}
 
-		policyEvaluations = append(policyEvaluations, policyEvaluation)
+	evaluatePolicyResponse, err := m.evaluatePolicy(ctx, policyAssignment.PolicyVersionId, policyEntity.RegoContent, input)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, fmt.Sprintf("error evaluating policy version %s", policyAssignment.PolicyVersionId), err)
+	}
+
+	result := policyResult(evaluatePolicyResponse)
+
+	enforcementMode := policyAssignment.EnforcementMode
+	if enforcementMode == pb.PolicyEnforcementMode_POLICY_ENFORCEMENT_MODE_UNSPECIFIED {
+		enforcementMode = pb.PolicyEnforcementMode_ENFORCED
+	}
+
+	return &pb.PolicyEvaluation{
+		Id:                   uuid.New().String(),
+		ResourceEvaluationId: resourceEvaluationId,
+		PolicyVersionId:      policyAssignment.PolicyVersionId,
+		Pass:                 result.Pass,
+		Violations:           result.Violations,
+		EnforcementMode:      enforcementMode,
+	}, nil
+}
+
+// storeResourceEvaluationResults writes each resource evaluation and its policy evaluations in a single bulk request.
+// operation applies to the resource evaluation documents only, as policy evaluations are always new documents.
+// Once stored, finished resource evaluations are sent to any open WatchResourceEvaluations streams.
+func (m *manager) storeResourceEvaluationResults(ctx context.Context, operation esutil.EsBulkOperation, results ...*pb.ResourceEvaluationResult) error {
+	if err := m.signResourceEvaluationResults(results...); err != nil {
+		return err
+	}
+
+	var bulkRequestItems []*esutil.BulkRequestItem
+	for _, result := range results {
+		resourceEvaluation := result.ResourceEvaluation
 		bulkRequestItems = append(bulkRequestItems, &esutil.BulkRequestItem{
-			Operation:  esutil.BULK_CREATE,
-			Message:    policyEvaluation,
-			DocumentId: policyEvaluation.Id,
+			Operation:  operation,
+			Message:    resourceEvaluation,
+			DocumentId: resourceEvaluation.Id,
 			Join: &esutil.EsJoin{
-				Parent: resourceEvaluation.Id,
-				Field:  evaluationDocumentJoinField,
-				Name:   policyEvaluationRelationName,
+				Field: evaluationDocumentJoinField,
+				Name:  resourceEvaluationRelationName,
 			},
 		})
+
+		for _, policyEvaluation := range result.PolicyEvaluations {
+			bulkRequestItems = append(bulkRequestItems, &esutil.BulkRequestItem{
+				Operation:  esutil.BULK_CREATE,
+				Message:    policyEvaluation,
+				DocumentId: policyEvaluation.Id,
+				Join: &esutil.EsJoin{
+					Parent: resourceEvaluation.Id,
+					Field:  evaluationDocumentJoinField,
+					Name:   policyEvaluationRelationName,
+				},
+			})
+		}
 	}
 
 	response, err := m.esClient.Bulk(ctx, &esutil.BulkRequest{
@@ -206,16 +616,16 @@ func (m *manager) EvaluateResource(ctx context.Context, request *pb.ResourceEval
 		Refresh: m.esConfig.Refresh.String(),
 	})
 	if err != nil {
-		return nil, util.GrpcInternalError(log, "error storing resource evaluation results", err)
+		return err
 	}
-	if err = util.CheckBulkResponseErrors(response); err != nil {
-		return nil, util.GrpcInternalError(log, "error storing resource evaluation results", err)
+
+	if err := util.CheckBulkResponseErrors(response); err != nil {
+		return err
 	}
 
-	return &pb.ResourceEvaluationResult{
-		ResourceEvaluation: resourceEvaluation,
-		PolicyEvaluations:  policyEvaluations,
-	}, nil
+	m.publishResourceEvaluationResults(results...)
+
+	return nil
 }
 
 func (m *manager) GetResourceEvaluation(ctx context.Context, request *pb.GetResourceEvaluationRequest) (*pb.ResourceEvaluationResult, error) {
@@ -340,10 +750,7 @@ func (m *manager) ListResourceEvaluations(ctx context.Context, request *pb.ListR
 		return &pb.ListResourceEvaluationsResponse{}, nil
 	}
 
-	var (
-		resourceEvaluationResults []*pb.ResourceEvaluationResult
-		policyEvaluationSearches  []*esutil.EsSearch
-	)
+	var resourceEvaluationResults []*pb.ResourceEvaluationResult
 	for _, hit := range searchResponse.Hits.Hits {
 		var resourceEvaluation pb.ResourceEvaluation
 		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(hit.Source, &resourceEvaluation)
@@ -354,18 +761,34 @@ func (m *manager) ListResourceEvaluations(ctx context.Context, request *pb.ListR
 		resourceEvaluationResults = append(resourceEvaluationResults, &pb.ResourceEvaluationResult{
 			ResourceEvaluation: &resourceEvaluation,
 		})
+	}
+
+	if err := m.addPolicyEvaluations(ctx, log, resourceEvaluationResults); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListResourceEvaluationsResponse{
+		ResourceEvaluations: resourceEvaluationResults,
+		NextPageToken:       searchResponse.NextPageToken,
+	}, nil
+}
+
+// addPolicyEvaluations fetches the policy evaluations for each resource evaluation in a single request
+func (m *manager) addPolicyEvaluations(ctx context.Context, log *zap.Logger, results []*pb.ResourceEvaluationResult) error {
+	var policyEvaluationSearches []*esutil.EsSearch
+	for _, result := range results {
 		policyEvaluationSearches = append(policyEvaluationSearches, &esutil.EsSearch{
 			Query: &filtering.Query{
 				HasParent: &filtering.HasParent{
 					ParentType: resourceEvaluationRelationName,
 					Query: &filtering.Query{
 						Term: &filtering.Term{
-							"_id": resourceEvaluation.Id,
+							"_id": result.ResourceEvaluation.Id,
 						},
 					},
 				},
 			},
-			Routing: resourceEvaluation.Id,
+			Routing: result.ResourceEvaluation.Id,
 		})
 	}
 
@@ -374,7 +797,7 @@ func (m *manager) ListResourceEvaluations(ctx context.Context, request *pb.ListR
 		Searches: policyEvaluationSearches,
 	})
 	if err != nil {
-		return nil, util.GrpcInternalError(log, "error searching for policy evaluations", err)
+		return util.GrpcInternalError(log, "error searching for policy evaluations", err)
 	}
 
 	for i, response := range multiSearchResponse.Responses {
@@ -383,19 +806,16 @@ func (m *manager) ListResourceEvaluations(ctx context.Context, request *pb.ListR
 			var poliyEvaluation pb.PolicyEvaluation
 			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(hit.Source, &poliyEvaluation)
 			if err != nil {
-				return nil, util.GrpcInternalError(log, "error unmarshalling policy evaluation into json", err)
+				return util.GrpcInternalError(log, "error unmarshalling policy evaluation into json", err)
 			}
 
 			policyEvaluations = append(policyEvaluations, &poliyEvaluation)
 		}
 
-		resourceEvaluationResults[i].PolicyEvaluations = policyEvaluations
+		results[i].PolicyEvaluations = policyEvaluations
 	}
 
-	return &pb.ListResourceEvaluationsResponse{
-		ResourceEvaluations: resourceEvaluationResults,
-		NextPageToken:       searchResponse.NextPageToken,
-	}, nil
+	return nil
 }
 
 func (m *manager) EvaluatePolicy(ctx context.Context, request *pb.EvaluatePolicyRequest) (*pb.EvaluatePolicyResponse, error) {
@@ -413,15 +833,15 @@ func (m *manager) EvaluatePolicy(ctx context.Context, request *pb.EvaluatePolicy
 	}
 
 	// fetch occurrences from grafeas
-	occurrences, _, err := m.grafeasExtensions.ListVersionedResourceOccurrences(ctx, request.ResourceUri, "", constants.MaxPageSize)
+	occurrences, err := m.listResourceOccurrences(ctx, log, request.ResourceUri)
 	if err != nil {
-		return nil, util.GrpcInternalError(log, "error listing occurrences", err)
+		return nil, err
 	}
 
 	log.Debug("Occurrences found", zap.Any("occurrences", occurrences))
 
 	// evaluate OPA policy
-	evaluatePolicyResponse, err := m.evaluatePolicy(ctx, policy.Id, policy.Policy.RegoContent, occurrences)
+	evaluatePolicyResponse, err := m.evaluatePolicy(ctx, policy.Id, policy.Policy.RegoContent, &pb.EvaluatePolicyInput{Occurrences: occurrences})
 	if err != nil {
 		return nil, util.GrpcInternalError(log, "error evaluating policy", err)
 	}
@@ -441,6 +861,7 @@ func (m *manager) EvaluatePolicy(ctx context.Context, request *pb.EvaluatePolicy
 				Message:     violation.Message,
 				Link:        violation.Link,
 				Pass:        violation.Pass,
+				Severity:    violation.Severity,
 			})
 		}
 	} else {
@@ -463,18 +884,26 @@ func (m *manager) EvaluatePolicy(ctx context.Context, request *pb.EvaluatePolicy
 	return response, nil
 }
 
-func (m *manager) evaluatePolicy(ctx context.Context, policyId, rego string, occurrences []*grafeas_go_proto.Occurrence) (*opa.EvaluatePolicyResponse, error) {
+// policyResult returns the result of a policy evaluation. A policy that doesn't produce a result fails, as it does in
+// EvaluatePolicy.
+func policyResult(response *opa.EvaluatePolicyResponse) *opa.EvaluatePolicyResult {
+	if response.Result == nil {
+		return &opa.EvaluatePolicyResult{Pass: false}
+	}
+
+	return response.Result
+}
+
+func (m *manager) evaluatePolicy(ctx context.Context, policyId, rego string, input *pb.EvaluatePolicyInput) (*opa.EvaluatePolicyResponse, error) {
 	// check OPA policy has been loaded, using the policy id
 	initializePolicyErr := m.opa.InitializePolicy(policyId, rego)
 	if initializePolicyErr != nil {
 		return nil, fmt.Errorf("error initializing policy in OPA: %v", initializePolicyErr)
 	}
 
-	input, _ := protojson.Marshal(&pb.EvaluatePolicyInput{
-		Occurrences: occurrences,
-	})
+	inputJson, _ := protojson.Marshal(input)
 
-	evaluatePolicyResponse, err := m.opa.EvaluatePolicy(rego, input)
+	evaluatePolicyResponse, err := m.opa.EvaluatePolicy(ctx, policyId, rego, inputJson)
 	if err != nil {
 		return nil, fmt.Errorf("error evaluating policy in OPA: %v", err)
 	}
@@ -18,8 +18,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/rode/es-index-manager/mocks"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
@@ -27,16 +30,22 @@ import (
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
 	"github.com/rode/rode/config"
+	grafeasmocks "github.com/rode/rode/mocks"
 	"github.com/rode/rode/opa"
 	"github.com/rode/rode/opa/opafakes"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/grafeas"
 	"github.com/rode/rode/pkg/grafeas/grafeasfakes"
 	"github.com/rode/rode/pkg/policy/policyfakes"
 	"github.com/rode/rode/pkg/resource/resourcefakes"
+	"github.com/rode/rode/pkg/signing/signingfakes"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
 	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -49,14 +58,20 @@ var _ = Describe("evaluation manager", func() {
 
 		esClient                *esutilfakes.FakeClient
 		esConfig                *config.ElasticsearchConfig
+		evaluationConfig        *config.EvaluationConfig
 		policyManager           *policyfakes.FakeManager
 		policyGroupManager      *policyfakes.FakePolicyGroupManager
 		policyAssignmentManager *policyfakes.FakeAssignmentManager
+		waiverManager           *policyfakes.FakeWaiverManager
 		grafeasExtensions       *grafeasfakes.FakeExtensions
+		grafeasClient           *grafeasmocks.FakeGrafeasV1Beta1Client
+		signer                  *signingfakes.FakeSigner
 		opaClient               *opafakes.FakeClient
 		resourceManager         *resourcefakes.FakeManager
 		indexManager            *mocks.FakeIndexManager
 		filterer                *filteringfakes.FakeFilterer
+		webhookNotifier         *webhookfakes.FakeNotifier
+		eventPublisher          *eventsfakes.FakePublisher
 
 		manager Manager
 	)
@@ -68,19 +83,47 @@ var _ = Describe("evaluation manager", func() {
 		esConfig = &config.ElasticsearchConfig{
 			Refresh: config.RefreshTrue,
 		}
+		evaluationConfig = &config.EvaluationConfig{
+			QueueSize:           1,
+			PolicyConcurrency:   1,
+			ResourceConcurrency: 1,
+			MaxOccurrences:      100,
+		}
 		policyManager = &policyfakes.FakeManager{}
 		policyGroupManager = &policyfakes.FakePolicyGroupManager{}
 		policyAssignmentManager = &policyfakes.FakeAssignmentManager{}
+		waiverManager = &policyfakes.FakeWaiverManager{}
 		grafeasExtensions = &grafeasfakes.FakeExtensions{}
+		grafeasClient = &grafeasmocks.FakeGrafeasV1Beta1Client{}
+		signer = &signingfakes.FakeSigner{}
 		opaClient = &opafakes.FakeClient{}
 		resourceManager = &resourcefakes.FakeManager{}
 		indexManager = &mocks.FakeIndexManager{}
 		filterer = &filteringfakes.FakeFilterer{}
+		webhookNotifier = &webhookfakes.FakeNotifier{}
+		eventPublisher = &eventsfakes.FakePublisher{}
 
 		expectedEvaluationsAlias = fake.LetterN(10)
 		indexManager.AliasNameReturns(expectedEvaluationsAlias)
 
-		manager = NewManager(logger, esClient, esConfig, policyManager, policyGroupManager, policyAssignmentManager, grafeasExtensions, opaClient, resourceManager, indexManager, filterer)
+		manager = newTestManager(&managerDependencies{
+			esClient:                esClient,
+			esConfig:                esConfig,
+			evaluationConfig:        evaluationConfig,
+			policyManager:           policyManager,
+			policyGroupManager:      policyGroupManager,
+			policyAssignmentManager: policyAssignmentManager,
+			waiverManager:           waiverManager,
+			grafeasExtensions:       grafeasExtensions,
+			grafeasClient:           grafeasClient,
+			signer:                  signer,
+			opa:                     opaClient,
+			resourceManager:         resourceManager,
+			indexManager:            indexManager,
+			filterer:                filterer,
+			webhookNotifier:         webhookNotifier,
+			eventPublisher:          eventPublisher,
+		})
 	})
 
 	Context("EvaluateResource", func() {
@@ -98,14 +141,14 @@ var _ = Describe("evaluation manager", func() {
 			expectedPolicyGroup         *pb.PolicyGroup
 			expectedGetPolicyGroupError error
 
-			expectedPolicyAssignments          []*pb.PolicyAssignment
-			expectedListPolicyAssignmentsError error
+			expectedPolicyAssignments       []*pb.PolicyAssignment
+			expectedResolveAssignmentsError error
 
 			expectedPolicyVersionId string
 			expectedPolicyRego      string
 
-			expectedOccurrences                           []*grafeas_proto.Occurrence
-			expectedListVersionedResourceOccurrencesError error
+			expectedOccurrences          []*grafeas_proto.Occurrence
+			expectedListOccurrencesError error
 
 			expectedPolicyEntity          *pb.PolicyEntity
 			expectedGetPolicyVersionError error
@@ -150,12 +193,12 @@ var _ = Describe("evaluation manager", func() {
 					PolicyGroup:     expectedPolicyGroupName,
 				},
 			}
-			expectedListPolicyAssignmentsError = nil
+			expectedResolveAssignmentsError = nil
 
 			expectedOccurrences = []*grafeas_proto.Occurrence{
 				createRandomOccurrence(grafeas_common_proto.NoteKind_DISCOVERY),
 			}
-			expectedListVersionedResourceOccurrencesError = nil
+			expectedListOccurrencesError = nil
 
 			expectedPolicyRego = fake.LetterN(10)
 			expectedPolicyEntity = createRandomPolicyEntity(expectedPolicyRego, uint32(expectedPolicyVersion))
@@ -190,8 +233,8 @@ var _ = Describe("evaluation manager", func() {
 		JustBeforeEach(func() {
 			resourceManager.GetResourceVersionReturns(expectedResourceVersion, expectedGetResourceVersionError)
 			policyGroupManager.GetPolicyGroupReturns(expectedPolicyGroup, expectedGetPolicyGroupError)
-			policyAssignmentManager.ListPolicyAssignmentsReturns(&pb.ListPolicyAssignmentsResponse{PolicyAssignments: expectedPolicyAssignments}, expectedListPolicyAssignmentsError)
-			grafeasExtensions.ListVersionedResourceOccurrencesReturns(expectedOccurrences, "", expectedListVersionedResourceOccurrencesError)
+			policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{PolicyAssignments: expectedPolicyAssignments}, expectedResolveAssignmentsError)
+			grafeasExtensions.ListAllVersionedResourceOccurrencesReturns(expectedOccurrences, expectedListOccurrencesError)
 
 			policyManager.GetPolicyVersionReturnsOnCall(0, expectedPolicyEntity, expectedGetPolicyVersionError)
 			opaClient.InitializePolicyReturnsOnCall(0, expectedInitializePolicyError)
@@ -210,6 +253,21 @@ var _ = Describe("evaluation manager", func() {
 			Expect(resourceUri).To(Equal(expectedResourceUri))
 		})
 
+		It("should leave the resource type unspecified when it can't be determined from the uri", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResourceEvaluationResult.ResourceEvaluation.ResourceType).To(Equal(pb.ResourceType_RESOURCE_TYPE_UNSPECIFIED))
+		})
+
+		When("the resource version uri has a known type", func() {
+			BeforeEach(func() {
+				expectedResourceVersion.Version = fmt.Sprintf("git://github.com/%s/%s@%s", fake.LetterN(10), fake.LetterN(10), fake.LetterN(40))
+			})
+
+			It("should record the resource type on the resource evaluation", func() {
+				Expect(actualResourceEvaluationResult.ResourceEvaluation.ResourceType).To(Equal(pb.ResourceType_GIT))
+			})
+		})
+
 		It("should fetch the provided policy group", func() {
 			Expect(policyGroupManager.GetPolicyGroupCallCount()).To(Equal(1))
 
@@ -218,18 +276,18 @@ var _ = Describe("evaluation manager", func() {
 			Expect(getPolicyGroupRequest.Name).To(Equal(expectedPolicyGroupName))
 		})
 
-		It("should fetch policy assignments for the provided policy group", func() {
-			Expect(policyAssignmentManager.ListPolicyAssignmentsCallCount()).To(Equal(1))
+		It("should resolve the policy assignments for the provided policy group", func() {
+			Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(Equal(1))
 
-			_, listPolicyAssignmentsRequest := policyAssignmentManager.ListPolicyAssignmentsArgsForCall(0)
+			_, resolveAssignmentsRequest := policyGroupManager.ResolvePolicyGroupAssignmentsArgsForCall(0)
 
-			Expect(listPolicyAssignmentsRequest.PolicyGroup).To(Equal(expectedPolicyGroupName))
+			Expect(resolveAssignmentsRequest.Name).To(Equal(expectedPolicyGroupName))
 		})
 
 		It("should fetch the versioned resource occurrences for the provided resource uri", func() {
-			Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(Equal(1))
+			Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(Equal(1))
 
-			_, resourceUri, _, _ := grafeasExtensions.ListVersionedResourceOccurrencesArgsForCall(0)
+			_, resourceUri, _ := grafeasExtensions.ListAllVersionedResourceOccurrencesArgsForCall(0)
 
 			Expect(resourceUri).To(Equal(expectedResourceUri))
 		})
@@ -254,8 +312,9 @@ var _ = Describe("evaluation manager", func() {
 		It("should evaluate the policy in OPA", func() {
 			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(1))
 
-			rego, input := opaClient.EvaluatePolicyArgsForCall(0)
+			_, policyId, rego, input := opaClient.EvaluatePolicyArgsForCall(0)
 
+			Expect(policyId).To(Equal(expectedPolicyVersionId))
 			Expect(rego).To(Equal(expectedPolicyRego))
 			expectedInput, _ := protojson.Marshal(&pb.EvaluatePolicyInput{
 				Occurrences: expectedOccurrences,
@@ -282,6 +341,7 @@ var _ = Describe("evaluation manager", func() {
 			Expect(resourceEvaluationItem.Join.Parent).To(BeEmpty())
 
 			Expect(resourceEvaluation.Pass).To(BeTrue())
+			Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
 			Expect(resourceEvaluation.Source).To(Equal(expectedResourceEvaluationRequest.Source))
 			Expect(resourceEvaluation.ResourceVersion).To(Equal(expectedResourceVersion))
 			Expect(resourceEvaluation.PolicyGroup).To(Equal(expectedPolicyGroupName))
@@ -306,6 +366,91 @@ var _ = Describe("evaluation manager", func() {
 			Expect(actualError).ToNot(HaveOccurred())
 		})
 
+		It("should record the occurrences that were evaluated", func() {
+			expectedInputHash, err := hashEvaluatePolicyInput(&pb.EvaluatePolicyInput{Occurrences: expectedOccurrences})
+			Expect(err).NotTo(HaveOccurred())
+
+			resourceEvaluation := actualResourceEvaluationResult.ResourceEvaluation
+			Expect(resourceEvaluation.OccurrenceNames).To(ConsistOf(expectedOccurrences[0].Name))
+			Expect(resourceEvaluation.InputHash).To(Equal(expectedInputHash))
+		})
+
+		It("should store the policy input", func() {
+			Expect(esClient.CreateCallCount()).To(Equal(1))
+
+			_, createRequest := esClient.CreateArgsForCall(0)
+			resourceEvaluationInput := createRequest.Message.(*pb.ResourceEvaluationInput)
+			resourceEvaluation := actualResourceEvaluationResult.ResourceEvaluation
+
+			Expect(createRequest.DocumentId).To(Equal(resourceEvaluation.Id))
+			Expect(createRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(resourceEvaluationInput.ResourceEvaluationId).To(Equal(resourceEvaluation.Id))
+			Expect(resourceEvaluationInput.InputHash).To(Equal(resourceEvaluation.InputHash))
+			Expect(resourceEvaluationInput.Input.Occurrences).To(Equal(expectedOccurrences))
+		})
+
+		It("should sign the resource evaluation before storing it", func() {
+			Expect(signer.SignCallCount()).To(Equal(1))
+
+			_, bulkRequest := esClient.BulkArgsForCall(0)
+			storedResourceEvaluation := bulkRequest.Items[0].Message.(*pb.ResourceEvaluation)
+
+			Expect(storedResourceEvaluation.Signature).NotTo(BeNil())
+			Expect(actualResourceEvaluationResult.ResourceEvaluation.Signature).To(Equal(storedResourceEvaluation.Signature))
+		})
+
+		It("should not create an attestation", func() {
+			Expect(grafeasClient.CreateNoteCallCount()).To(Equal(0))
+			Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(0))
+		})
+
+		When("the policy group requires attestations", func() {
+			var expectedSignature []byte
+
+			BeforeEach(func() {
+				expectedPolicyGroup.Attest = true
+				expectedSignature = []byte(fake.LetterN(10))
+				signer.SignReturns(expectedSignature, nil)
+			})
+
+			It("should create an attestation occurrence for the resource", func() {
+				Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(1))
+
+				_, request, _ := grafeasClient.BatchCreateOccurrencesArgsForCall(0)
+				Expect(request.Parent).To(Equal(constants.RodeProjectSlug))
+				Expect(request.Occurrences).To(HaveLen(1))
+
+				occurrence := request.Occurrences[0]
+				Expect(occurrence.Kind).To(Equal(grafeas_common_proto.NoteKind_ATTESTATION))
+				Expect(occurrence.Resource.Uri).To(Equal(expectedResourceUri))
+
+				signedAttestation := occurrence.GetAttestation().Attestation.GetGenericSignedAttestation()
+				Expect(signedAttestation.SerializedPayload).To(ContainSubstring(actualResourceEvaluationResult.ResourceEvaluation.Id))
+				Expect(signedAttestation.Signatures[0].Signature).To(Equal(expectedSignature))
+			})
+
+			When("creating the attestation fails", func() {
+				BeforeEach(func() {
+					grafeasClient.BatchCreateOccurrencesReturns(nil, errors.New("batch create occurrences error"))
+				})
+
+				It("should still return the evaluation result", func() {
+					Expect(actualError).NotTo(HaveOccurred())
+					Expect(actualResourceEvaluationResult).NotTo(BeNil())
+				})
+			})
+
+			When("storing the evaluation results fails", func() {
+				BeforeEach(func() {
+					expectedBulkError = errors.New("error during bulk insert")
+				})
+
+				It("should not create an attestation", func() {
+					Expect(grafeasClient.BatchCreateOccurrencesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		When("the resource uri is missing", func() {
 			BeforeEach(func() {
 				expectedResourceEvaluationRequest.ResourceUri = ""
@@ -320,8 +465,8 @@ var _ = Describe("evaluation manager", func() {
 			It("should not continue with the request", func() {
 				Expect(resourceManager.GetResourceVersionCallCount()).To(BeZero())
 				Expect(policyGroupManager.GetPolicyGroupCallCount()).To(BeZero())
-				Expect(policyAssignmentManager.ListPolicyAssignmentsCallCount()).To(BeZero())
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -343,8 +488,8 @@ var _ = Describe("evaluation manager", func() {
 			It("should not continue with the request", func() {
 				Expect(resourceManager.GetResourceVersionCallCount()).To(BeZero())
 				Expect(policyGroupManager.GetPolicyGroupCallCount()).To(BeZero())
-				Expect(policyAssignmentManager.ListPolicyAssignmentsCallCount()).To(BeZero())
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -364,8 +509,8 @@ var _ = Describe("evaluation manager", func() {
 
 			It("should not continue with the request", func() {
 				Expect(policyGroupManager.GetPolicyGroupCallCount()).To(BeZero())
-				Expect(policyAssignmentManager.ListPolicyAssignmentsCallCount()).To(BeZero())
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -384,8 +529,8 @@ var _ = Describe("evaluation manager", func() {
 			})
 
 			It("should not continue with the request", func() {
-				Expect(policyAssignmentManager.ListPolicyAssignmentsCallCount()).To(BeZero())
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -395,7 +540,7 @@ var _ = Describe("evaluation manager", func() {
 
 		When("fetching the policy assignments fails", func() {
 			BeforeEach(func() {
-				expectedListPolicyAssignmentsError = errors.New("error fetching policy assignments")
+				expectedResolveAssignmentsError = errors.New("error fetching policy assignments")
 			})
 
 			It("should return an error", func() {
@@ -404,7 +549,7 @@ var _ = Describe("evaluation manager", func() {
 			})
 
 			It("should not continue with the request", func() {
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -424,7 +569,7 @@ var _ = Describe("evaluation manager", func() {
 			})
 
 			It("should not continue with the request", func() {
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
 				Expect(policyManager.GetPolicyVersionCallCount()).To(BeZero())
 				Expect(opaClient.InitializePolicyCallCount()).To(BeZero())
 				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
@@ -432,9 +577,27 @@ var _ = Describe("evaluation manager", func() {
 			})
 		})
 
+		It("should limit the number of occurrences", func() {
+			_, _, maxOccurrences := grafeasExtensions.ListAllVersionedResourceOccurrencesArgsForCall(0)
+			Expect(maxOccurrences).To(Equal(evaluationConfig.MaxOccurrences))
+		})
+
+		When("the resource has more occurrences than allowed", func() {
+			BeforeEach(func() {
+				expectedListOccurrencesError = fmt.Errorf("resource has too many occurrences: %w", grafeas.ErrTooManyOccurrences)
+			})
+
+			It("should fail the evaluation instead of using a partial set of occurrences", func() {
+				Expect(actualResourceEvaluationResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
 		When("fetching the occurrences fails", func() {
 			BeforeEach(func() {
-				expectedListVersionedResourceOccurrencesError = errors.New("error fetching occurrences")
+				expectedListOccurrencesError = errors.New("error fetching occurrences")
 			})
 
 			It("should return an error", func() {
@@ -451,6 +614,22 @@ var _ = Describe("evaluation manager", func() {
 			})
 		})
 
+		When("storing the policy input fails", func() {
+			BeforeEach(func() {
+				esClient.CreateReturns("", errors.New("error storing input"))
+			})
+
+			It("should return an error", func() {
+				Expect(actualResourceEvaluationResult).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+
+			It("should not evaluate any policies", func() {
+				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
 		When("fetching the policy version fails", func() {
 			BeforeEach(func() {
 				expectedGetPolicyVersionError = errors.New("error fetching policy version")
@@ -618,6 +797,694 @@ var _ = Describe("evaluation manager", func() {
 
 					Expect(failingPolicyEvaluation.Pass).To(BeFalse())
 				})
+
+				It("should record the enforcement mode of each policy", func() {
+					for _, policyEvaluation := range actualResourceEvaluationResult.PolicyEvaluations {
+						Expect(policyEvaluation.EnforcementMode).To(Equal(pb.PolicyEnforcementMode_ENFORCED))
+					}
+					Expect(actualResourceEvaluationResult.ResourceEvaluation.AuditFailures).To(BeEmpty())
+				})
+
+				When("the failing policy is assigned in audit mode", func() {
+					BeforeEach(func() {
+						expectedPolicyAssignments[1].EnforcementMode = pb.PolicyEnforcementMode_AUDIT
+					})
+
+					It("should pass the resource evaluation", func() {
+						Expect(actualError).NotTo(HaveOccurred())
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeTrue())
+					})
+
+					It("should report the audit failure", func() {
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.AuditFailures).To(ConsistOf(expectedPolicyAssignments[1].PolicyVersionId))
+
+						failingPolicyEvaluation := actualResourceEvaluationResult.PolicyEvaluations[1]
+						Expect(failingPolicyEvaluation.Pass).To(BeFalse())
+						Expect(failingPolicyEvaluation.EnforcementMode).To(Equal(pb.PolicyEnforcementMode_AUDIT))
+					})
+				})
+
+				When("the passing policy is assigned in audit mode", func() {
+					BeforeEach(func() {
+						expectedPolicyAssignments[0].EnforcementMode = pb.PolicyEnforcementMode_AUDIT
+					})
+
+					It("should fail the resource evaluation without reporting an audit failure", func() {
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeFalse())
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.AuditFailures).To(BeEmpty())
+					})
+				})
+
+				When("the failing policy has been waived", func() {
+					var waiver *pb.Waiver
+
+					BeforeEach(func() {
+						waiver = &pb.Waiver{
+							Id:       fake.UUID(),
+							PolicyId: policyIdFromVersionId(expectedPolicyVersionId),
+						}
+						waiverManager.ListWaiversReturns(&pb.ListWaiversResponse{Waivers: []*pb.Waiver{waiver}}, nil)
+					})
+
+					It("should look for waivers for the resource version", func() {
+						Expect(waiverManager.ListWaiversCallCount()).To(Equal(1))
+
+						_, request := waiverManager.ListWaiversArgsForCall(0)
+						Expect(request.ResourceUri).To(Equal(expectedResourceVersion.Version))
+					})
+
+					It("should pass the resource evaluation", func() {
+						Expect(actualError).NotTo(HaveOccurred())
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeTrue())
+					})
+
+					It("should record the waiver on the failing policy evaluation", func() {
+						failingPolicyEvaluation := actualResourceEvaluationResult.PolicyEvaluations[1]
+
+						Expect(failingPolicyEvaluation.Pass).To(BeFalse())
+						Expect(failingPolicyEvaluation.Waived).To(BeTrue())
+						Expect(failingPolicyEvaluation.WaiverIds).To(ConsistOf(waiver.Id))
+					})
+				})
+
+				When("the failing policy reports violation severities", func() {
+					BeforeEach(func() {
+						opaClient.EvaluatePolicyReturnsOnCall(1, &opa.EvaluatePolicyResponse{
+							Result: &opa.EvaluatePolicyResult{
+								Pass: false,
+								Violations: []*pb.EvaluatePolicyViolation{
+									{Id: fake.LetterN(10), Pass: false, Severity: pb.ViolationSeverity_MEDIUM},
+									{Id: fake.LetterN(10), Pass: false, Severity: pb.ViolationSeverity_LOW},
+									{Id: fake.LetterN(10), Pass: true, Severity: pb.ViolationSeverity_CRITICAL},
+								},
+							},
+						}, expectedEvaluatePolicyError)
+					})
+
+					It("should count the failed violations by severity", func() {
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.ViolationCounts).To(Equal(map[string]int32{
+							pb.ViolationSeverity_MEDIUM.String(): 1,
+							pb.ViolationSeverity_LOW.String():    1,
+						}))
+					})
+
+					It("should fail the resource evaluation when the policy group has no severity threshold", func() {
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeFalse())
+						Expect(actualResourceEvaluationResult.ResourceEvaluation.SeverityThreshold).To(Equal(pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED))
+					})
+
+					When("the violations are below the policy group's severity threshold", func() {
+						BeforeEach(func() {
+							expectedPolicyGroup.SeverityThreshold = pb.ViolationSeverity_HIGH
+						})
+
+						It("should pass the resource evaluation", func() {
+							Expect(actualError).NotTo(HaveOccurred())
+							Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeTrue())
+							Expect(actualResourceEvaluationResult.ResourceEvaluation.SeverityThreshold).To(Equal(pb.ViolationSeverity_HIGH))
+						})
+
+						It("should still record the failing policy evaluation", func() {
+							Expect(actualResourceEvaluationResult.PolicyEvaluations[1].Pass).To(BeFalse())
+						})
+					})
+
+					When("a violation meets the policy group's severity threshold", func() {
+						BeforeEach(func() {
+							expectedPolicyGroup.SeverityThreshold = pb.ViolationSeverity_MEDIUM
+						})
+
+						It("should fail the resource evaluation", func() {
+							Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeFalse())
+						})
+					})
+				})
+			})
+		})
+
+		When("policies are evaluated concurrently", func() {
+			var (
+				concurrency       int
+				mu                sync.Mutex
+				inFlight          int
+				maxInFlight       int
+				failingPolicyId   string
+				policyAssignments []*pb.PolicyAssignment
+
+				evaluatePolicyCallCount func() int
+			)
+
+			BeforeEach(func() {
+				concurrency = fake.Number(2, 4)
+				evaluationConfig.PolicyConcurrency = concurrency
+				inFlight = 0
+				maxInFlight = 0
+
+				policyAssignments = nil
+				for i := 0; i < concurrency*2; i++ {
+					policyAssignments = append(policyAssignments, &pb.PolicyAssignment{
+						Id:              fake.UUID(),
+						PolicyVersionId: fmt.Sprintf("%s.%d", fake.UUID(), i+1),
+						PolicyGroup:     expectedPolicyGroupName,
+					})
+				}
+				failingPolicyId = policyAssignments[fake.Number(0, len(policyAssignments)-1)].PolicyVersionId
+				expectedPolicyAssignments = policyAssignments
+
+				// the shared fakes are configured to return results by call order, which isn't deterministic here
+				stubbedPolicyManager := &policyfakes.FakeManager{}
+				stubbedPolicyManager.GetPolicyVersionReturns(createRandomPolicyEntity(fake.LetterN(10), 1), nil)
+				stubbedOpaClient := &opafakes.FakeClient{}
+				stubbedOpaClient.EvaluatePolicyStub = func(_ context.Context, policyId, _ string, _ []byte) (*opa.EvaluatePolicyResponse, error) {
+					mu.Lock()
+					inFlight++
+					if inFlight > maxInFlight {
+						maxInFlight = inFlight
+					}
+					mu.Unlock()
+
+					time.Sleep(5 * time.Millisecond)
+
+					mu.Lock()
+					inFlight--
+					mu.Unlock()
+
+					return &opa.EvaluatePolicyResponse{
+						Result: &opa.EvaluatePolicyResult{
+							Pass: policyId != failingPolicyId,
+						},
+					}, nil
+				}
+				evaluatePolicyCallCount = stubbedOpaClient.EvaluatePolicyCallCount
+
+				manager = newTestManager(&managerDependencies{
+					esClient:                esClient,
+					esConfig:                esConfig,
+					evaluationConfig:        evaluationConfig,
+					policyManager:           stubbedPolicyManager,
+					policyGroupManager:      policyGroupManager,
+					policyAssignmentManager: policyAssignmentManager,
+					waiverManager:           waiverManager,
+					grafeasExtensions:       grafeasExtensions,
+					grafeasClient:           grafeasClient,
+					signer:                  signer,
+					opa:                     stubbedOpaClient,
+					resourceManager:         resourceManager,
+					indexManager:            indexManager,
+					filterer:                filterer,
+					webhookNotifier:         webhookNotifier,
+					eventPublisher:          eventPublisher,
+				})
+			})
+
+			It("should evaluate every policy without exceeding the concurrency limit", func() {
+				Expect(evaluatePolicyCallCount()).To(Equal(len(policyAssignments)))
+				Expect(maxInFlight).To(BeNumerically("<=", concurrency))
+			})
+
+			It("should return the policy evaluations in the same order as the assignments", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResourceEvaluationResult.PolicyEvaluations).To(HaveLen(len(policyAssignments)))
+
+				for i, policyEvaluation := range actualResourceEvaluationResult.PolicyEvaluations {
+					Expect(policyEvaluation.PolicyVersionId).To(Equal(policyAssignments[i].PolicyVersionId))
+					Expect(policyEvaluation.Pass).To(Equal(policyEvaluation.PolicyVersionId != failingPolicyId))
+				}
+				Expect(actualResourceEvaluationResult.ResourceEvaluation.Pass).To(BeFalse())
+			})
+		})
+
+		When("the request context is cancelled", func() {
+			BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				cancel()
+			})
+
+			It("should return an error", func() {
+				Expect(actualResourceEvaluationResult).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Canceled))
+			})
+
+			It("should not store the evaluation", func() {
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("BatchEvaluateResource", func() {
+		var (
+			request          *pb.BatchEvaluateResourceRequest
+			resourceUri      string
+			policyGroupNames []string
+			policyVersionIds map[string]string
+
+			getPolicyGroupError  error
+			listOccurrencesError error
+			bulkError            error
+
+			actualResponse *pb.BatchEvaluateResourceResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			resourceUri = fake.URL()
+			policyGroupNames = []string{fake.LetterN(10), fake.LetterN(11), fake.LetterN(12)}
+			policyVersionIds = map[string]string{}
+			for _, name := range policyGroupNames {
+				policyVersionIds[name] = fmt.Sprintf("%s.%d", fake.UUID(), fake.Number(1, 9))
+			}
+
+			request = &pb.BatchEvaluateResourceRequest{
+				ResourceUri:  resourceUri,
+				PolicyGroups: policyGroupNames,
+				Source: &pb.ResourceEvaluationSource{
+					Name: fake.LetterN(10),
+				},
+			}
+
+			getPolicyGroupError = nil
+			listOccurrencesError = nil
+			bulkError = nil
+
+			resourceManager.GetResourceVersionReturns(&pb.ResourceVersion{Version: resourceUri}, nil)
+			policyGroupManager.ResolvePolicyGroupAssignmentsStub = func(_ context.Context, request *pb.ResolvePolicyGroupAssignmentsRequest) (*pb.ResolvePolicyGroupAssignmentsResponse, error) {
+				return &pb.ResolvePolicyGroupAssignmentsResponse{
+					PolicyAssignments: []*pb.PolicyAssignment{
+						{
+							PolicyVersionId: policyVersionIds[request.Name],
+							PolicyGroup:     request.Name,
+						},
+					},
+				}, nil
+			}
+			policyManager.GetPolicyVersionReturns(createRandomPolicyEntity(fake.LetterN(10), 1), nil)
+			opaClient.EvaluatePolicyStub = func(_ context.Context, policyId, _ string, _ []byte) (*opa.EvaluatePolicyResponse, error) {
+				return &opa.EvaluatePolicyResponse{
+					Result: &opa.EvaluatePolicyResult{
+						Pass: true,
+					},
+				}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			policyGroupManager.GetPolicyGroupStub = func(_ context.Context, request *pb.GetPolicyGroupRequest) (*pb.PolicyGroup, error) {
+				if getPolicyGroupError != nil && request.Name == policyGroupNames[1] {
+					return nil, getPolicyGroupError
+				}
+
+				return &pb.PolicyGroup{Name: request.Name}, nil
+			}
+			grafeasExtensions.ListAllVersionedResourceOccurrencesReturns([]*grafeas_proto.Occurrence{
+				createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+			}, listOccurrencesError)
+			esClient.BulkReturns(&esutil.EsBulkResponse{}, bulkError)
+
+			actualResponse, actualError = manager.BatchEvaluateResource(ctx, request)
+		})
+
+		It("should fetch occurrences once", func() {
+			Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(Equal(1))
+
+			_, actualResourceUri, _ := grafeasExtensions.ListAllVersionedResourceOccurrencesArgsForCall(0)
+			Expect(actualResourceUri).To(Equal(resourceUri))
+		})
+
+		It("should evaluate each policy group", func() {
+			Expect(policyGroupManager.GetPolicyGroupCallCount()).To(Equal(len(policyGroupNames)))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(len(policyGroupNames)))
+		})
+
+		It("should store a resource evaluation for each policy group in a single request", func() {
+			Expect(esClient.BulkCallCount()).To(Equal(1))
+
+			_, bulkRequest := esClient.BulkArgsForCall(0)
+			Expect(bulkRequest.Items).To(HaveLen(len(policyGroupNames) * 2))
+
+			for i, name := range policyGroupNames {
+				resourceEvaluationItem := bulkRequest.Items[i*2]
+				resourceEvaluation := resourceEvaluationItem.Message.(*pb.ResourceEvaluation)
+				Expect(resourceEvaluationItem.Join.Name).To(Equal(resourceEvaluationRelationName))
+				Expect(resourceEvaluation.PolicyGroup).To(Equal(name))
+				Expect(resourceEvaluation.Source).To(Equal(request.Source))
+				Expect(resourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
+
+				policyEvaluationItem := bulkRequest.Items[i*2+1]
+				policyEvaluation := policyEvaluationItem.Message.(*pb.PolicyEvaluation)
+				Expect(policyEvaluationItem.Join.Parent).To(Equal(resourceEvaluation.Id))
+				Expect(policyEvaluation.PolicyVersionId).To(Equal(policyVersionIds[name]))
+			}
+		})
+
+		It("should return the results for each policy group in order", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Pass).To(BeTrue())
+			Expect(actualResponse.Results).To(HaveLen(len(policyGroupNames)))
+
+			for i, name := range policyGroupNames {
+				Expect(actualResponse.Results[i].ResourceEvaluation.PolicyGroup).To(Equal(name))
+				Expect(actualResponse.Results[i].PolicyEvaluations).To(HaveLen(1))
+			}
+		})
+
+		When("one of the policy groups fails", func() {
+			BeforeEach(func() {
+				failingPolicyId := policyVersionIds[policyGroupNames[2]]
+				opaClient.EvaluatePolicyStub = func(_ context.Context, policyId, _ string, _ []byte) (*opa.EvaluatePolicyResponse, error) {
+					return &opa.EvaluatePolicyResponse{
+						Result: &opa.EvaluatePolicyResult{
+							Pass: policyId != failingPolicyId,
+						},
+					}, nil
+				}
+			})
+
+			It("should fail the aggregate result", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Pass).To(BeFalse())
+				Expect(actualResponse.Results[0].ResourceEvaluation.Pass).To(BeTrue())
+				Expect(actualResponse.Results[1].ResourceEvaluation.Pass).To(BeTrue())
+				Expect(actualResponse.Results[2].ResourceEvaluation.Pass).To(BeFalse())
+			})
+		})
+
+		DescribeTable("invalid requests",
+			func(modifyRequest func(*pb.BatchEvaluateResourceRequest)) {
+				modifyRequest(request)
+
+				response, err := manager.BatchEvaluateResource(ctx, request)
+
+				Expect(response).To(BeNil())
+				Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+			},
+			Entry("missing resource uri", func(request *pb.BatchEvaluateResourceRequest) {
+				request.ResourceUri = ""
+			}),
+			Entry("no policy groups", func(request *pb.BatchEvaluateResourceRequest) {
+				request.PolicyGroups = nil
+			}),
+			Entry("empty policy group name", func(request *pb.BatchEvaluateResourceRequest) {
+				request.PolicyGroups = append(request.PolicyGroups, "")
+			}),
+			Entry("duplicate policy group", func(request *pb.BatchEvaluateResourceRequest) {
+				request.PolicyGroups = append(request.PolicyGroups, request.PolicyGroups[0])
+			}),
+		)
+
+		When("a policy group cannot be found", func() {
+			BeforeEach(func() {
+				getPolicyGroupError = status.Error(codes.NotFound, fake.Word())
+			})
+
+			It("should return the error without evaluating any policy groups", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
+				Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
+		When("listing occurrences fails", func() {
+			BeforeEach(func() {
+				listOccurrencesError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
+		When("storing the results fails", func() {
+			BeforeEach(func() {
+				bulkError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("EvaluateResources", func() {
+		var (
+			request         *pb.EvaluateResourcesRequest
+			resourceUris    []string
+			policyGroupName string
+
+			getPolicyGroupError     error
+			getResourceVersionError error
+			bulkError               error
+
+			actualResponse *pb.EvaluateResourcesResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			resourceUris = []string{fake.URL(), fake.URL(), fake.URL()}
+			policyGroupName = fake.LetterN(10)
+
+			request = &pb.EvaluateResourcesRequest{
+				ResourceUris: resourceUris,
+				PolicyGroup:  policyGroupName,
+				Source: &pb.ResourceEvaluationSource{
+					Name: fake.LetterN(10),
+				},
+			}
+
+			getPolicyGroupError = nil
+			getResourceVersionError = nil
+			bulkError = nil
+
+			policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{
+				PolicyAssignments: []*pb.PolicyAssignment{
+					{
+						PolicyVersionId: fmt.Sprintf("%s.%d", fake.UUID(), fake.Number(1, 9)),
+						PolicyGroup:     policyGroupName,
+					},
+				},
+			}, nil)
+			policyManager.GetPolicyVersionReturns(createRandomPolicyEntity(fake.LetterN(10), 1), nil)
+			grafeasExtensions.ListAllVersionedResourceOccurrencesReturns([]*grafeas_proto.Occurrence{
+				createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+			}, nil)
+			opaClient.EvaluatePolicyReturns(&opa.EvaluatePolicyResponse{
+				Result: &opa.EvaluatePolicyResult{
+					Pass: true,
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			policyGroupManager.GetPolicyGroupReturns(&pb.PolicyGroup{Name: policyGroupName}, getPolicyGroupError)
+			resourceManager.GetResourceVersionStub = func(_ context.Context, resourceUri string) (*pb.ResourceVersion, error) {
+				if getResourceVersionError != nil && resourceUri == resourceUris[1] {
+					return nil, getResourceVersionError
+				}
+
+				return &pb.ResourceVersion{Version: resourceUri}, nil
+			}
+			esClient.BulkReturns(&esutil.EsBulkResponse{}, bulkError)
+
+			actualResponse, actualError = manager.EvaluateResources(ctx, request)
+		})
+
+		It("should look up the policy group once", func() {
+			Expect(policyGroupManager.GetPolicyGroupCallCount()).To(Equal(1))
+			Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(Equal(1))
+		})
+
+		It("should evaluate each resource", func() {
+			Expect(resourceManager.GetResourceVersionCallCount()).To(Equal(len(resourceUris)))
+			Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(Equal(len(resourceUris)))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(len(resourceUris)))
+		})
+
+		It("should return a result for each resource in order", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.BatchId).NotTo(BeEmpty())
+			Expect(actualResponse.Pass).To(BeTrue())
+			Expect(actualResponse.Results).To(HaveLen(len(resourceUris)))
+
+			for i, resourceUri := range resourceUris {
+				result := actualResponse.Results[i]
+				Expect(result.ResourceUri).To(Equal(resourceUri))
+				Expect(result.Error).To(BeEmpty())
+				Expect(result.Result.ResourceEvaluation.ResourceVersion.Version).To(Equal(resourceUri))
+				Expect(result.Result.ResourceEvaluation.BatchId).To(Equal(actualResponse.BatchId))
+				Expect(result.Result.ResourceEvaluation.State).To(Equal(pb.ResourceEvaluationState_COMPLETE))
+				Expect(result.Result.PolicyEvaluations).To(HaveLen(1))
+			}
+		})
+
+		It("should store every resource evaluation in a single request", func() {
+			Expect(esClient.BulkCallCount()).To(Equal(1))
+
+			_, bulkRequest := esClient.BulkArgsForCall(0)
+			Expect(bulkRequest.Items).To(HaveLen(len(resourceUris) * 2))
+
+			for i := range resourceUris {
+				resourceEvaluation := bulkRequest.Items[i*2].Message.(*pb.ResourceEvaluation)
+				Expect(resourceEvaluation.BatchId).To(Equal(actualResponse.BatchId))
+				Expect(resourceEvaluation.PolicyGroup).To(Equal(policyGroupName))
+				Expect(resourceEvaluation.Source).To(Equal(request.Source))
+			}
+		})
+
+		When("resources are evaluated concurrently", func() {
+			BeforeEach(func() {
+				evaluationConfig.ResourceConcurrency = len(resourceUris)
+			})
+
+			It("should keep the results in request order", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+
+				for i, resourceUri := range resourceUris {
+					Expect(actualResponse.Results[i].ResourceUri).To(Equal(resourceUri))
+					Expect(actualResponse.Results[i].Result.ResourceEvaluation.ResourceVersion.Version).To(Equal(resourceUri))
+				}
+			})
+		})
+
+		When("the request is cancelled while resources are being evaluated", func() {
+			var evaluationFinished bool
+
+			BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				evaluationFinished = false
+
+				opaClient.EvaluatePolicyStub = func(_ context.Context, _, _ string, _ []byte) (*opa.EvaluatePolicyResponse, error) {
+					cancel()
+					time.Sleep(50 * time.Millisecond)
+					evaluationFinished = true
+
+					return &opa.EvaluatePolicyResponse{
+						Result: &opa.EvaluatePolicyResult{
+							Pass: true,
+						},
+					}, nil
+				}
+			})
+
+			It("should wait for the evaluations that already started before returning an error", func() {
+				Expect(evaluationFinished).To(BeTrue())
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Canceled))
+			})
+
+			It("should not start evaluating the remaining resources", func() {
+				Expect(resourceManager.GetResourceVersionCallCount()).To(Equal(1))
+			})
+		})
+
+		When("a resource fails the policy group", func() {
+			BeforeEach(func() {
+				opaClient.EvaluatePolicyReturnsOnCall(0, &opa.EvaluatePolicyResponse{
+					Result: &opa.EvaluatePolicyResult{
+						Pass: false,
+					},
+				}, nil)
+			})
+
+			It("should fail the combined result", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Pass).To(BeFalse())
+				Expect(actualResponse.Results[0].Result.ResourceEvaluation.Pass).To(BeFalse())
+				Expect(actualResponse.Results[1].Result.ResourceEvaluation.Pass).To(BeTrue())
+			})
+		})
+
+		When("a resource version cannot be found", func() {
+			var errorMessage string
+
+			BeforeEach(func() {
+				errorMessage = fake.Word()
+				getResourceVersionError = status.Error(codes.NotFound, errorMessage)
+			})
+
+			It("should report the error for that resource only", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Pass).To(BeFalse())
+
+				Expect(actualResponse.Results[1].ResourceUri).To(Equal(resourceUris[1]))
+				Expect(actualResponse.Results[1].Error).To(Equal(errorMessage))
+				Expect(actualResponse.Results[1].Result).To(BeNil())
+
+				Expect(actualResponse.Results[0].Result).NotTo(BeNil())
+				Expect(actualResponse.Results[2].Result).NotTo(BeNil())
+			})
+
+			It("should store the other resource evaluations", func() {
+				Expect(esClient.BulkCallCount()).To(Equal(1))
+
+				_, bulkRequest := esClient.BulkArgsForCall(0)
+				Expect(bulkRequest.Items).To(HaveLen((len(resourceUris) - 1) * 2))
+			})
+		})
+
+		When("no resources can be evaluated", func() {
+			BeforeEach(func() {
+				request.ResourceUris = resourceUris[1:2]
+				getResourceVersionError = status.Error(codes.NotFound, fake.Word())
+			})
+
+			It("should not store anything", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Pass).To(BeFalse())
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
+		DescribeTable("invalid requests",
+			func(modifyRequest func(*pb.EvaluateResourcesRequest)) {
+				modifyRequest(request)
+
+				response, err := manager.EvaluateResources(ctx, request)
+
+				Expect(response).To(BeNil())
+				Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+			},
+			Entry("missing policy group", func(request *pb.EvaluateResourcesRequest) {
+				request.PolicyGroup = ""
+			}),
+			Entry("no resource uris", func(request *pb.EvaluateResourcesRequest) {
+				request.ResourceUris = nil
+			}),
+			Entry("empty resource uri", func(request *pb.EvaluateResourcesRequest) {
+				request.ResourceUris = append(request.ResourceUris, "")
+			}),
+			Entry("duplicate resource uri", func(request *pb.EvaluateResourcesRequest) {
+				request.ResourceUris = append(request.ResourceUris, request.ResourceUris[0])
+			}),
+		)
+
+		When("the policy group cannot be found", func() {
+			BeforeEach(func() {
+				getPolicyGroupError = status.Error(codes.NotFound, fake.Word())
+			})
+
+			It("should return the error without evaluating any resources", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				Expect(resourceManager.GetResourceVersionCallCount()).To(BeZero())
+				Expect(esClient.BulkCallCount()).To(BeZero())
+			})
+		})
+
+		When("storing the results fails", func() {
+			BeforeEach(func() {
+				bulkError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
 			})
 		})
 	})
@@ -1092,7 +1959,7 @@ var _ = Describe("evaluation manager", func() {
 			policyManager.GetPolicyReturns(policy, getPolicyError)
 
 			opaClient.InitializePolicyReturns(opaInitializePolicyError)
-			grafeasExtensions.ListVersionedResourceOccurrencesReturns(listVersionedResourceOccurrencesResponse, "", listVersionedResourceOccurrencesError)
+			grafeasExtensions.ListAllVersionedResourceOccurrencesReturns(listVersionedResourceOccurrencesResponse, listVersionedResourceOccurrencesError)
 			opaClient.EvaluatePolicyReturns(opaEvaluatePolicyResponse, opaEvaluatePolicyError)
 
 			actualResponse, actualError = manager.EvaluatePolicy(ctx, request)
@@ -1117,24 +1984,24 @@ var _ = Describe("evaluation manager", func() {
 			})
 
 			It("should fetch versioned resource occurrences from Grafeas", func() {
-				Expect(grafeasExtensions.ListVersionedResourceOccurrencesCallCount()).To(Equal(1))
+				Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(Equal(1))
 
-				_, actualResourceUri, actualPageToken, actualPageSize := grafeasExtensions.ListVersionedResourceOccurrencesArgsForCall(0)
+				_, actualResourceUri, actualMaxOccurrences := grafeasExtensions.ListAllVersionedResourceOccurrencesArgsForCall(0)
 
 				Expect(actualResourceUri).To(Equal(resourceUri))
-				Expect(actualPageToken).To(BeEmpty())
-				Expect(actualPageSize).To(BeEquivalentTo(constants.MaxPageSize))
+				Expect(actualMaxOccurrences).To(Equal(evaluationConfig.MaxOccurrences))
 			})
 
 			It("should evaluate the policy in Open Policy Agent", func() {
 				Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(1))
-				actualPolicy, actualInput := opaClient.EvaluatePolicyArgsForCall(0)
+				_, actualPolicyId, actualPolicy, actualInput := opaClient.EvaluatePolicyArgsForCall(0)
 
 				expectedInput, err := protojson.Marshal(&pb.EvaluatePolicyInput{
 					Occurrences: listVersionedResourceOccurrencesResponse,
 				})
 				Expect(err).NotTo(HaveOccurred())
 
+				Expect(actualPolicyId).To(Equal(policyId))
 				Expect(actualPolicy).To(Equal(expectedPolicyRego))
 				Expect(actualInput).To(MatchJSON(expectedInput))
 			})
@@ -1145,6 +2012,19 @@ var _ = Describe("evaluation manager", func() {
 				Expect(actualResponse.Explanation[0]).To(Equal((*opaEvaluatePolicyResponse.Explanation)[0]))
 				Expect(actualError).NotTo(HaveOccurred())
 			})
+
+			When("the violations have severities", func() {
+				BeforeEach(func() {
+					opaEvaluatePolicyResponse.Result.Violations = []*pb.EvaluatePolicyViolation{
+						{Id: fake.LetterN(10), Pass: false, Severity: pb.ViolationSeverity_HIGH},
+					}
+				})
+
+				It("should include the severities in the result", func() {
+					Expect(actualError).NotTo(HaveOccurred())
+					Expect(actualResponse.Result[0].Violations[0].Severity).To(Equal(pb.ViolationSeverity_HIGH))
+				})
+			})
 		})
 
 		When("the request doesn't contain a resource uri", func() {
@@ -0,0 +1,229 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	reevaluationSourceName = "rode-reevaluate"
+
+	defaultStaleEvaluationLimit = 100
+	maxStaleEvaluationLimit     = 1000
+)
+
+// PolicyAssignmentChanged starts a background re-evaluation of the resource versions that were evaluated against the policy
+// group, or one of the policy groups that inherit from it, within EvaluationConfig.ReevaluateWindow.
+// Only one re-evaluation runs per policy group at a time; changes that arrive while it's running are coalesced into a
+// single re-evaluation that starts once the current one finishes.
+func (m *manager) PolicyAssignmentChanged(policyGroup string) {
+	m.reevaluationsMu.Lock()
+	defer m.reevaluationsMu.Unlock()
+
+	if _, running := m.reevaluations[policyGroup]; running {
+		m.reevaluations[policyGroup] = true
+		return
+	}
+
+	m.reevaluations[policyGroup] = false
+	go m.runReevaluations(context.Background(), policyGroup)
+}
+
+// runReevaluations re-evaluates the policy group until no further changes were recorded while it was running
+func (m *manager) runReevaluations(ctx context.Context, policyGroup string) {
+	for {
+		m.reevaluatePolicyGroup(ctx, policyGroup)
+
+		m.reevaluationsMu.Lock()
+		if !m.reevaluations[policyGroup] {
+			delete(m.reevaluations, policyGroup)
+			m.reevaluationsMu.Unlock()
+			return
+		}
+
+		m.reevaluations[policyGroup] = false
+		m.reevaluationsMu.Unlock()
+	}
+}
+
+func (m *manager) reevaluatePolicyGroup(ctx context.Context, policyGroup string) {
+	log := m.logger.Named("reevaluatePolicyGroup").With(zap.String("policyGroup", policyGroup))
+	since := time.Now().Add(-m.evaluationConfig.ReevaluateWindow)
+
+	for _, groupName := range m.inheritingPolicyGroups(ctx, log, policyGroup) {
+		resourceEvaluations, err := m.listLatestResourceEvaluations(ctx, log, groupName, since, constants.MaxPageSize)
+		if err != nil {
+			log.Error("error listing resource evaluations to re-evaluate", zap.String("evaluatedPolicyGroup", groupName), zap.Error(err))
+			continue
+		}
+
+		log.Debug("re-evaluating resource versions", zap.String("evaluatedPolicyGroup", groupName), zap.Int("count", len(resourceEvaluations)))
+		for _, resourceEvaluation := range resourceEvaluations {
+			// wait for room in the queue, rather than dropping the rest of a large policy group
+			_, err := m.queueResourceEvaluation(ctx, log.With(zap.String("evaluatedPolicyGroup", groupName), zap.String("resourceUri", resourceEvaluation.ResourceVersion.Version)), &pb.ResourceEvaluationRequest{
+				ResourceUri: resourceEvaluation.ResourceVersion.Version,
+				PolicyGroup: groupName,
+				Source: &pb.ResourceEvaluationSource{
+					Name:            reevaluationSourceName,
+					SystemTriggered: true,
+				},
+			}, true)
+			if err == nil {
+				continue
+			}
+
+			if ctx.Err() != nil {
+				log.Warn("stopped re-evaluating resource versions", zap.Error(ctx.Err()))
+				return
+			}
+
+			log.Error("error re-evaluating resource version", zap.String("resourceUri", resourceEvaluation.ResourceVersion.Version), zap.Error(err))
+		}
+	}
+}
+
+// inheritingPolicyGroups returns the policy group along with every policy group that has it as an ancestor, since a change
+// to its assignments can change their resolved assignments as well
+func (m *manager) inheritingPolicyGroups(ctx context.Context, log *zap.Logger, policyGroup string) []string {
+	response, err := m.policyGroupManager.ListPolicyGroups(ctx, &pb.ListPolicyGroupsRequest{
+		PageSize: constants.MaxPageSize,
+	})
+	if err != nil {
+		log.Error("error listing policy groups, only the changed policy group will be re-evaluated", zap.Error(err))
+		return []string{policyGroup}
+	}
+
+	children := map[string][]string{}
+	for _, group := range response.PolicyGroups {
+		for _, parent := range group.Parents {
+			children[parent] = append(children[parent], group.Name)
+		}
+	}
+
+	policyGroups := []string{policyGroup}
+	visited := map[string]bool{policyGroup: true}
+	for i := 0; i < len(policyGroups); i++ {
+		for _, child := range children[policyGroups[i]] {
+			if visited[child] {
+				continue
+			}
+
+			visited[child] = true
+			policyGroups = append(policyGroups, child)
+		}
+	}
+
+	return policyGroups
+}
+
+func (m *manager) ListStaleResourceEvaluations(ctx context.Context, request *pb.ListStaleResourceEvaluationsRequest) (*pb.ListStaleResourceEvaluationsResponse, error) {
+	log := m.logger.Named("ListStaleResourceEvaluations").With(zap.Any("request", request))
+
+	if request.PolicyGroup == "" {
+		return nil, util.GrpcErrorWithCode(log, "policy group is required", nil, codes.InvalidArgument)
+	}
+
+	limit := int(request.Limit)
+	if limit < 0 || limit > maxStaleEvaluationLimit {
+		return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("limit must be between 0 and %d", maxStaleEvaluationLimit), nil, codes.InvalidArgument)
+	}
+	if limit == 0 {
+		limit = defaultStaleEvaluationLimit
+	}
+
+	resolvedAssignments, err := m.policyGroupManager.ResolvePolicyGroupAssignments(ctx, &pb.ResolvePolicyGroupAssignmentsRequest{
+		Name: request.PolicyGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceEvaluations, err := m.listLatestResourceEvaluations(ctx, log, request.PolicyGroup, time.Time{}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListStaleResourceEvaluationsResponse{}
+	if len(resourceEvaluations) == 0 {
+		return response, nil
+	}
+
+	var results []*pb.ResourceEvaluationResult
+	for _, resourceEvaluation := range resourceEvaluations {
+		results = append(results, &pb.ResourceEvaluationResult{ResourceEvaluation: resourceEvaluation})
+	}
+
+	if err := m.addPolicyEvaluations(ctx, log, results); err != nil {
+		return nil, err
+	}
+
+	assigned := map[string]bool{}
+	for _, assignment := range resolvedAssignments.PolicyAssignments {
+		assigned[assignment.PolicyVersionId] = true
+	}
+
+	for _, result := range results {
+		if staleEvaluation := diffEvaluatedPolicyVersions(result, assigned); staleEvaluation != nil {
+			response.StaleResourceEvaluations = append(response.StaleResourceEvaluations, staleEvaluation)
+		}
+	}
+
+	return response, nil
+}
+
+// diffEvaluatedPolicyVersions compares the policy versions that were considered in a resource evaluation, whether they
+// were evaluated or skipped, with the assigned policy versions. nil is returned if they're the same.
+func diffEvaluatedPolicyVersions(result *pb.ResourceEvaluationResult, assigned map[string]bool) *pb.StaleResourceEvaluation {
+	evaluated := map[string]bool{}
+	for _, policyEvaluation := range result.PolicyEvaluations {
+		evaluated[policyEvaluation.PolicyVersionId] = true
+	}
+	for _, skippedPolicy := range result.ResourceEvaluation.SkippedPolicies {
+		evaluated[skippedPolicy.PolicyVersionId] = true
+	}
+
+	staleEvaluation := &pb.StaleResourceEvaluation{
+		ResourceEvaluation: result.ResourceEvaluation,
+	}
+	for policyVersionId := range assigned {
+		if !evaluated[policyVersionId] {
+			staleEvaluation.AddedPolicyVersionIds = append(staleEvaluation.AddedPolicyVersionIds, policyVersionId)
+		}
+	}
+	for policyVersionId := range evaluated {
+		if !assigned[policyVersionId] {
+			staleEvaluation.RemovedPolicyVersionIds = append(staleEvaluation.RemovedPolicyVersionIds, policyVersionId)
+		}
+	}
+
+	if len(staleEvaluation.AddedPolicyVersionIds) == 0 && len(staleEvaluation.RemovedPolicyVersionIds) == 0 {
+		return nil
+	}
+
+	sort.Strings(staleEvaluation.AddedPolicyVersionIds)
+	sort.Strings(staleEvaluation.RemovedPolicyVersionIds)
+
+	return staleEvaluation
+}
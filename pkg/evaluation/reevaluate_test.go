@@ -0,0 +1,381 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	"github.com/rode/rode/pkg/resource/resourcefakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("re-evaluations", func() {
+	var (
+		ctx context.Context
+
+		esClient           *esutilfakes.FakeClient
+		evaluationConfig   *config.EvaluationConfig
+		policyGroupManager *policyfakes.FakePolicyGroupManager
+		resourceManager    *resourcefakes.FakeManager
+		indexManager       *mocks.FakeIndexManager
+
+		evaluationManager *manager
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		esClient = &esutilfakes.FakeClient{}
+		// no workers are started, so that queued jobs can be inspected
+		evaluationConfig = &config.EvaluationConfig{
+			QueueSize:        10,
+			ReevaluateWindow: time.Hour,
+		}
+		policyGroupManager = &policyfakes.FakePolicyGroupManager{}
+		resourceManager = &resourcefakes.FakeManager{}
+		indexManager = &mocks.FakeIndexManager{}
+		indexManager.AliasNameReturns(fake.LetterN(10))
+	})
+
+	JustBeforeEach(func() {
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient:           esClient,
+			evaluationConfig:   evaluationConfig,
+			policyGroupManager: policyGroupManager,
+			resourceManager:    resourceManager,
+			indexManager:       indexManager,
+		})
+	})
+
+	Context("reevaluatePolicyGroup", func() {
+		var (
+			resourceUris        map[string]string
+			listPolicyGroupsErr error
+		)
+
+		BeforeEach(func() {
+			resourceUris = map[string]string{
+				"base":    fake.URL(),
+				"staging": fake.URL(),
+				"prod":    fake.URL(),
+				"other":   fake.URL(),
+			}
+			listPolicyGroupsErr = nil
+
+			esClient.SearchStub = func(_ context.Context, request *esutil.SearchRequest) (*esutil.SearchResponse, error) {
+				policyGroup := (*(*request.Search.Query.Bool.Must)[0].(*filtering.Query).Term)["policyGroup"]
+				resourceEvaluationJson, _ := protojson.Marshal(&pb.ResourceEvaluation{
+					Id:              fake.UUID(),
+					PolicyGroup:     policyGroup,
+					ResourceVersion: &pb.ResourceVersion{Version: resourceUris[policyGroup]},
+				})
+
+				return &esutil.SearchResponse{
+					Hits: &esutil.EsSearchResponseHits{
+						Total: &esutil.EsSearchResponseTotal{Value: 1},
+						Hits:  []*esutil.EsSearchResponseHit{{Source: resourceEvaluationJson}},
+					},
+				}, nil
+			}
+			esClient.BulkReturns(&esutil.EsBulkResponse{}, nil)
+			resourceManager.GetResourceVersionReturns(&pb.ResourceVersion{}, nil)
+			policyGroupManager.GetPolicyGroupStub = func(_ context.Context, request *pb.GetPolicyGroupRequest) (*pb.PolicyGroup, error) {
+				return &pb.PolicyGroup{Name: request.Name}, nil
+			}
+			policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{
+				PolicyAssignments: []*pb.PolicyAssignment{
+					{PolicyVersionId: fmt.Sprintf("%s.%d", fake.UUID(), 1)},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			policyGroupManager.ListPolicyGroupsReturns(&pb.ListPolicyGroupsResponse{
+				PolicyGroups: []*pb.PolicyGroup{
+					{Name: "base"},
+					{Name: "prod", Parents: []string{"staging"}},
+					{Name: "staging", Parents: []string{"base"}},
+					{Name: "other"},
+				},
+			}, listPolicyGroupsErr)
+
+			evaluationManager.reevaluatePolicyGroup(ctx, "base")
+		})
+
+		It("should search for evaluations within the window", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(3))
+
+			_, searchRequest := esClient.SearchArgsForCall(0)
+			must := *searchRequest.Search.Query.Bool.Must
+			Expect(must).To(HaveLen(2))
+
+			createdRange := (*must[1].(*filtering.Query).Range)["created"]
+			since, err := time.Parse(time.RFC3339, createdRange.GreaterEquals)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+		})
+
+		It("should re-evaluate the policy group and the policy groups that inherit from it", func() {
+			Expect(evaluationManager.evaluationJobs).To(HaveLen(3))
+
+			var actualResourceUris []string
+			for i := 0; i < 3; i++ {
+				job := <-evaluationManager.evaluationJobs
+				actualResourceUris = append(actualResourceUris, job.resourceUri)
+
+				Expect(job.resourceEvaluation.Source.SystemTriggered).To(BeTrue())
+				Expect(job.resourceEvaluation.Source.Name).To(Equal(reevaluationSourceName))
+			}
+
+			Expect(actualResourceUris).To(ConsistOf(resourceUris["base"], resourceUris["staging"], resourceUris["prod"]))
+		})
+
+		When("the policy groups cannot be listed", func() {
+			BeforeEach(func() {
+				listPolicyGroupsErr = errors.New(fake.Word())
+			})
+
+			It("should only re-evaluate the changed policy group", func() {
+				Expect(esClient.SearchCallCount()).To(Equal(1))
+				Expect(evaluationManager.evaluationJobs).To(HaveLen(1))
+			})
+		})
+
+		When("the evaluation queue is full", func() {
+			var cancel context.CancelFunc
+
+			BeforeEach(func() {
+				evaluationConfig.QueueSize = 1
+				ctx, cancel = context.WithTimeout(ctx, 50*time.Millisecond)
+			})
+
+			AfterEach(func() {
+				cancel()
+			})
+
+			It("should wait for room in the queue until the context is done", func() {
+				Expect(ctx.Err()).To(HaveOccurred())
+				Expect(esClient.SearchCallCount()).To(Equal(2))
+				Expect(evaluationManager.evaluationJobs).To(HaveLen(1))
+			})
+
+			It("should not store the evaluations that weren't queued", func() {
+				Expect(esClient.BulkCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("PolicyAssignmentChanged", func() {
+		var (
+			policyGroup    string
+			listStarted    chan struct{}
+			releaseListing chan struct{}
+		)
+
+		BeforeEach(func() {
+			policyGroup = fake.LetterN(10)
+			listStarted = make(chan struct{}, 10)
+			releaseListing = make(chan struct{})
+
+			policyGroupManager.ListPolicyGroupsStub = func(_ context.Context, _ *pb.ListPolicyGroupsRequest) (*pb.ListPolicyGroupsResponse, error) {
+				listStarted <- struct{}{}
+				<-releaseListing
+
+				return &pb.ListPolicyGroupsResponse{}, nil
+			}
+			esClient.SearchReturns(&esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Total: &esutil.EsSearchResponseTotal{},
+				},
+			}, nil)
+		})
+
+		It("should coalesce changes that arrive during a re-evaluation", func() {
+			evaluationManager.PolicyAssignmentChanged(policyGroup)
+			Eventually(listStarted).Should(Receive())
+
+			for i := 0; i < 3; i++ {
+				evaluationManager.PolicyAssignmentChanged(policyGroup)
+			}
+			close(releaseListing)
+
+			Eventually(func() int {
+				evaluationManager.reevaluationsMu.Lock()
+				defer evaluationManager.reevaluationsMu.Unlock()
+
+				return len(evaluationManager.reevaluations)
+			}).Should(BeZero())
+			Expect(policyGroupManager.ListPolicyGroupsCallCount()).To(Equal(2))
+		})
+
+		It("should re-evaluate different policy groups independently", func() {
+			evaluationManager.PolicyAssignmentChanged(policyGroup)
+			evaluationManager.PolicyAssignmentChanged(fake.LetterN(11))
+
+			Eventually(listStarted).Should(Receive())
+			Eventually(listStarted).Should(Receive())
+			close(releaseListing)
+		})
+	})
+
+	Context("ListStaleResourceEvaluations", func() {
+		var (
+			request            *pb.ListStaleResourceEvaluationsRequest
+			policyIds          []string
+			resourceEvaluation *pb.ResourceEvaluation
+			staleEvaluation    *pb.ResourceEvaluation
+			resolveError       error
+			searchHits         []*esutil.EsSearchResponseHit
+
+			actualResponse *pb.ListStaleResourceEvaluationsResponse
+			actualError    error
+		)
+
+		policyEvaluationHits := func(policyVersionIds ...string) *esutil.EsMultiSearchResponseHitsSummary {
+			summary := &esutil.EsMultiSearchResponseHitsSummary{
+				Hits: &esutil.EsMultiSearchResponseHits{},
+			}
+			for _, policyVersionId := range policyVersionIds {
+				policyEvaluationJson, _ := protojson.Marshal(&pb.PolicyEvaluation{PolicyVersionId: policyVersionId})
+				summary.Hits.Hits = append(summary.Hits.Hits, &esutil.EsMultiSearchResponseHit{Source: policyEvaluationJson})
+			}
+
+			return summary
+		}
+
+		BeforeEach(func() {
+			request = &pb.ListStaleResourceEvaluationsRequest{
+				PolicyGroup: fake.LetterN(10),
+			}
+			policyIds = []string{fake.UUID(), fake.UUID()}
+			resolveError = nil
+
+			resourceEvaluation = &pb.ResourceEvaluation{
+				Id: fake.UUID(),
+				SkippedPolicies: []*pb.SkippedPolicy{
+					{PolicyVersionId: policyIds[1] + ".1"},
+				},
+			}
+			staleEvaluation = &pb.ResourceEvaluation{
+				Id: fake.UUID(),
+			}
+			searchHits = nil
+			for _, evaluation := range []*pb.ResourceEvaluation{resourceEvaluation, staleEvaluation} {
+				evaluationJson, _ := protojson.Marshal(evaluation)
+				searchHits = append(searchHits, &esutil.EsSearchResponseHit{Source: evaluationJson})
+			}
+
+			esClient.MultiSearchReturns(&esutil.EsMultiSearchResponse{
+				Responses: []*esutil.EsMultiSearchResponseHitsSummary{
+					policyEvaluationHits(policyIds[0] + ".2"),
+					policyEvaluationHits(policyIds[0]+".1", policyIds[1]+".1"),
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			policyGroupManager.ResolvePolicyGroupAssignmentsReturns(&pb.ResolvePolicyGroupAssignmentsResponse{
+				PolicyAssignments: []*pb.PolicyAssignment{
+					{PolicyVersionId: policyIds[0] + ".2"},
+					{PolicyVersionId: policyIds[1] + ".1"},
+				},
+			}, resolveError)
+			esClient.SearchReturns(&esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Total: &esutil.EsSearchResponseTotal{Value: len(searchHits)},
+					Hits:  searchHits,
+				},
+			}, nil)
+
+			actualResponse, actualError = evaluationManager.ListStaleResourceEvaluations(ctx, request)
+		})
+
+		It("should resolve the current assignments for the policy group", func() {
+			Expect(policyGroupManager.ResolvePolicyGroupAssignmentsCallCount()).To(Equal(1))
+
+			_, resolveRequest := policyGroupManager.ResolvePolicyGroupAssignmentsArgsForCall(0)
+			Expect(resolveRequest.Name).To(Equal(request.PolicyGroup))
+		})
+
+		It("should only return the evaluations that used different policy versions", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.StaleResourceEvaluations).To(HaveLen(1))
+
+			actualStaleEvaluation := actualResponse.StaleResourceEvaluations[0]
+			Expect(actualStaleEvaluation.ResourceEvaluation.Id).To(Equal(staleEvaluation.Id))
+			Expect(actualStaleEvaluation.AddedPolicyVersionIds).To(ConsistOf(policyIds[0] + ".2"))
+			Expect(actualStaleEvaluation.RemovedPolicyVersionIds).To(ConsistOf(policyIds[0] + ".1"))
+		})
+
+		When("there are no resource evaluations", func() {
+			BeforeEach(func() {
+				searchHits = nil
+			})
+
+			It("should return an empty response", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.StaleResourceEvaluations).To(BeEmpty())
+				Expect(esClient.MultiSearchCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the policy group is missing", func() {
+			BeforeEach(func() {
+				request.PolicyGroup = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("the limit is too high", func() {
+			BeforeEach(func() {
+				request.Limit = maxStaleEvaluationLimit + 1
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+		})
+
+		When("the assignments can't be resolved", func() {
+			BeforeEach(func() {
+				resolveError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(esClient.SearchCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,194 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *manager) ReplayResourceEvaluation(ctx context.Context, request *pb.ReplayResourceEvaluationRequest) (*pb.ReplayResourceEvaluationResponse, error) {
+	log := m.logger.Named("ReplayResourceEvaluation").With(zap.Any("request", request))
+
+	if request.Id == "" {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation id is required", nil, codes.InvalidArgument)
+	}
+
+	requestedPolicyVersionIds := map[string]bool{}
+	for _, policyVersionId := range request.PolicyVersionIds {
+		if policyVersionId == "" {
+			return nil, util.GrpcErrorWithCode(log, "policy version ids cannot be empty", nil, codes.InvalidArgument)
+		}
+		if requestedPolicyVersionIds[policyVersionId] {
+			return nil, util.GrpcErrorWithCode(log, fmt.Sprintf("policy version %s was specified more than once", policyVersionId), nil, codes.InvalidArgument)
+		}
+		requestedPolicyVersionIds[policyVersionId] = true
+	}
+
+	original, err := m.GetResourceEvaluation(ctx, &pb.GetResourceEvaluationRequest{Id: request.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	switch original.ResourceEvaluation.State {
+	case pb.ResourceEvaluationState_PENDING, pb.ResourceEvaluationState_RUNNING, pb.ResourceEvaluationState_FAILED:
+		return nil, util.GrpcErrorWithCode(log, "only complete resource evaluations can be replayed", nil, codes.FailedPrecondition)
+	}
+
+	occurrences, err := m.replayOccurrences(ctx, log, original.ResourceEvaluation)
+	if err != nil {
+		return nil, err
+	}
+
+	// policy evaluations are matched by policy, so that a new version can be compared to the one that was evaluated
+	originalPolicyEvaluations := map[string]*pb.PolicyEvaluation{}
+	var policyVersionIds []string
+	for _, policyEvaluation := range original.PolicyEvaluations {
+		originalPolicyEvaluations[policyIdFromVersionId(policyEvaluation.PolicyVersionId)] = policyEvaluation
+		policyVersionIds = append(policyVersionIds, policyEvaluation.PolicyVersionId)
+	}
+	if len(request.PolicyVersionIds) != 0 {
+		policyVersionIds = request.PolicyVersionIds
+	}
+
+	input := &pb.EvaluatePolicyInput{
+		Occurrences: occurrences,
+	}
+	response := &pb.ReplayResourceEvaluationResponse{
+		ResourceEvaluation: original.ResourceEvaluation,
+	}
+	for _, occurrence := range occurrences {
+		response.OccurrenceNames = append(response.OccurrenceNames, occurrence.Name)
+	}
+
+	for _, policyVersionId := range policyVersionIds {
+		policyEntity, err := m.getPolicyVersion(ctx, log, policyVersionId)
+		if err != nil {
+			return nil, err
+		}
+
+		evaluatePolicyResponse, err := m.evaluatePolicy(ctx, policyVersionId, policyEntity.RegoContent, input)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, fmt.Sprintf("error evaluating policy version %s", policyVersionId), err)
+		}
+
+		result := policyResult(evaluatePolicyResponse)
+		replay := &pb.PolicyEvaluationReplay{
+			PolicyVersionId: policyVersionId,
+			Pass:            result.Pass,
+		}
+		var originalViolations []*pb.EvaluatePolicyViolation
+		if originalPolicyEvaluation, ok := originalPolicyEvaluations[policyIdFromVersionId(policyVersionId)]; ok {
+			replay.OriginalPolicyVersionId = originalPolicyEvaluation.PolicyVersionId
+			replay.OriginalPass = originalPolicyEvaluation.Pass
+			originalViolations = originalPolicyEvaluation.Violations
+		}
+		replay.Violations = diffViolations(originalViolations, result.Violations)
+
+		response.PolicyEvaluations = append(response.PolicyEvaluations, replay)
+	}
+
+	return response, nil
+}
+
+// replayOccurrences returns the occurrences that were used in the original resource evaluation. Evaluations from before
+// policy inputs were stored fall back to the current occurrences, excluding any that were created after the evaluation.
+// Occurrences that have been updated since the evaluation can't be restored in that case.
+func (m *manager) replayOccurrences(ctx context.Context, log *zap.Logger, resourceEvaluation *pb.ResourceEvaluation) ([]*grafeas_go_proto.Occurrence, error) {
+	resourceEvaluationInput, err := m.GetResourceEvaluationInput(ctx, &pb.GetResourceEvaluationInputRequest{Id: resourceEvaluation.Id})
+	if err == nil {
+		return resourceEvaluationInput.Input.Occurrences, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	log.Debug("resource evaluation input not found, using current occurrences")
+	occurrences, err := m.listResourceOccurrences(ctx, log, resourceEvaluation.ResourceVersion.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var replayOccurrences []*grafeas_go_proto.Occurrence
+	for _, occurrence := range occurrences {
+		if occurrence.CreateTime.AsTime().After(resourceEvaluation.Created.AsTime()) {
+			continue
+		}
+
+		replayOccurrences = append(replayOccurrences, occurrence)
+	}
+
+	return replayOccurrences, nil
+}
+
+// diffViolations compares the outcome of each rule, matched by violation id. Results are in the order of the replayed
+// violations, followed by any rules that no longer exist.
+func diffViolations(originalViolations, replayedViolations []*pb.EvaluatePolicyViolation) []*pb.EvaluatePolicyViolationDiff {
+	originalViolationsById := map[string]*pb.EvaluatePolicyViolation{}
+	for _, violation := range originalViolations {
+		originalViolationsById[violation.Id] = violation
+	}
+
+	var diffs []*pb.EvaluatePolicyViolationDiff
+	replayedIds := map[string]bool{}
+	for _, replayed := range replayedViolations {
+		replayedIds[replayed.Id] = true
+		diff := &pb.EvaluatePolicyViolationDiff{
+			Id:       replayed.Id,
+			Replayed: replayed,
+		}
+
+		original, ok := originalViolationsById[replayed.Id]
+		switch {
+		case !ok:
+			diff.Change = pb.EvaluatePolicyViolationChange_ADDED
+		case original.Pass == replayed.Pass:
+			diff.Change = pb.EvaluatePolicyViolationChange_UNCHANGED
+		case replayed.Pass:
+			diff.Change = pb.EvaluatePolicyViolationChange_NOW_PASSING
+		default:
+			diff.Change = pb.EvaluatePolicyViolationChange_NOW_FAILING
+		}
+		diff.Original = original
+
+		diffs = append(diffs, diff)
+	}
+
+	for _, original := range originalViolations {
+		if replayedIds[original.Id] {
+			continue
+		}
+
+		diffs = append(diffs, &pb.EvaluatePolicyViolationDiff{
+			Id:       original.Id,
+			Change:   pb.EvaluatePolicyViolationChange_REMOVED,
+			Original: original,
+		})
+	}
+
+	return diffs
+}
+
+func policyIdFromVersionId(policyVersionId string) string {
+	return strings.SplitN(policyVersionId, ".", 2)[0]
+}
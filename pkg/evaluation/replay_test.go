@@ -0,0 +1,350 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/opa"
+	"github.com/rode/rode/opa/opafakes"
+	"github.com/rode/rode/pkg/grafeas/grafeasfakes"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	grafeas_common_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
+	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("resource evaluation replays", func() {
+	var (
+		ctx context.Context
+
+		esClient          *esutilfakes.FakeClient
+		policyManager     *policyfakes.FakeManager
+		grafeasExtensions *grafeasfakes.FakeExtensions
+		opaClient         *opafakes.FakeClient
+
+		evaluationManager Manager
+
+		request                  *pb.ReplayResourceEvaluationRequest
+		resourceEvaluation       *pb.ResourceEvaluation
+		policyId                 string
+		originalPolicyVersionId  string
+		originalPolicyEvaluation *pb.PolicyEvaluation
+		occurrences              []*grafeas_proto.Occurrence
+		replayedViolations       []*pb.EvaluatePolicyViolation
+
+		inputFound          bool
+		getPolicyVersionErr error
+
+		actualResponse *pb.ReplayResourceEvaluationResponse
+		actualError    error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		esClient = &esutilfakes.FakeClient{}
+		policyManager = &policyfakes.FakeManager{}
+		grafeasExtensions = &grafeasfakes.FakeExtensions{}
+		opaClient = &opafakes.FakeClient{}
+
+		policyId = fake.UUID()
+		originalPolicyVersionId = fmt.Sprintf("%s.1", policyId)
+		resourceEvaluation = &pb.ResourceEvaluation{
+			Id:      fake.UUID(),
+			Pass:    false,
+			State:   pb.ResourceEvaluationState_COMPLETE,
+			Created: timestamppb.Now(),
+			ResourceVersion: &pb.ResourceVersion{
+				Version: fake.URL(),
+			},
+		}
+		originalPolicyEvaluation = &pb.PolicyEvaluation{
+			Id:                   fake.UUID(),
+			ResourceEvaluationId: resourceEvaluation.Id,
+			PolicyVersionId:      originalPolicyVersionId,
+			Pass:                 false,
+			Violations: []*pb.EvaluatePolicyViolation{
+				{Id: "unchanged", Pass: true},
+				{Id: "fixed", Pass: false},
+				{Id: "broken", Pass: true},
+				{Id: "removed", Pass: true},
+			},
+		}
+		replayedViolations = []*pb.EvaluatePolicyViolation{
+			{Id: "unchanged", Pass: true},
+			{Id: "fixed", Pass: true},
+			{Id: "broken", Pass: false},
+			{Id: "added", Pass: true},
+		}
+		occurrences = []*grafeas_proto.Occurrence{
+			createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD),
+		}
+
+		request = &pb.ReplayResourceEvaluationRequest{
+			Id: resourceEvaluation.Id,
+		}
+
+		inputFound = true
+		getPolicyVersionErr = nil
+
+		policyManager.GetPolicyVersionStub = func(_ context.Context, id string) (*pb.PolicyEntity, error) {
+			return &pb.PolicyEntity{Id: id, RegoContent: id}, getPolicyVersionErr
+		}
+		opaClient.EvaluatePolicyStub = func(_ context.Context, policyVersionId, _ string, _ []byte) (*opa.EvaluatePolicyResponse, error) {
+			return &opa.EvaluatePolicyResponse{
+				Result: &opa.EvaluatePolicyResult{
+					Pass:       false,
+					Violations: replayedViolations,
+				},
+			}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		resourceEvaluationJson, _ := protojson.Marshal(resourceEvaluation)
+		policyEvaluationJson, _ := protojson.Marshal(originalPolicyEvaluation)
+		esClient.MultiSearchReturns(&esutil.EsMultiSearchResponse{
+			Responses: []*esutil.EsMultiSearchResponseHitsSummary{
+				{
+					Hits: &esutil.EsMultiSearchResponseHits{
+						Total: &esutil.EsSearchResponseTotal{Value: 1},
+						Hits:  []*esutil.EsMultiSearchResponseHit{{Source: resourceEvaluationJson}},
+					},
+				},
+				{
+					Hits: &esutil.EsMultiSearchResponseHits{
+						Total: &esutil.EsSearchResponseTotal{Value: 1},
+						Hits:  []*esutil.EsMultiSearchResponseHit{{Source: policyEvaluationJson}},
+					},
+				},
+			},
+		}, nil)
+
+		inputJson, _ := protojson.Marshal(&pb.ResourceEvaluationInput{
+			ResourceEvaluationId: resourceEvaluation.Id,
+			Input: &pb.EvaluatePolicyInput{
+				Occurrences: occurrences,
+			},
+		})
+		esClient.GetReturns(&esutil.EsGetResponse{Found: inputFound, Source: inputJson}, nil)
+
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient:          esClient,
+			evaluationConfig:  &config.EvaluationConfig{MaxOccurrences: 100},
+			policyManager:     policyManager,
+			grafeasExtensions: grafeasExtensions,
+			opa:               opaClient,
+		})
+		actualResponse, actualError = evaluationManager.ReplayResourceEvaluation(ctx, request)
+	})
+
+	It("should replay the original policy versions against the stored input", func() {
+		Expect(actualError).NotTo(HaveOccurred())
+		Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(BeZero())
+
+		Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(1))
+		_, policyVersionId, _, input := opaClient.EvaluatePolicyArgsForCall(0)
+		Expect(policyVersionId).To(Equal(originalPolicyVersionId))
+
+		var actualInput map[string][]map[string]interface{}
+		Expect(json.Unmarshal(input, &actualInput)).To(Succeed())
+		Expect(actualInput["occurrences"]).To(HaveLen(1))
+		Expect(actualInput["occurrences"][0]["name"]).To(Equal(occurrences[0].Name))
+
+		Expect(actualResponse.ResourceEvaluation.Id).To(Equal(resourceEvaluation.Id))
+		Expect(actualResponse.OccurrenceNames).To(ConsistOf(occurrences[0].Name))
+	})
+
+	It("should compare each rule with the original evaluation", func() {
+		Expect(actualResponse.PolicyEvaluations).To(HaveLen(1))
+		replay := actualResponse.PolicyEvaluations[0]
+
+		Expect(replay.PolicyVersionId).To(Equal(originalPolicyVersionId))
+		Expect(replay.OriginalPolicyVersionId).To(Equal(originalPolicyVersionId))
+		Expect(replay.OriginalPass).To(BeFalse())
+		Expect(replay.Pass).To(BeFalse())
+
+		changes := map[string]pb.EvaluatePolicyViolationChange{}
+		for _, diff := range replay.Violations {
+			changes[diff.Id] = diff.Change
+		}
+		Expect(changes).To(Equal(map[string]pb.EvaluatePolicyViolationChange{
+			"unchanged": pb.EvaluatePolicyViolationChange_UNCHANGED,
+			"fixed":     pb.EvaluatePolicyViolationChange_NOW_PASSING,
+			"broken":    pb.EvaluatePolicyViolationChange_NOW_FAILING,
+			"added":     pb.EvaluatePolicyViolationChange_ADDED,
+			"removed":   pb.EvaluatePolicyViolationChange_REMOVED,
+		}))
+	})
+
+	It("should not store anything", func() {
+		Expect(esClient.BulkCallCount()).To(BeZero())
+		Expect(esClient.CreateCallCount()).To(BeZero())
+	})
+
+	When("policy versions are specified", func() {
+		var (
+			newPolicyVersionId   string
+			otherPolicyVersionId string
+		)
+
+		BeforeEach(func() {
+			newPolicyVersionId = fmt.Sprintf("%s.2", policyId)
+			otherPolicyVersionId = fmt.Sprintf("%s.1", fake.UUID())
+			request.PolicyVersionIds = []string{newPolicyVersionId, otherPolicyVersionId}
+		})
+
+		It("should evaluate the requested policy versions", func() {
+			Expect(opaClient.EvaluatePolicyCallCount()).To(Equal(2))
+
+			_, firstPolicyVersionId, _, _ := opaClient.EvaluatePolicyArgsForCall(0)
+			_, secondPolicyVersionId, _, _ := opaClient.EvaluatePolicyArgsForCall(1)
+			Expect(firstPolicyVersionId).To(Equal(newPolicyVersionId))
+			Expect(secondPolicyVersionId).To(Equal(otherPolicyVersionId))
+		})
+
+		It("should compare a new version of a policy with the original version", func() {
+			Expect(actualResponse.PolicyEvaluations[0].PolicyVersionId).To(Equal(newPolicyVersionId))
+			Expect(actualResponse.PolicyEvaluations[0].OriginalPolicyVersionId).To(Equal(originalPolicyVersionId))
+		})
+
+		It("should treat every rule of a policy that wasn't originally evaluated as added", func() {
+			replay := actualResponse.PolicyEvaluations[1]
+
+			Expect(replay.OriginalPolicyVersionId).To(BeEmpty())
+			Expect(replay.Violations).To(HaveLen(len(replayedViolations)))
+			for _, diff := range replay.Violations {
+				Expect(diff.Change).To(Equal(pb.EvaluatePolicyViolationChange_ADDED))
+				Expect(diff.Original).To(BeNil())
+			}
+		})
+	})
+
+	When("a policy version doesn't produce a result", func() {
+		BeforeEach(func() {
+			opaClient.EvaluatePolicyStub = nil
+			opaClient.EvaluatePolicyReturns(&opa.EvaluatePolicyResponse{}, nil)
+		})
+
+		It("should report it as failing without any violations", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.PolicyEvaluations).NotTo(BeEmpty())
+
+			replay := actualResponse.PolicyEvaluations[0]
+			Expect(replay.Pass).To(BeFalse())
+			Expect(replay.Violations).NotTo(BeEmpty())
+			for _, diff := range replay.Violations {
+				Expect(diff.Change).To(Equal(pb.EvaluatePolicyViolationChange_REMOVED))
+			}
+		})
+	})
+
+	When("the input was not stored with the original evaluation", func() {
+		var (
+			earlierOccurrence *grafeas_proto.Occurrence
+			laterOccurrence   *grafeas_proto.Occurrence
+		)
+
+		BeforeEach(func() {
+			inputFound = false
+
+			earlierOccurrence = createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD)
+			earlierOccurrence.CreateTime = timestamppb.New(resourceEvaluation.Created.AsTime().Add(-time.Hour))
+			laterOccurrence = createRandomOccurrence(grafeas_common_proto.NoteKind_VULNERABILITY)
+			laterOccurrence.CreateTime = timestamppb.New(resourceEvaluation.Created.AsTime().Add(time.Hour))
+
+			grafeasExtensions.ListAllVersionedResourceOccurrencesReturns([]*grafeas_proto.Occurrence{earlierOccurrence, laterOccurrence}, nil)
+		})
+
+		It("should list the current occurrences for the resource version", func() {
+			Expect(grafeasExtensions.ListAllVersionedResourceOccurrencesCallCount()).To(Equal(1))
+
+			_, resourceUri, _ := grafeasExtensions.ListAllVersionedResourceOccurrencesArgsForCall(0)
+			Expect(resourceUri).To(Equal(resourceEvaluation.ResourceVersion.Version))
+		})
+
+		It("should ignore occurrences created after the original evaluation", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.OccurrenceNames).To(ConsistOf(earlierOccurrence.Name))
+		})
+	})
+
+	When("the original evaluation is not complete", func() {
+		BeforeEach(func() {
+			resourceEvaluation.State = pb.ResourceEvaluationState_PENDING
+		})
+
+		It("should return an error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+			Expect(opaClient.EvaluatePolicyCallCount()).To(BeZero())
+		})
+	})
+
+	When("a policy version cannot be fetched", func() {
+		BeforeEach(func() {
+			getPolicyVersionErr = errors.New(fake.Word())
+		})
+
+		It("should return an error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+		})
+	})
+
+	When("a policy version does not exist", func() {
+		BeforeEach(func() {
+			policyManager.GetPolicyVersionStub = nil
+			policyManager.GetPolicyVersionReturns(nil, nil)
+		})
+
+		It("should return a not found error", func() {
+			Expect(actualResponse).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+		})
+	})
+
+	DescribeTable("invalid requests",
+		func(request *pb.ReplayResourceEvaluationRequest) {
+			evaluationManager := newTestManager(&managerDependencies{
+				esClient:          esClient,
+				policyManager:     policyManager,
+				grafeasExtensions: grafeasExtensions,
+				opa:               opaClient,
+			})
+
+			response, err := evaluationManager.ReplayResourceEvaluation(context.Background(), request)
+
+			Expect(response).To(BeNil())
+			Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+		},
+		Entry("missing id", &pb.ReplayResourceEvaluationRequest{}),
+		Entry("empty policy version id", &pb.ReplayResourceEvaluationRequest{Id: "id", PolicyVersionIds: []string{""}}),
+		Entry("duplicate policy version id", &pb.ReplayResourceEvaluationRequest{Id: "id", PolicyVersionIds: []string{"policy.1", "policy.1"}}),
+	)
+})
@@ -0,0 +1,83 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"github.com/rode/rode/pkg/policy"
+	"github.com/rode/rode/pkg/resource"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+)
+
+// selectPolicyAssignments returns the policy assignments whose selectors match the resource being evaluated. Assignments
+// that don't match are recorded on the resource evaluation as skipped.
+func selectPolicyAssignments(log *zap.Logger, resourceEvaluation *pb.ResourceEvaluation, policyAssignments []*pb.PolicyAssignment) []*pb.PolicyAssignment {
+	var (
+		selected     []*pb.PolicyAssignment
+		rodeResource *pb.Resource
+		resourceErr  error
+	)
+
+	for _, policyAssignment := range policyAssignments {
+		if policyAssignment.Selector == nil {
+			selected = append(selected, policyAssignment)
+			continue
+		}
+
+		if rodeResource == nil && resourceErr == nil {
+			rodeResource, resourceErr = resource.ResourceFromUri(resourceEvaluation.ResourceVersion.GetVersion())
+		}
+
+		var (
+			matches bool
+			reason  string
+		)
+		if resourceErr != nil {
+			reason = "unable to determine the resource name and type"
+		} else {
+			matches, reason = policy.MatchSelector(policyAssignment.Selector, rodeResource)
+		}
+
+		if matches {
+			selected = append(selected, policyAssignment)
+			continue
+		}
+
+		log.Debug("skipping policy assignment", zap.String("policyAssignmentId", policyAssignment.Id), zap.String("reason", reason))
+		resourceEvaluation.SkippedPolicies = append(resourceEvaluation.SkippedPolicies, &pb.SkippedPolicy{
+			PolicyAssignmentId: policyAssignment.Id,
+			PolicyVersionId:    policyAssignment.PolicyVersionId,
+			Reason:             reason,
+		})
+	}
+
+	return selected
+}
+
+// policyAssignmentSelectsResource reports whether the policy assignment's selector matches the resource version.
+func policyAssignmentSelectsResource(policyAssignment *pb.PolicyAssignment, resourceVersion *pb.ResourceVersion) bool {
+	if policyAssignment.Selector == nil {
+		return true
+	}
+
+	rodeResource, err := resource.ResourceFromUri(resourceVersion.GetVersion())
+	if err != nil {
+		return false
+	}
+
+	matches, _ := policy.MatchSelector(policyAssignment.Selector, rodeResource)
+
+	return matches
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+var _ = Describe("selectPolicyAssignments", func() {
+	var (
+		resourceEvaluation *pb.ResourceEvaluation
+		policyAssignments  []*pb.PolicyAssignment
+		actualAssignments  []*pb.PolicyAssignment
+	)
+
+	BeforeEach(func() {
+		resourceEvaluation = &pb.ResourceEvaluation{
+			ResourceVersion: &pb.ResourceVersion{
+				Version: "harbor.localhost/rode-demo/node-app@sha256:" + fake.LetterN(64),
+			},
+		}
+		policyAssignments = []*pb.PolicyAssignment{
+			{
+				Id:              fake.UUID(),
+				PolicyVersionId: fake.UUID(),
+			},
+			{
+				Id:              fake.UUID(),
+				PolicyVersionId: fake.UUID(),
+				Selector: &pb.PolicyAssignmentSelector{
+					ResourceTypes: []pb.ResourceType{pb.ResourceType_DOCKER},
+				},
+			},
+			{
+				Id:              fake.UUID(),
+				PolicyVersionId: fake.UUID(),
+				Selector: &pb.PolicyAssignmentSelector{
+					ResourceTypes: []pb.ResourceType{pb.ResourceType_MAVEN},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		actualAssignments = selectPolicyAssignments(logger, resourceEvaluation, policyAssignments)
+	})
+
+	It("should return the assignments that match the resource", func() {
+		Expect(actualAssignments).To(ConsistOf(policyAssignments[0], policyAssignments[1]))
+	})
+
+	It("should record the assignments that were skipped", func() {
+		Expect(resourceEvaluation.SkippedPolicies).To(HaveLen(1))
+
+		skippedPolicy := resourceEvaluation.SkippedPolicies[0]
+		Expect(skippedPolicy.PolicyAssignmentId).To(Equal(policyAssignments[2].Id))
+		Expect(skippedPolicy.PolicyVersionId).To(Equal(policyAssignments[2].PolicyVersionId))
+		Expect(skippedPolicy.Reason).To(ContainSubstring("DOCKER"))
+	})
+
+	When("the resource uri can't be parsed", func() {
+		BeforeEach(func() {
+			resourceEvaluation.ResourceVersion.Version = fake.LetterN(10)
+		})
+
+		It("should only return the assignments without a selector", func() {
+			Expect(actualAssignments).To(ConsistOf(policyAssignments[0]))
+		})
+
+		It("should skip the assignments with a selector", func() {
+			Expect(resourceEvaluation.SkippedPolicies).To(HaveLen(2))
+			Expect(resourceEvaluation.SkippedPolicies[0].Reason).To(Equal("unable to determine the resource name and type"))
+		})
+	})
+})
@@ -0,0 +1,56 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+// meetsSeverityThreshold determines whether a failed policy evaluation should fail the resource evaluation.
+// Violations without a severity are treated as meeting any threshold, as is a failed policy that didn't report which
+// of its violations failed.
+func meetsSeverityThreshold(policyEvaluation *pb.PolicyEvaluation, threshold pb.ViolationSeverity) bool {
+	if threshold == pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED {
+		return true
+	}
+
+	failedViolations := 0
+	for _, violation := range policyEvaluation.Violations {
+		if violation.Pass || violation.Waived {
+			continue
+		}
+
+		failedViolations++
+		if violation.Severity == pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED || violation.Severity >= threshold {
+			return true
+		}
+	}
+
+	return failedViolations == 0
+}
+
+// countViolationsBySeverity tallies the failed, unwaived violations across all policy evaluations, keyed by the severity name
+func countViolationsBySeverity(policyEvaluations []*pb.PolicyEvaluation) map[string]int32 {
+	counts := map[string]int32{}
+	for _, policyEvaluation := range policyEvaluations {
+		for _, violation := range policyEvaluation.Violations {
+			if !violation.Pass && !violation.Waived {
+				counts[violation.Severity.String()]++
+			}
+		}
+	}
+
+	return counts
+}
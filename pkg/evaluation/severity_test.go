@@ -0,0 +1,50 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+var _ = Describe("severity", func() {
+	DescribeTable("meetsSeverityThreshold",
+		func(threshold pb.ViolationSeverity, violations []*pb.EvaluatePolicyViolation, expected bool) {
+			policyEvaluation := &pb.PolicyEvaluation{Pass: false, Violations: violations}
+
+			Expect(meetsSeverityThreshold(policyEvaluation, threshold)).To(Equal(expected))
+		},
+		Entry("no threshold", pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED, []*pb.EvaluatePolicyViolation{
+			{Pass: false, Severity: pb.ViolationSeverity_LOW},
+		}, true),
+		Entry("failed violation below the threshold", pb.ViolationSeverity_HIGH, []*pb.EvaluatePolicyViolation{
+			{Pass: false, Severity: pb.ViolationSeverity_MEDIUM},
+		}, false),
+		Entry("failed violation at the threshold", pb.ViolationSeverity_HIGH, []*pb.EvaluatePolicyViolation{
+			{Pass: false, Severity: pb.ViolationSeverity_LOW},
+			{Pass: false, Severity: pb.ViolationSeverity_HIGH},
+		}, true),
+		Entry("passing violation above the threshold", pb.ViolationSeverity_HIGH, []*pb.EvaluatePolicyViolation{
+			{Pass: false, Severity: pb.ViolationSeverity_LOW},
+			{Pass: true, Severity: pb.ViolationSeverity_CRITICAL},
+		}, false),
+		Entry("failed violation without a severity", pb.ViolationSeverity_CRITICAL, []*pb.EvaluatePolicyViolation{
+			{Pass: false},
+		}, true),
+		Entry("failed policy without violations", pb.ViolationSeverity_CRITICAL, nil, true),
+	)
+})
@@ -0,0 +1,109 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rode/rode/pkg/signing"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+)
+
+func (m *manager) GetEvaluationPublicKey(_ context.Context, _ *pb.GetEvaluationPublicKeyRequest) (*pb.EvaluationPublicKey, error) {
+	log := m.logger.Named("GetEvaluationPublicKey")
+
+	if m.signer == nil {
+		return nil, util.GrpcErrorWithCode(log, "evaluation signing is not configured", nil, codes.FailedPrecondition)
+	}
+
+	publicKey, err := signing.MarshalPublicKey(m.signer.PublicKey())
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error encoding public key", err)
+	}
+
+	return &pb.EvaluationPublicKey{
+		KeyId:     m.signer.KeyId(),
+		PublicKey: string(publicKey),
+	}, nil
+}
+
+// signResourceEvaluationResults signs each resource evaluation, and its policy evaluations if configured to do so.
+// The resource evaluation records a digest of each policy evaluation, so its signature covers them either way.
+// Evaluations are left unsigned when no signing key has been configured.
+func (m *manager) signResourceEvaluationResults(results ...*pb.ResourceEvaluationResult) error {
+	if m.signer == nil {
+		return nil
+	}
+
+	for _, result := range results {
+		resourceEvaluation := result.ResourceEvaluation
+		resourceEvaluation.PolicyEvaluationDigests = nil
+		for _, policyEvaluation := range result.PolicyEvaluations {
+			digest, err := signing.PolicyEvaluationDigest(policyEvaluation, signing.PayloadVersion)
+			if err != nil {
+				return fmt.Errorf("error encoding policy evaluation %s: %v", policyEvaluation.Id, err)
+			}
+
+			resourceEvaluation.PolicyEvaluationDigests = append(resourceEvaluation.PolicyEvaluationDigests, &pb.PolicyEvaluationDigest{
+				PolicyEvaluationId: policyEvaluation.Id,
+				PayloadDigest:      digest,
+			})
+		}
+
+		payload, err := signing.ResourceEvaluationPayload(resourceEvaluation, signing.PayloadVersion)
+		if err != nil {
+			return fmt.Errorf("error encoding resource evaluation %s: %v", resourceEvaluation.Id, err)
+		}
+
+		resourceEvaluation.Signature, err = m.signEvaluation(payload)
+		if err != nil {
+			return fmt.Errorf("error signing resource evaluation %s: %v", resourceEvaluation.Id, err)
+		}
+
+		if !m.evaluationConfig.SignPolicyEvaluations {
+			continue
+		}
+
+		for _, policyEvaluation := range result.PolicyEvaluations {
+			payload, err := signing.PolicyEvaluationPayload(policyEvaluation, signing.PayloadVersion)
+			if err != nil {
+				return fmt.Errorf("error encoding policy evaluation %s: %v", policyEvaluation.Id, err)
+			}
+
+			policyEvaluation.Signature, err = m.signEvaluation(payload)
+			if err != nil {
+				return fmt.Errorf("error signing policy evaluation %s: %v", policyEvaluation.Id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *manager) signEvaluation(payload []byte) (*pb.EvaluationSignature, error) {
+	signature, err := m.signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EvaluationSignature{
+		KeyId:          m.signer.KeyId(),
+		Signature:      signature,
+		PayloadVersion: signing.PayloadVersion,
+	}, nil
+}
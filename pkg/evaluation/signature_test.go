@@ -0,0 +1,211 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/signing"
+	"github.com/rode/rode/pkg/signing/signingfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+)
+
+var _ = Describe("evaluation signatures", func() {
+	var (
+		ctx               context.Context
+		evaluationConfig  *config.EvaluationConfig
+		signer            *signingfakes.FakeSigner
+		evaluationManager *manager
+
+		expectedKeyId string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		evaluationConfig = &config.EvaluationConfig{}
+		signer = &signingfakes.FakeSigner{}
+		expectedKeyId = fake.LetterN(64)
+		signer.KeyIdReturns(expectedKeyId)
+	})
+
+	JustBeforeEach(func() {
+		evaluationManager = newTestManager(&managerDependencies{
+			evaluationConfig: evaluationConfig,
+			signer:           signer,
+		})
+	})
+
+	Context("GetEvaluationPublicKey", func() {
+		var (
+			publicKey ed25519.PublicKey
+
+			actualPublicKey *pb.EvaluationPublicKey
+			actualError     error
+		)
+
+		BeforeEach(func() {
+			var err error
+			publicKey, _, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			signer.PublicKeyReturns(publicKey)
+		})
+
+		JustBeforeEach(func() {
+			actualPublicKey, actualError = evaluationManager.GetEvaluationPublicKey(ctx, &pb.GetEvaluationPublicKeyRequest{})
+		})
+
+		It("should return the PEM encoded public key", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualPublicKey.KeyId).To(Equal(expectedKeyId))
+
+			parsedKey, err := signing.ParsePublicKey([]byte(actualPublicKey.PublicKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedKey).To(Equal(publicKey))
+		})
+
+		When("a signing key has not been configured", func() {
+			JustBeforeEach(func() {
+				evaluationManager.signer = nil
+
+				actualPublicKey, actualError = evaluationManager.GetEvaluationPublicKey(ctx, &pb.GetEvaluationPublicKeyRequest{})
+			})
+
+			It("should return an error", func() {
+				Expect(actualPublicKey).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+			})
+		})
+	})
+
+	Context("signResourceEvaluationResults", func() {
+		var (
+			result            *pb.ResourceEvaluationResult
+			expectedSignature []byte
+
+			actualError error
+		)
+
+		BeforeEach(func() {
+			resourceEvaluationId := fake.UUID()
+			result = &pb.ResourceEvaluationResult{
+				ResourceEvaluation: &pb.ResourceEvaluation{
+					Id:          resourceEvaluationId,
+					PolicyGroup: fake.LetterN(10),
+				},
+				PolicyEvaluations: []*pb.PolicyEvaluation{
+					{
+						Id:                   fake.UUID(),
+						ResourceEvaluationId: resourceEvaluationId,
+					},
+				},
+			}
+
+			expectedSignature = []byte(fake.LetterN(20))
+			signer.SignReturns(expectedSignature, nil)
+		})
+
+		JustBeforeEach(func() {
+			actualError = evaluationManager.signResourceEvaluationResults(result)
+		})
+
+		It("should sign the resource evaluation payload", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(signer.SignCallCount()).To(Equal(1))
+
+			expectedPayload, err := signing.ResourceEvaluationPayload(result.ResourceEvaluation, signing.PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(signer.SignArgsForCall(0)).To(Equal(expectedPayload))
+			Expect(result.ResourceEvaluation.Signature.KeyId).To(Equal(expectedKeyId))
+			Expect(result.ResourceEvaluation.Signature.Signature).To(Equal(expectedSignature))
+			Expect(result.ResourceEvaluation.Signature.PayloadVersion).To(Equal(signing.PayloadVersion))
+		})
+
+		It("should record a digest of each policy evaluation in the signed resource evaluation", func() {
+			expectedDigest, err := signing.PolicyEvaluationDigest(result.PolicyEvaluations[0], signing.PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.ResourceEvaluation.PolicyEvaluationDigests).To(HaveLen(1))
+			Expect(result.ResourceEvaluation.PolicyEvaluationDigests[0].PolicyEvaluationId).To(Equal(result.PolicyEvaluations[0].Id))
+			Expect(result.ResourceEvaluation.PolicyEvaluationDigests[0].PayloadDigest).To(Equal(expectedDigest))
+		})
+
+		It("should not sign the policy evaluations", func() {
+			Expect(result.PolicyEvaluations[0].Signature).To(BeNil())
+		})
+
+		When("policy evaluations should be signed", func() {
+			BeforeEach(func() {
+				evaluationConfig.SignPolicyEvaluations = true
+			})
+
+			It("should sign each policy evaluation", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(signer.SignCallCount()).To(Equal(2))
+
+				expectedPayload, err := signing.PolicyEvaluationPayload(result.PolicyEvaluations[0], signing.PayloadVersion)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(signer.SignArgsForCall(1)).To(Equal(expectedPayload))
+				Expect(result.PolicyEvaluations[0].Signature.Signature).To(Equal(expectedSignature))
+			})
+		})
+
+		When("the resource evaluation is signed again without its policy evaluations", func() {
+			JustBeforeEach(func() {
+				actualError = evaluationManager.signResourceEvaluationResults(&pb.ResourceEvaluationResult{
+					ResourceEvaluation: result.ResourceEvaluation,
+				})
+			})
+
+			It("should clear the previous digests", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(result.ResourceEvaluation.PolicyEvaluationDigests).To(BeEmpty())
+			})
+		})
+
+		When("signing fails", func() {
+			BeforeEach(func() {
+				signer.SignReturns(nil, errors.New("sign error"))
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+			})
+		})
+
+		When("a signing key has not been configured", func() {
+			JustBeforeEach(func() {
+				evaluationManager.signer = nil
+				result.ResourceEvaluation.Signature = nil
+
+				actualError = evaluationManager.signResourceEvaluationResults(result)
+			})
+
+			It("should leave the evaluation unsigned", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(result.ResourceEvaluation.Signature).To(BeNil())
+			})
+		})
+	})
+})
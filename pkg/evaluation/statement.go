@@ -0,0 +1,174 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rode/rode/pkg/resource"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/intoto_go_proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	inTotoStatementType             = "https://in-toto.io/Statement/v0.1"
+	inTotoPayloadType               = "application/vnd.in-toto+json"
+	resourceEvaluationPredicateType = "https://github.com/rode/rode/ResourceEvaluation/v1alpha1"
+)
+
+type inTotoStatement struct {
+	Type          string                       `json:"_type"`
+	Subject       []*inTotoSubject             `json:"subject"`
+	PredicateType string                       `json:"predicateType"`
+	Predicate     *resourceEvaluationPredicate `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type resourceEvaluationPredicate struct {
+	ResourceEvaluationId string                       `json:"resourceEvaluationId"`
+	PolicyGroup          string                       `json:"policyGroup"`
+	Pass                 bool                         `json:"pass"`
+	EvaluatedAt          string                       `json:"evaluatedAt"`
+	AuditFailures        []string                     `json:"auditFailures"`
+	SeverityThreshold    string                       `json:"severityThreshold"`
+	PolicyEvaluations    []*policyEvaluationPredicate `json:"policyEvaluations"`
+}
+
+type policyEvaluationPredicate struct {
+	PolicyVersionId string            `json:"policyVersionId"`
+	EnforcementMode string            `json:"enforcementMode"`
+	Pass            bool              `json:"pass"`
+	Waived          bool              `json:"waived"`
+	WaiverIds       []string          `json:"waiverIds"`
+	Violations      []json.RawMessage `json:"violations"`
+}
+
+func (m *manager) GetResourceEvaluationStatement(ctx context.Context, request *pb.GetResourceEvaluationStatementRequest) (*pb.DsseEnvelope, error) {
+	log := m.logger.Named("GetResourceEvaluationStatement").With(zap.String("id", request.Id))
+
+	if request.Id == "" {
+		return nil, util.GrpcErrorWithCode(log, "resource evaluation id is required", nil, codes.InvalidArgument)
+	}
+
+	if m.signer == nil {
+		return nil, util.GrpcErrorWithCode(log, "evaluation signing is not configured", nil, codes.FailedPrecondition)
+	}
+
+	result, err := m.GetResourceEvaluation(ctx, &pb.GetResourceEvaluationRequest{Id: request.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceEvaluation := result.ResourceEvaluation
+	switch resourceEvaluation.State {
+	case pb.ResourceEvaluationState_PENDING, pb.ResourceEvaluationState_RUNNING, pb.ResourceEvaluationState_FAILED:
+		return nil, util.GrpcErrorWithCode(log, "only complete resource evaluations can be exported", nil, codes.FailedPrecondition)
+	}
+
+	digest, err := resource.VersionDigest(resourceEvaluation.ResourceVersion.Version)
+	if err != nil {
+		return nil, util.GrpcErrorWithCode(log, "unable to determine the digest of the evaluated resource", err, codes.FailedPrecondition)
+	}
+
+	statement, err := newResourceEvaluationStatement(result, digest)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error creating in-toto statement", err)
+	}
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error encoding in-toto statement", err)
+	}
+
+	signature, err := m.signer.Sign(dssePreAuthEncoding(inTotoPayloadType, payload))
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error signing in-toto statement", err)
+	}
+
+	return &pb.DsseEnvelope{
+		Payload:     payload,
+		PayloadType: inTotoPayloadType,
+		Signatures: []*intoto_go_proto.Signature{
+			{
+				KeyId:     m.signer.KeyId(),
+				Signature: base64.StdEncoding.EncodeToString(signature),
+			},
+		},
+	}, nil
+}
+
+func newResourceEvaluationStatement(result *pb.ResourceEvaluationResult, digest map[string]string) (*inTotoStatement, error) {
+	resourceEvaluation := result.ResourceEvaluation
+	predicate := &resourceEvaluationPredicate{
+		ResourceEvaluationId: resourceEvaluation.Id,
+		PolicyGroup:          resourceEvaluation.PolicyGroup,
+		Pass:                 resourceEvaluation.Pass,
+		EvaluatedAt:          resourceEvaluation.Created.AsTime().Format(time.RFC3339),
+		AuditFailures:        append([]string{}, resourceEvaluation.AuditFailures...),
+		SeverityThreshold:    resourceEvaluation.SeverityThreshold.String(),
+		PolicyEvaluations:    []*policyEvaluationPredicate{},
+	}
+
+	for _, policyEvaluation := range result.PolicyEvaluations {
+		policyPredicate := &policyEvaluationPredicate{
+			PolicyVersionId: policyEvaluation.PolicyVersionId,
+			EnforcementMode: policyEvaluation.EnforcementMode.String(),
+			Pass:            policyEvaluation.Pass,
+			Waived:          policyEvaluation.Waived,
+			WaiverIds:       append([]string{}, policyEvaluation.WaiverIds...),
+			Violations:      []json.RawMessage{},
+		}
+
+		for _, violation := range policyEvaluation.Violations {
+			violationJson, err := protojson.Marshal(violation)
+			if err != nil {
+				return nil, err
+			}
+
+			policyPredicate.Violations = append(policyPredicate.Violations, violationJson)
+		}
+
+		predicate.PolicyEvaluations = append(predicate.PolicyEvaluations, policyPredicate)
+	}
+
+	return &inTotoStatement{
+		Type: inTotoStatementType,
+		Subject: []*inTotoSubject{
+			{
+				Name:   resourceEvaluation.ResourceVersion.Version,
+				Digest: digest,
+			},
+		},
+		PredicateType: resourceEvaluationPredicateType,
+		Predicate:     predicate,
+	}, nil
+}
+
+// dssePreAuthEncoding returns the bytes that are signed in a DSSE envelope
+func dssePreAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
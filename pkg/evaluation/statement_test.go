@@ -0,0 +1,225 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/rode/pkg/signing"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("in-toto statements", func() {
+	var (
+		ctx               context.Context
+		esClient          *esutilfakes.FakeClient
+		signer            signing.Signer
+		evaluationManager Manager
+
+		request            *pb.GetResourceEvaluationStatementRequest
+		resourceEvaluation *pb.ResourceEvaluation
+		policyEvaluation   *pb.PolicyEvaluation
+		expectedDigest     string
+		multiSearchError   error
+
+		actualEnvelope *pb.DsseEnvelope
+		actualError    error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		esClient = &esutilfakes.FakeClient{}
+
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err = signing.NewSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedDigest = fake.LetterN(64)
+		resourceEvaluation = &pb.ResourceEvaluation{
+			Id:          fake.UUID(),
+			Pass:        true,
+			PolicyGroup: fake.LetterN(10),
+			Created:     timestamppb.Now(),
+			State:       pb.ResourceEvaluationState_COMPLETE,
+			ResourceVersion: &pb.ResourceVersion{
+				Version: "harbor.localhost/rode-demo/app@sha256:" + expectedDigest,
+			},
+		}
+		policyVersionId := fake.UUID() + ".1"
+		resourceEvaluation.AuditFailures = []string{policyVersionId}
+		resourceEvaluation.SeverityThreshold = pb.ViolationSeverity_HIGH
+		policyEvaluation = &pb.PolicyEvaluation{
+			Id:                   fake.UUID(),
+			ResourceEvaluationId: resourceEvaluation.Id,
+			PolicyVersionId:      policyVersionId,
+			Pass:                 false,
+			EnforcementMode:      pb.PolicyEnforcementMode_AUDIT,
+			Violations: []*pb.EvaluatePolicyViolation{
+				{
+					Id:      fake.LetterN(10),
+					Message: fake.Sentence(5),
+					Pass:    false,
+				},
+			},
+		}
+		request = &pb.GetResourceEvaluationStatementRequest{Id: resourceEvaluation.Id}
+		multiSearchError = nil
+	})
+
+	JustBeforeEach(func() {
+		resourceEvaluationJson, _ := protojson.Marshal(resourceEvaluation)
+		policyEvaluationJson, _ := protojson.Marshal(policyEvaluation)
+		esClient.MultiSearchReturns(&esutil.EsMultiSearchResponse{
+			Responses: []*esutil.EsMultiSearchResponseHitsSummary{
+				{
+					Hits: &esutil.EsMultiSearchResponseHits{
+						Total: &esutil.EsSearchResponseTotal{Value: 1},
+						Hits:  []*esutil.EsMultiSearchResponseHit{{Source: resourceEvaluationJson}},
+					},
+				},
+				{
+					Hits: &esutil.EsMultiSearchResponseHits{
+						Total: &esutil.EsSearchResponseTotal{Value: 1},
+						Hits:  []*esutil.EsMultiSearchResponseHit{{Source: policyEvaluationJson}},
+					},
+				},
+			},
+		}, multiSearchError)
+
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient: esClient,
+			signer:   signer,
+		})
+		actualEnvelope, actualError = evaluationManager.GetResourceEvaluationStatement(ctx, request)
+	})
+
+	It("should return an in-toto statement for the resource evaluation", func() {
+		Expect(actualError).NotTo(HaveOccurred())
+		Expect(actualEnvelope.PayloadType).To(Equal("application/vnd.in-toto+json"))
+
+		var statement map[string]interface{}
+		Expect(json.Unmarshal(actualEnvelope.Payload, &statement)).To(Succeed())
+
+		Expect(statement["_type"]).To(Equal("https://in-toto.io/Statement/v0.1"))
+		Expect(statement["predicateType"]).To(Equal("https://github.com/rode/rode/ResourceEvaluation/v1alpha1"))
+		Expect(statement["subject"]).To(ConsistOf(map[string]interface{}{
+			"name":   resourceEvaluation.ResourceVersion.Version,
+			"digest": map[string]interface{}{"sha256": expectedDigest},
+		}))
+
+		predicate := statement["predicate"].(map[string]interface{})
+		Expect(predicate["resourceEvaluationId"]).To(Equal(resourceEvaluation.Id))
+		Expect(predicate["policyGroup"]).To(Equal(resourceEvaluation.PolicyGroup))
+		Expect(predicate["pass"]).To(BeTrue())
+		Expect(predicate["evaluatedAt"]).NotTo(BeEmpty())
+		Expect(predicate["auditFailures"]).To(ConsistOf(policyEvaluation.PolicyVersionId))
+		Expect(predicate["severityThreshold"]).To(Equal("HIGH"))
+
+		policyEvaluations := predicate["policyEvaluations"].([]interface{})
+		Expect(policyEvaluations).To(HaveLen(1))
+		policyPredicate := policyEvaluations[0].(map[string]interface{})
+		Expect(policyPredicate["policyVersionId"]).To(Equal(policyEvaluation.PolicyVersionId))
+		Expect(policyPredicate["pass"]).To(BeFalse())
+		Expect(policyPredicate["enforcementMode"]).To(Equal("AUDIT"))
+		Expect(policyPredicate["violations"]).To(ConsistOf(HaveKeyWithValue("message", policyEvaluation.Violations[0].Message)))
+	})
+
+	It("should sign the DSSE pre-authentication encoding", func() {
+		Expect(actualEnvelope.Signatures).To(HaveLen(1))
+		Expect(actualEnvelope.Signatures[0].KeyId).To(Equal(signer.KeyId()))
+
+		signature, err := base64.StdEncoding.DecodeString(actualEnvelope.Signatures[0].Signature)
+		Expect(err).NotTo(HaveOccurred())
+
+		preAuthEncoding := []byte("DSSEv1 28 application/vnd.in-toto+json ")
+		preAuthEncoding = append(preAuthEncoding, []byte(fmt.Sprintf("%d ", len(actualEnvelope.Payload)))...)
+		preAuthEncoding = append(preAuthEncoding, actualEnvelope.Payload...)
+
+		Expect(signing.Verify(signer.PublicKey(), preAuthEncoding, signature)).To(Succeed())
+	})
+
+	When("the id is missing", func() {
+		BeforeEach(func() {
+			request.Id = ""
+		})
+
+		It("should return an error", func() {
+			Expect(actualEnvelope).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	When("a signing key has not been configured", func() {
+		BeforeEach(func() {
+			signer = nil
+		})
+
+		It("should return an error", func() {
+			Expect(actualEnvelope).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	When("fetching the resource evaluation fails", func() {
+		BeforeEach(func() {
+			multiSearchError = errors.New("multi search error")
+		})
+
+		It("should return an error", func() {
+			Expect(actualEnvelope).To(BeNil())
+			Expect(actualError).To(HaveOccurred())
+		})
+	})
+
+	When("the resource evaluation has not completed", func() {
+		BeforeEach(func() {
+			resourceEvaluation.State = pb.ResourceEvaluationState_RUNNING
+		})
+
+		It("should return an error", func() {
+			Expect(actualEnvelope).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	When("the resource version is not identified by a digest", func() {
+		BeforeEach(func() {
+			resourceEvaluation.ResourceVersion.Version = "npm://lodash:4.17.21"
+		})
+
+		It("should return an error", func() {
+			Expect(actualEnvelope).To(BeNil())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+		})
+	})
+})
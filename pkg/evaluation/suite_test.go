@@ -15,12 +15,34 @@
 package evaluation
 
 import (
+	"testing"
+
 	"github.com/brianvoe/gofakeit/v6"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rode/es-index-manager/indexmanager"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/opa"
+	"github.com/rode/rode/opa/opafakes"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/grafeas"
+	"github.com/rode/rode/pkg/grafeas/grafeasfakes"
+	"github.com/rode/rode/pkg/policy"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	"github.com/rode/rode/pkg/resource"
+	"github.com/rode/rode/pkg/resource/resourcefakes"
+	"github.com/rode/rode/pkg/signing"
+	"github.com/rode/rode/pkg/webhook"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
+	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/status"
-	"testing"
 )
 
 var (
@@ -39,3 +61,89 @@ func getGRPCStatusFromError(err error) *status.Status {
 
 	return s
 }
+
+// managerDependencies are the dependencies of the manager under test. Any that are left unset are replaced with fakes
+// by newTestManager, except for signer and grafeasClient, which stay nil.
+type managerDependencies struct {
+	esClient                esutil.Client
+	esConfig                *config.ElasticsearchConfig
+	evaluationConfig        *config.EvaluationConfig
+	policyManager           policy.Manager
+	policyGroupManager      policy.PolicyGroupManager
+	policyAssignmentManager policy.AssignmentManager
+	waiverManager           policy.WaiverManager
+	grafeasExtensions       grafeas.Extensions
+	grafeasClient           grafeas_proto.GrafeasV1Beta1Client
+	signer                  signing.Signer
+	opa                     opa.Client
+	resourceManager         resource.Manager
+	indexManager            indexmanager.IndexManager
+	filterer                filtering.Filterer
+	webhookNotifier         webhook.Notifier
+	eventPublisher          events.Publisher
+}
+
+func newTestManager(deps *managerDependencies) *manager {
+	if deps.esClient == nil {
+		deps.esClient = &esutilfakes.FakeClient{}
+	}
+	if deps.esConfig == nil {
+		deps.esConfig = &config.ElasticsearchConfig{}
+	}
+	if deps.evaluationConfig == nil {
+		deps.evaluationConfig = &config.EvaluationConfig{}
+	}
+	if deps.policyManager == nil {
+		deps.policyManager = &policyfakes.FakeManager{}
+	}
+	if deps.policyGroupManager == nil {
+		deps.policyGroupManager = &policyfakes.FakePolicyGroupManager{}
+	}
+	if deps.policyAssignmentManager == nil {
+		deps.policyAssignmentManager = &policyfakes.FakeAssignmentManager{}
+	}
+	if deps.waiverManager == nil {
+		deps.waiverManager = &policyfakes.FakeWaiverManager{}
+	}
+	if deps.grafeasExtensions == nil {
+		deps.grafeasExtensions = &grafeasfakes.FakeExtensions{}
+	}
+	if deps.opa == nil {
+		deps.opa = &opafakes.FakeClient{}
+	}
+	if deps.resourceManager == nil {
+		deps.resourceManager = &resourcefakes.FakeManager{}
+	}
+	if deps.indexManager == nil {
+		deps.indexManager = &immocks.FakeIndexManager{}
+	}
+	if deps.filterer == nil {
+		deps.filterer = &filteringfakes.FakeFilterer{}
+	}
+	if deps.webhookNotifier == nil {
+		deps.webhookNotifier = &webhookfakes.FakeNotifier{}
+	}
+	if deps.eventPublisher == nil {
+		deps.eventPublisher = &eventsfakes.FakePublisher{}
+	}
+
+	return NewManager(
+		logger,
+		deps.esClient,
+		deps.esConfig,
+		deps.evaluationConfig,
+		deps.policyManager,
+		deps.policyGroupManager,
+		deps.policyAssignmentManager,
+		deps.waiverManager,
+		deps.grafeasExtensions,
+		deps.grafeasClient,
+		deps.signer,
+		deps.opa,
+		deps.resourceManager,
+		deps.indexManager,
+		deps.filterer,
+		deps.webhookNotifier,
+		deps.eventPublisher,
+	).(*manager)
+}
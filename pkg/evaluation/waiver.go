@@ -0,0 +1,96 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+)
+
+// applyWaivers marks the failed violations that are covered by an active waiver for the resource version. A failed policy
+// evaluation is waived when every one of its failed violations is waived, or when it didn't report any failed violations
+// and a waiver covers the entire policy.
+func (m *manager) applyWaivers(ctx context.Context, log *zap.Logger, resourceUri string, policyEvaluations []*pb.PolicyEvaluation) error {
+	if resourceUri == "" {
+		return nil
+	}
+
+	// without a page size, the waivers are listed with a single search instead of opening a point in time on every evaluation
+	response, err := m.waiverManager.ListWaivers(ctx, &pb.ListWaiversRequest{
+		ResourceUri: resourceUri,
+	})
+	if err != nil {
+		return util.GrpcInternalError(log, "error listing waivers", err)
+	}
+
+	waivers := map[string][]*pb.Waiver{}
+	for _, waiver := range response.GetWaivers() {
+		waivers[waiver.PolicyId] = append(waivers[waiver.PolicyId], waiver)
+	}
+
+	for _, policyEvaluation := range policyEvaluations {
+		policyWaivers := waivers[policyIdFromVersionId(policyEvaluation.PolicyVersionId)]
+		if policyEvaluation.Pass || len(policyWaivers) == 0 {
+			continue
+		}
+
+		failedViolations, waivedViolations := 0, 0
+		waiverIds := map[string]bool{}
+		for _, violation := range policyEvaluation.Violations {
+			if violation.Pass {
+				continue
+			}
+
+			failedViolations++
+			if waiver := findWaiver(policyWaivers, violation.Id); waiver != nil {
+				violation.Waived = true
+				violation.WaiverId = waiver.Id
+				waivedViolations++
+				waiverIds[waiver.Id] = true
+			}
+		}
+
+		if failedViolations == 0 {
+			if waiver := findWaiver(policyWaivers, ""); waiver != nil {
+				waiverIds[waiver.Id] = true
+			}
+		}
+
+		for _, waiver := range policyWaivers {
+			if waiverIds[waiver.Id] {
+				policyEvaluation.WaiverIds = append(policyEvaluation.WaiverIds, waiver.Id)
+			}
+		}
+
+		policyEvaluation.Waived = len(waiverIds) != 0 && failedViolations == waivedViolations
+	}
+
+	return nil
+}
+
+// findWaiver returns the first waiver that covers the violation. Waivers without a violation id cover every violation
+// of the policy, and are the only waivers considered when the violation id is empty.
+func findWaiver(waivers []*pb.Waiver, violationId string) *pb.Waiver {
+	for _, waiver := range waivers {
+		if waiver.ViolationId == "" || (violationId != "" && waiver.ViolationId == violationId) {
+			return waiver
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/pkg/policy/policyfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+)
+
+var _ = Describe("waivers", func() {
+	var (
+		ctx               context.Context
+		waiverManager     *policyfakes.FakeWaiverManager
+		evaluationManager *manager
+
+		resourceUri       string
+		policyId          string
+		policyEvaluations []*pb.PolicyEvaluation
+		waivers           []*pb.Waiver
+		listWaiversError  error
+
+		actualError error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		waiverManager = &policyfakes.FakeWaiverManager{}
+		evaluationManager = newTestManager(&managerDependencies{
+			waiverManager: waiverManager,
+		})
+
+		resourceUri = fake.URL()
+		policyId = fake.UUID()
+		policyEvaluations = []*pb.PolicyEvaluation{
+			{
+				PolicyVersionId: fmt.Sprintf("%s.%d", policyId, fake.Number(1, 10)),
+				Pass:            false,
+				Violations: []*pb.EvaluatePolicyViolation{
+					{Id: "a", Pass: false},
+					{Id: "b", Pass: false},
+					{Id: "c", Pass: true},
+				},
+			},
+		}
+		waivers = nil
+		listWaiversError = nil
+	})
+
+	JustBeforeEach(func() {
+		waiverManager.ListWaiversReturns(&pb.ListWaiversResponse{Waivers: waivers}, listWaiversError)
+
+		actualError = evaluationManager.applyWaivers(ctx, logger, resourceUri, policyEvaluations)
+	})
+
+	It("should list the active waivers for the resource", func() {
+		Expect(waiverManager.ListWaiversCallCount()).To(Equal(1))
+
+		_, actualRequest := waiverManager.ListWaiversArgsForCall(0)
+
+		Expect(actualRequest.ResourceUri).To(Equal(resourceUri))
+		Expect(actualRequest.PageSize).To(BeZero(), "paginating would open a point in time on every evaluation")
+		Expect(actualRequest.IncludeExpired).To(BeFalse())
+	})
+
+	It("should not waive the policy when there are no waivers", func() {
+		Expect(actualError).NotTo(HaveOccurred())
+		Expect(policyEvaluations[0].Waived).To(BeFalse())
+		Expect(policyEvaluations[0].WaiverIds).To(BeEmpty())
+	})
+
+	When("a waiver covers one of the failed violations", func() {
+		BeforeEach(func() {
+			waivers = []*pb.Waiver{{Id: fake.UUID(), PolicyId: policyId, ViolationId: "a"}}
+		})
+
+		It("should mark the violation as waived", func() {
+			violation := policyEvaluations[0].Violations[0]
+
+			Expect(violation.Waived).To(BeTrue())
+			Expect(violation.WaiverId).To(Equal(waivers[0].Id))
+			Expect(policyEvaluations[0].Violations[1].Waived).To(BeFalse())
+		})
+
+		It("should not waive the policy evaluation", func() {
+			Expect(policyEvaluations[0].Waived).To(BeFalse())
+			Expect(policyEvaluations[0].WaiverIds).To(ConsistOf(waivers[0].Id))
+		})
+	})
+
+	When("waivers cover all of the failed violations", func() {
+		BeforeEach(func() {
+			waivers = []*pb.Waiver{
+				{Id: fake.UUID(), PolicyId: policyId, ViolationId: "a"},
+				{Id: fake.UUID(), PolicyId: policyId, ViolationId: "b"},
+			}
+		})
+
+		It("should waive the policy evaluation", func() {
+			Expect(policyEvaluations[0].Waived).To(BeTrue())
+			Expect(policyEvaluations[0].WaiverIds).To(ConsistOf(waivers[0].Id, waivers[1].Id))
+		})
+
+		It("should not mark passing violations as waived", func() {
+			Expect(policyEvaluations[0].Violations[2].Waived).To(BeFalse())
+		})
+	})
+
+	When("a waiver covers the entire policy", func() {
+		BeforeEach(func() {
+			waivers = []*pb.Waiver{{Id: fake.UUID(), PolicyId: policyId}}
+		})
+
+		It("should waive every failed violation", func() {
+			Expect(policyEvaluations[0].Waived).To(BeTrue())
+			Expect(policyEvaluations[0].Violations[0].WaiverId).To(Equal(waivers[0].Id))
+			Expect(policyEvaluations[0].Violations[1].WaiverId).To(Equal(waivers[0].Id))
+		})
+
+		When("the failed policy didn't report any violations", func() {
+			BeforeEach(func() {
+				policyEvaluations[0].Violations = nil
+			})
+
+			It("should waive the policy evaluation", func() {
+				Expect(policyEvaluations[0].Waived).To(BeTrue())
+				Expect(policyEvaluations[0].WaiverIds).To(ConsistOf(waivers[0].Id))
+			})
+		})
+	})
+
+	When("the waiver is for a different policy", func() {
+		BeforeEach(func() {
+			waivers = []*pb.Waiver{{Id: fake.UUID(), PolicyId: fake.UUID()}}
+		})
+
+		It("should not waive the policy evaluation", func() {
+			Expect(policyEvaluations[0].Waived).To(BeFalse())
+			Expect(policyEvaluations[0].Violations[0].Waived).To(BeFalse())
+		})
+	})
+
+	When("the policy passed", func() {
+		BeforeEach(func() {
+			policyEvaluations[0].Pass = true
+			waivers = []*pb.Waiver{{Id: fake.UUID(), PolicyId: policyId}}
+		})
+
+		It("should not apply the waiver", func() {
+			Expect(policyEvaluations[0].Waived).To(BeFalse())
+			Expect(policyEvaluations[0].WaiverIds).To(BeEmpty())
+		})
+	})
+
+	When("the resource uri is unknown", func() {
+		BeforeEach(func() {
+			resourceUri = ""
+		})
+
+		It("should not look for waivers", func() {
+			Expect(waiverManager.ListWaiversCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an error occurs listing waivers", func() {
+		BeforeEach(func() {
+			listWaiversError = errors.New("list error")
+		})
+
+		It("should return an error", func() {
+			Expect(actualError).To(HaveOccurred())
+			Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+		})
+	})
+})
@@ -0,0 +1,166 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// watcherBufferSize is the number of batches of results a watcher can fall behind by before new results are dropped
+const watcherBufferSize = 100
+
+// resourceEvaluationWatcher receives finished resource evaluations on behalf of a single WatchResourceEvaluations stream
+type resourceEvaluationWatcher struct {
+	results chan []*pb.ResourceEvaluationResult
+}
+
+func (m *manager) WatchResourceEvaluations(request *pb.WatchResourceEvaluationsRequest, stream pb.Rode_WatchResourceEvaluationsServer) error {
+	log := m.logger.Named("WatchResourceEvaluations").With(zap.String("filter", request.Filter))
+	ctx := stream.Context()
+
+	var filterQuery *filtering.Query
+	if request.Filter != "" {
+		var err error
+		filterQuery, err = m.filterer.ParseExpression(request.Filter)
+		if err != nil {
+			return util.GrpcInternalError(log, "error parsing filter expression", err)
+		}
+
+		if err := validateFilterQuery(filterQuery, (&pb.ResourceEvaluation{}).ProtoReflect().Descriptor()); err != nil {
+			return util.GrpcErrorWithCode(log, "unsupported filter expression", err, codes.InvalidArgument)
+		}
+	}
+
+	watcher := &resourceEvaluationWatcher{
+		results: make(chan []*pb.ResourceEvaluationResult, watcherBufferSize),
+	}
+	m.addWatcher(watcher)
+	defer m.removeWatcher(watcher)
+
+	log.Debug("watching resource evaluations")
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("stopped watching resource evaluations")
+			return nil
+		case results := <-watcher.results:
+			if filterQuery != nil {
+				var err error
+				results, err = filterResourceEvaluationResults(filterQuery, results)
+				if err != nil {
+					return util.GrpcInternalError(log, "error filtering resource evaluations", err)
+				}
+			}
+
+			for _, result := range results {
+				if err := stream.Send(result); err != nil {
+					log.Debug("error sending resource evaluation", zap.Error(err))
+					return err
+				}
+			}
+		}
+	}
+}
+
+// filterResourceEvaluationResults returns the results whose resource evaluations match the filter query. The filter
+// is matched against the results in memory, since they may not be searchable yet when Elasticsearch isn't refreshed
+// on every write.
+func filterResourceEvaluationResults(filterQuery *filtering.Query, results []*pb.ResourceEvaluationResult) ([]*pb.ResourceEvaluationResult, error) {
+	var filtered []*pb.ResourceEvaluationResult
+	for _, result := range results {
+		document, err := newFilterDocument(result.ResourceEvaluation)
+		if err != nil {
+			return nil, err
+		}
+
+		if matchesFilterQuery(filterQuery, document) {
+			filtered = append(filtered, result)
+		}
+	}
+
+	return filtered, nil
+}
+
+// publishResourceEvaluationResults sends the finished resource evaluations to every watcher, webhook subscriber, and
+// event sink. A watcher that isn't keeping up misses the results, rather than holding up the evaluation.
+func (m *manager) publishResourceEvaluationResults(results ...*pb.ResourceEvaluationResult) {
+	var finished []*pb.ResourceEvaluationResult
+	for _, result := range results {
+		state := result.ResourceEvaluation.State
+		if state == pb.ResourceEvaluationState_COMPLETE || state == pb.ResourceEvaluationState_FAILED {
+			// watchers send results asynchronously, so they get a copy that the caller is free to keep modifying
+			finished = append(finished, proto.Clone(result).(*pb.ResourceEvaluationResult))
+		}
+	}
+
+	if len(finished) == 0 {
+		return
+	}
+
+	for _, result := range finished {
+		m.webhookNotifier.Notify(newResourceEvaluationWebhookEvent(result))
+
+		eventType := events.ResourceEvaluationCompleted
+		if result.ResourceEvaluation.State == pb.ResourceEvaluationState_FAILED {
+			eventType = events.ResourceEvaluationFailed
+		}
+		m.eventPublisher.Publish(eventType, result.ResourceEvaluation.Id, result)
+	}
+
+	m.watchersMu.RLock()
+	defer m.watchersMu.RUnlock()
+
+	for watcher := range m.watchers {
+		select {
+		case watcher.results <- finished:
+		default:
+			m.logger.Warn("resource evaluation watcher is full, dropping results", zap.Int("count", len(finished)))
+		}
+	}
+}
+
+func (m *manager) addWatcher(watcher *resourceEvaluationWatcher) {
+	m.watchersMu.Lock()
+	defer m.watchersMu.Unlock()
+
+	m.watchers[watcher] = struct{}{}
+}
+
+func (m *manager) removeWatcher(watcher *resourceEvaluationWatcher) {
+	m.watchersMu.Lock()
+	defer m.watchersMu.Unlock()
+
+	delete(m.watchers, watcher)
+}
+
+func newResourceEvaluationWebhookEvent(result *pb.ResourceEvaluationResult) *pb.WebhookEvent {
+	eventType := pb.WebhookEventType_RESOURCE_EVALUATION_FAILED
+	if result.ResourceEvaluation.State == pb.ResourceEvaluationState_COMPLETE && result.ResourceEvaluation.Pass {
+		eventType = pb.WebhookEventType_RESOURCE_EVALUATION_PASSED
+	}
+
+	return &pb.WebhookEvent{
+		Type: eventType,
+		Data: &pb.WebhookEvent_ResourceEvaluationResult{
+			ResourceEvaluationResult: result,
+		},
+	}
+}
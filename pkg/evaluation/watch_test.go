@@ -0,0 +1,359 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("watching resource evaluations", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		esClient          *esutilfakes.FakeClient
+		esConfig          *config.ElasticsearchConfig
+		filterer          *filteringfakes.FakeFilterer
+		webhookNotifier   *webhookfakes.FakeNotifier
+		eventPublisher    *eventsfakes.FakePublisher
+		evaluationManager *manager
+
+		request     *pb.WatchResourceEvaluationsRequest
+		stream      *fakeWatchStream
+		watchResult chan error
+
+		completeResult *pb.ResourceEvaluationResult
+		pendingResult  *pb.ResourceEvaluationResult
+	)
+
+	watcherCount := func() int {
+		evaluationManager.watchersMu.RLock()
+		defer evaluationManager.watchersMu.RUnlock()
+
+		return len(evaluationManager.watchers)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		esClient = &esutilfakes.FakeClient{}
+		esClient.BulkReturns(&esutil.EsBulkResponse{}, nil)
+		esConfig = &config.ElasticsearchConfig{Refresh: config.RefreshTrue}
+		filterer = &filteringfakes.FakeFilterer{}
+		webhookNotifier = &webhookfakes.FakeNotifier{}
+		eventPublisher = &eventsfakes.FakePublisher{}
+		indexManager := &immocks.FakeIndexManager{}
+		indexManager.AliasNameReturns(fake.LetterN(10))
+
+		evaluationManager = newTestManager(&managerDependencies{
+			esClient:        esClient,
+			esConfig:        esConfig,
+			indexManager:    indexManager,
+			filterer:        filterer,
+			webhookNotifier: webhookNotifier,
+			eventPublisher:  eventPublisher,
+		})
+
+		request = &pb.WatchResourceEvaluationsRequest{}
+		stream = &fakeWatchStream{ctx: ctx}
+		watchResult = make(chan error, 1)
+
+		completeResult = &pb.ResourceEvaluationResult{
+			ResourceEvaluation: &pb.ResourceEvaluation{
+				Id:    fake.UUID(),
+				State: pb.ResourceEvaluationState_COMPLETE,
+			},
+			PolicyEvaluations: []*pb.PolicyEvaluation{
+				{Id: fake.UUID()},
+			},
+		}
+		pendingResult = &pb.ResourceEvaluationResult{
+			ResourceEvaluation: &pb.ResourceEvaluation{
+				Id:    fake.UUID(),
+				State: pb.ResourceEvaluationState_PENDING,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		m, r, s, result := evaluationManager, request, stream, watchResult
+		go func() {
+			result <- m.WatchResourceEvaluations(r, s)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	When("the stream is open", func() {
+		JustBeforeEach(func() {
+			Eventually(watcherCount).Should(Equal(1))
+		})
+
+		It("should send finished resource evaluations once they've been stored", func() {
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, pendingResult, completeResult)).To(Succeed())
+
+			Eventually(stream.sentResults).Should(HaveLen(1))
+			Expect(stream.sentResults()[0].ResourceEvaluation.Id).To(Equal(completeResult.ResourceEvaluation.Id))
+			Expect(stream.sentResults()[0].PolicyEvaluations).To(HaveLen(1))
+		})
+
+		It("should notify webhook subscribers of finished resource evaluations", func() {
+			completeResult.ResourceEvaluation.Pass = true
+			failedResult := &pb.ResourceEvaluationResult{
+				ResourceEvaluation: &pb.ResourceEvaluation{
+					Id:    fake.UUID(),
+					State: pb.ResourceEvaluationState_FAILED,
+				},
+			}
+
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, pendingResult, completeResult, failedResult)).To(Succeed())
+
+			Expect(webhookNotifier.NotifyCallCount()).To(Equal(2))
+
+			passedEvent := webhookNotifier.NotifyArgsForCall(0)
+			Expect(passedEvent.Type).To(Equal(pb.WebhookEventType_RESOURCE_EVALUATION_PASSED))
+			Expect(passedEvent.GetResourceEvaluationResult().ResourceEvaluation.Id).To(Equal(completeResult.ResourceEvaluation.Id))
+
+			failedEvent := webhookNotifier.NotifyArgsForCall(1)
+			Expect(failedEvent.Type).To(Equal(pb.WebhookEventType_RESOURCE_EVALUATION_FAILED))
+			Expect(failedEvent.GetResourceEvaluationResult().ResourceEvaluation.Id).To(Equal(failedResult.ResourceEvaluation.Id))
+		})
+
+		It("should publish an event for each finished resource evaluation", func() {
+			failedResult := &pb.ResourceEvaluationResult{
+				ResourceEvaluation: &pb.ResourceEvaluation{
+					Id:    fake.UUID(),
+					State: pb.ResourceEvaluationState_FAILED,
+				},
+			}
+
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, pendingResult, completeResult, failedResult)).To(Succeed())
+
+			Expect(eventPublisher.PublishCallCount()).To(Equal(2))
+
+			eventType, subject, data := eventPublisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.ResourceEvaluationCompleted))
+			Expect(subject).To(Equal(completeResult.ResourceEvaluation.Id))
+			Expect(proto.Equal(data, completeResult)).To(BeTrue())
+
+			eventType, subject, _ = eventPublisher.PublishArgsForCall(1)
+			Expect(eventType).To(Equal(events.ResourceEvaluationFailed))
+			Expect(subject).To(Equal(failedResult.ResourceEvaluation.Id))
+		})
+
+		It("should treat complete resource evaluations that didn't pass as failures", func() {
+			completeResult.ResourceEvaluation.Pass = false
+
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, completeResult)).To(Succeed())
+
+			Expect(webhookNotifier.NotifyCallCount()).To(Equal(1))
+			Expect(webhookNotifier.NotifyArgsForCall(0).Type).To(Equal(pb.WebhookEventType_RESOURCE_EVALUATION_FAILED))
+		})
+
+		It("should not send resource evaluations that failed to store", func() {
+			esClient.BulkReturns(nil, errors.New(fake.Word()))
+
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, completeResult)).NotTo(Succeed())
+			Consistently(stream.sentResults).Should(BeEmpty())
+			Expect(webhookNotifier.NotifyCallCount()).To(Equal(0))
+			Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+		})
+
+		It("should stop watching when the stream is closed", func() {
+			cancel()
+
+			Eventually(watchResult).Should(Receive(BeNil()))
+			Expect(watcherCount()).To(Equal(0))
+		})
+
+		When("sending a result fails", func() {
+			BeforeEach(func() {
+				stream.sendErr = errors.New(fake.Word())
+			})
+
+			It("should return the error and stop watching", func() {
+				evaluationManager.publishResourceEvaluationResults(completeResult)
+
+				Eventually(watchResult).Should(Receive(MatchError(stream.sendErr)))
+				Expect(watcherCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("a filter is provided", func() {
+		var (
+			failedResult *pb.ResourceEvaluationResult
+			filterQuery  *filtering.Query
+		)
+
+		BeforeEach(func() {
+			request.Filter = `pass == "false"`
+			filterQuery = &filtering.Query{
+				Term: &filtering.Term{
+					"pass": "false",
+				},
+			}
+			filterer.ParseExpressionReturns(filterQuery, nil)
+
+			completeResult.ResourceEvaluation.Pass = true
+			failedResult = &pb.ResourceEvaluationResult{
+				ResourceEvaluation: &pb.ResourceEvaluation{
+					Id:    fake.UUID(),
+					State: pb.ResourceEvaluationState_FAILED,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			Eventually(watcherCount).Should(Equal(1))
+
+			Expect(evaluationManager.storeResourceEvaluationResults(ctx, esutil.BULK_CREATE, completeResult, failedResult)).To(Succeed())
+		})
+
+		It("should parse the filter", func() {
+			Expect(filterer.ParseExpressionCallCount()).To(Equal(1))
+			Expect(filterer.ParseExpressionArgsForCall(0)).To(Equal(request.Filter))
+		})
+
+		It("should only send the matching results", func() {
+			Eventually(stream.sentResults).Should(HaveLen(1))
+			Consistently(stream.sentResults).Should(HaveLen(1))
+			Expect(stream.sentResults()[0].ResourceEvaluation.Id).To(Equal(failedResult.ResourceEvaluation.Id))
+		})
+
+		It("should match the filter without searching Elasticsearch", func() {
+			Eventually(stream.sentResults).Should(HaveLen(1))
+			Expect(esClient.SearchCallCount()).To(Equal(0))
+		})
+
+		When("Elasticsearch isn't refreshed after writes", func() {
+			BeforeEach(func() {
+				esConfig.Refresh = config.RefreshFalse
+			})
+
+			It("should still send the matching results", func() {
+				Eventually(stream.sentResults).Should(HaveLen(1))
+				Expect(stream.sentResults()[0].ResourceEvaluation.Id).To(Equal(failedResult.ResourceEvaluation.Id))
+
+				_, bulkRequest := esClient.BulkArgsForCall(0)
+				Expect(bulkRequest.Refresh).To(Equal(config.RefreshFalse))
+			})
+		})
+	})
+
+	When("the filter is invalid", func() {
+		BeforeEach(func() {
+			request.Filter = fake.Word()
+			filterer.ParseExpressionReturns(nil, errors.New(fake.Word()))
+		})
+
+		It("should return an error without watching", func() {
+			var err error
+			Eventually(watchResult).Should(Receive(&err))
+			Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.Internal))
+			Expect(watcherCount()).To(Equal(0))
+		})
+	})
+
+	When("the filter isn't supported", func() {
+		BeforeEach(func() {
+			request.Filter = `other == "value"`
+			filterer.ParseExpressionReturns(&filtering.Query{
+				Term: &filtering.Term{
+					"other": "value",
+				},
+			}, nil)
+		})
+
+		It("should return an error without watching", func() {
+			var err error
+			Eventually(watchResult).Should(Receive(&err))
+			Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+			Expect(watcherCount()).To(Equal(0))
+		})
+	})
+
+	When("a watcher isn't keeping up", func() {
+		var watcher *resourceEvaluationWatcher
+
+		BeforeEach(func() {
+			watcher = &resourceEvaluationWatcher{
+				results: make(chan []*pb.ResourceEvaluationResult, 1),
+			}
+		})
+
+		It("should drop results rather than block", func() {
+			evaluationManager.addWatcher(watcher)
+
+			evaluationManager.publishResourceEvaluationResults(completeResult)
+			evaluationManager.publishResourceEvaluationResults(completeResult)
+
+			Expect(watcher.results).To(HaveLen(1))
+		})
+	})
+})
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	sendErr error
+
+	mu      sync.Mutex
+	results []*pb.ResourceEvaluationResult
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(result *pb.ResourceEvaluationResult) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results = append(f.results, result)
+
+	return nil
+}
+
+func (f *fakeWatchStream) sentResults() []*pb.ResourceEvaluationResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]*pb.ResourceEvaluationResult{}, f.results...)
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rode/rode/config"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsContentType = "application/cloudevents+json; charset=UTF-8"
+)
+
+// cloudEvent is a CloudEvent in the structured JSON format
+// https://github.com/cloudevents/spec/blob/v1.0.1/json-format.md
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+type httpPublisher struct {
+	logger       *zap.Logger
+	eventsConfig *config.EventsConfig
+	httpClient   *http.Client
+	events       chan *cloudEvent
+}
+
+var newUuid = uuid.New
+
+// NewHttpPublisher returns a Publisher that POSTs events to EventsConfig.SinkUrl in structured mode. Events are sent
+// one at a time, in the order they were published.
+func NewHttpPublisher(logger *zap.Logger, eventsConfig *config.EventsConfig) Publisher {
+	p := newHttpPublisher(logger, eventsConfig)
+	go p.run(context.Background())
+
+	return p
+}
+
+func newHttpPublisher(logger *zap.Logger, eventsConfig *config.EventsConfig) *httpPublisher {
+	return &httpPublisher{
+		logger:       logger,
+		eventsConfig: eventsConfig,
+		httpClient: &http.Client{
+			Timeout: eventsConfig.Timeout,
+		},
+		events: make(chan *cloudEvent, eventsConfig.QueueSize),
+	}
+}
+
+func (p *httpPublisher) Publish(eventType, subject string, data protoreflect.ProtoMessage) {
+	log := p.logger.Named("Publish").With(zap.String("type", eventType), zap.String("subject", subject))
+
+	// the data is serialized right away, since the caller may keep modifying it after the event is published
+	dataJson, err := protojson.Marshal(data)
+	if err != nil {
+		log.Error("error marshalling event data", zap.Error(err))
+		return
+	}
+
+	event := &cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		Id:              newUuid().String(),
+		Source:          p.eventsConfig.Source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            dataJson,
+	}
+
+	select {
+	case p.events <- event:
+	default:
+		log.Warn("event queue is full, dropping event", zap.String("id", event.Id))
+	}
+}
+
+func (p *httpPublisher) run(ctx context.Context) {
+	for event := range p.events {
+		if err := p.send(ctx, event); err != nil {
+			p.logger.Warn("error sending event", zap.Error(err), zap.String("id", event.Id), zap.String("type", event.Type))
+		}
+	}
+}
+
+func (p *httpPublisher) send(ctx context.Context, event *cloudEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.eventsConfig.SinkUrl, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", cloudEventsContentType)
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status code %d", response.StatusCode)
+	}
+
+	return nil
+}
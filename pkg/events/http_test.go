@@ -0,0 +1,215 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/config"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type receivedEvent struct {
+	contentType string
+	event       map[string]interface{}
+	data        []byte
+}
+
+var _ = Describe("HTTP publisher", func() {
+	var (
+		ctx          = context.Background()
+		server       *httptest.Server
+		received     chan *receivedEvent
+		statusCode   int
+		eventsConfig *config.EventsConfig
+		publisher    *httpPublisher
+
+		eventType string
+		subject   string
+		data      *pb.Policy
+	)
+
+	BeforeEach(func() {
+		received = make(chan *receivedEvent, 10)
+		statusCode = http.StatusAccepted
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			var event map[string]interface{}
+			_ = json.Unmarshal(body, &event)
+			var raw struct {
+				Data json.RawMessage `json:"data"`
+			}
+			_ = json.Unmarshal(body, &raw)
+
+			received <- &receivedEvent{
+				contentType: r.Header.Get("Content-Type"),
+				event:       event,
+				data:        raw.Data,
+			}
+			w.WriteHeader(statusCode)
+		}))
+
+		eventsConfig = &config.EventsConfig{
+			SinkUrl:   server.URL,
+			Source:    fake.URL(),
+			QueueSize: 1,
+			Timeout:   time.Second,
+		}
+		publisher = newHttpPublisher(logger, eventsConfig)
+
+		eventType = PolicyCreated
+		subject = fake.UUID()
+		data = &pb.Policy{
+			Id:   subject,
+			Name: fake.LetterN(10),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("Publish", func() {
+		It("should queue the event", func() {
+			publisher.Publish(eventType, subject, data)
+
+			var event *cloudEvent
+			Expect(publisher.events).To(Receive(&event))
+			Expect(event.SpecVersion).To(Equal("1.0"))
+			Expect(event.Id).NotTo(BeEmpty())
+			Expect(event.Source).To(Equal(eventsConfig.Source))
+			Expect(event.Type).To(Equal(eventType))
+			Expect(event.Subject).To(Equal(subject))
+			Expect(event.DataContentType).To(Equal("application/json"))
+
+			_, err := time.Parse(time.RFC3339Nano, event.Time)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should serialize the data when the event is published", func() {
+			publisher.Publish(eventType, subject, data)
+			data.Name = fake.LetterN(11)
+
+			var event *cloudEvent
+			Expect(publisher.events).To(Receive(&event))
+
+			var actualData pb.Policy
+			Expect(protojson.Unmarshal(event.Data, &actualData)).To(Succeed())
+			Expect(actualData.Name).NotTo(Equal(data.Name))
+		})
+
+		It("should drop events when the queue is full", func() {
+			publisher.Publish(eventType, subject, data)
+			publisher.Publish(eventType, subject, data)
+
+			Expect(publisher.events).To(HaveLen(1))
+		})
+	})
+
+	Context("run", func() {
+		BeforeEach(func() {
+			eventsConfig.QueueSize = 2
+			publisher = newHttpPublisher(logger, eventsConfig)
+
+			publisher.Publish(eventType, subject, data)
+			publisher.Publish(PolicyDeleted, subject, data)
+			close(publisher.events)
+		})
+
+		JustBeforeEach(func() {
+			publisher.run(ctx)
+		})
+
+		It("should send each event to the sink in structured mode, in order", func() {
+			Expect(received).To(HaveLen(2))
+
+			var first, second *receivedEvent
+			Expect(received).To(Receive(&first))
+			Expect(received).To(Receive(&second))
+
+			Expect(first.contentType).To(Equal("application/cloudevents+json; charset=UTF-8"))
+			Expect(first.event).To(HaveKeyWithValue("specversion", "1.0"))
+			Expect(first.event).To(HaveKeyWithValue("source", eventsConfig.Source))
+			Expect(first.event).To(HaveKeyWithValue("type", PolicyCreated))
+			Expect(first.event).To(HaveKeyWithValue("subject", subject))
+			Expect(first.event).To(HaveKeyWithValue("datacontenttype", "application/json"))
+			Expect(first.event).To(HaveKey("id"))
+			Expect(first.event).To(HaveKey("time"))
+
+			var actualData pb.Policy
+			Expect(protojson.Unmarshal(first.data, &actualData)).To(Succeed())
+			Expect(proto.Equal(&actualData, data)).To(BeTrue())
+
+			Expect(second.event).To(HaveKeyWithValue("type", PolicyDeleted))
+		})
+
+		When("the sink rejects an event", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+			})
+
+			It("should continue sending events", func() {
+				Expect(received).To(HaveLen(2))
+			})
+		})
+	})
+
+	Context("send", func() {
+		var event *cloudEvent
+
+		BeforeEach(func() {
+			event = &cloudEvent{
+				SpecVersion: cloudEventsSpecVersion,
+				Id:          fake.UUID(),
+				Source:      eventsConfig.Source,
+				Type:        eventType,
+				Data:        json.RawMessage("{}"),
+			}
+		})
+
+		It("should not return an error when the sink accepts the event", func() {
+			Expect(publisher.send(ctx, event)).To(Succeed())
+		})
+
+		When("the sink responds with an error", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusBadRequest
+			})
+
+			It("should return an error", func() {
+				Expect(publisher.send(ctx, event)).To(MatchError(ContainSubstring("400")))
+			})
+		})
+
+		When("the sink can't be reached", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+
+			It("should return an error", func() {
+				Expect(publisher.send(ctx, event)).NotTo(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"github.com/rode/rode/config"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CloudEvent types emitted by rode
+const (
+	OccurrenceCreated = "rode.occurrence.created"
+
+	PolicyCreated = "rode.policy.created"
+	PolicyUpdated = "rode.policy.updated"
+	PolicyDeleted = "rode.policy.deleted"
+
+	PolicyGroupCreated = "rode.policy-group.created"
+	PolicyGroupUpdated = "rode.policy-group.updated"
+	PolicyGroupDeleted = "rode.policy-group.deleted"
+
+	PolicyAssignmentCreated = "rode.policy-assignment.created"
+	PolicyAssignmentUpdated = "rode.policy-assignment.updated"
+	PolicyAssignmentDeleted = "rode.policy-assignment.deleted"
+
+	ResourceEvaluationCompleted = "rode.resource-evaluation.completed"
+	ResourceEvaluationFailed    = "rode.resource-evaluation.failed"
+)
+
+//go:generate counterfeiter -generate
+
+// Publisher emits a CloudEvent when state in rode changes. Events are sent in the background, so Publish never blocks
+// the caller and delivery failures aren't reported back to it.
+//
+//counterfeiter:generate . Publisher
+type Publisher interface {
+	// Publish emits an event of the given type. The subject identifies the entity that changed, and data is the
+	// entity's state after the change.
+	Publish(eventType, subject string, data protoreflect.ProtoMessage)
+}
+
+// NewPublisher returns a Publisher that sends events to the configured sink, or one that discards them when no sink
+// has been configured.
+func NewPublisher(logger *zap.Logger, eventsConfig *config.EventsConfig) Publisher {
+	if eventsConfig.SinkUrl == "" {
+		return NewNoopPublisher()
+	}
+
+	return NewHttpPublisher(logger, eventsConfig)
+}
+
+type noopPublisher struct{}
+
+// NewNoopPublisher returns a Publisher that discards every event
+func NewNoopPublisher() Publisher {
+	return noopPublisher{}
+}
+
+func (noopPublisher) Publish(string, string, protoreflect.ProtoMessage) {}
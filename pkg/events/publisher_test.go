@@ -0,0 +1,53 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/rode/config"
+)
+
+var _ = Describe("publisher", func() {
+	Context("NewPublisher", func() {
+		var eventsConfig *config.EventsConfig
+
+		BeforeEach(func() {
+			eventsConfig = &config.EventsConfig{
+				Source:    fake.URL(),
+				QueueSize: 1,
+				Timeout:   time.Second,
+			}
+		})
+
+		When("a sink is configured", func() {
+			BeforeEach(func() {
+				eventsConfig.SinkUrl = fake.URL()
+			})
+
+			It("should return an HTTP publisher", func() {
+				Expect(NewPublisher(logger, eventsConfig)).To(BeAssignableToTypeOf(&httpPublisher{}))
+			})
+		})
+
+		When("a sink is not configured", func() {
+			It("should return a no-op publisher", func() {
+				Expect(NewPublisher(logger, eventsConfig)).To(Equal(NewNoopPublisher()))
+			})
+		})
+	})
+})
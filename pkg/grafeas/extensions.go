@@ -16,60 +16,49 @@ package grafeas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/rode/rode/pkg/constants"
 	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	"go.uber.org/zap"
-	"strings"
 )
 
+// ErrTooManyOccurrences is returned when a resource has more occurrences, or build occurrences, than allowed
+var ErrTooManyOccurrences = errors.New("too many occurrences")
+
 //go:generate counterfeiter -generate
 
 //counterfeiter:generate . Extensions
 type Extensions interface {
 	ListVersionedResourceOccurrences(ctx context.Context, resourceUri, pageToken string, pageSize int32) ([]*grafeas_proto.Occurrence, string, error)
+	ListAllVersionedResourceOccurrences(ctx context.Context, resourceUri string, maxOccurrences int) ([]*grafeas_proto.Occurrence, error)
 }
 
 type extensions struct {
-	logger        *zap.Logger
-	grafeasCommon grafeas_proto.GrafeasV1Beta1Client
+	logger              *zap.Logger
+	grafeasCommon       grafeas_proto.GrafeasV1Beta1Client
+	maxBuildOccurrences int
 }
 
-func NewExtensions(logger *zap.Logger, grafeasCommon grafeas_proto.GrafeasV1Beta1Client) Extensions {
-	return &extensions{logger, grafeasCommon}
+// NewExtensions returns Extensions backed by grafeas. maxBuildOccurrences limits the number of build occurrences that
+// are used to find related resources; exceeding it is an error.
+func NewExtensions(logger *zap.Logger, grafeasCommon grafeas_proto.GrafeasV1Beta1Client, maxBuildOccurrences int) Extensions {
+	return &extensions{logger, grafeasCommon, maxBuildOccurrences}
 }
 
+// ListVersionedResourceOccurrences returns a page of the occurrences for the resource and the resources related to it
+// by build occurrences. The related resources are looked up again for each page.
 func (e *extensions) ListVersionedResourceOccurrences(ctx context.Context, resourceUri, pageToken string, pageSize int32) ([]*grafeas_proto.Occurrence, string, error) {
 	log := e.logger.Named("ListVersionedResourceOccurrences")
 
-	buildOccurrences, err := e.grafeasCommon.ListOccurrences(ctx, &grafeas_proto.ListOccurrencesRequest{
-		Parent:   constants.RodeProjectSlug,
-		PageSize: constants.MaxPageSize,
-		Filter:   fmt.Sprintf(`kind == "BUILD" && (resource.uri == "%[1]s" || build.provenance.builtArtifacts.nestedFilter(id == "%[1]s"))`, resourceUri),
-	})
+	filter, err := e.versionedResourceFilter(ctx, resourceUri)
 	if err != nil {
-		return nil, "", fmt.Errorf("error fetching build occurrences: %v", err)
-	}
-	if buildOccurrences.NextPageToken != "" {
-		log.Warn(fmt.Sprintf("listing build occurrences returned a page token, implying that there are more than %d build occurrences for this resource", constants.MaxPageSize))
-	}
-
-	resourceUris := map[string]string{
-		resourceUri: resourceUri,
-	}
-	for _, occurrence := range buildOccurrences.Occurrences {
-		resourceUris[occurrence.Resource.Uri] = occurrence.Resource.Uri
-		for _, artifact := range occurrence.GetBuild().GetProvenance().BuiltArtifacts {
-			resourceUris[artifact.Id] = artifact.Id
-		}
-	}
-
-	var resourceFilters []string
-	for k := range resourceUris {
-		resourceFilters = append(resourceFilters, fmt.Sprintf(`resource.uri == "%s"`, k))
+		return nil, "", err
 	}
 
-	filter := strings.Join(resourceFilters, " || ")
 	log.Debug("listing occurrences", zap.String("filter", filter))
 	allOccurrences, err := e.grafeasCommon.ListOccurrences(ctx, &grafeas_proto.ListOccurrencesRequest{
 		Parent:    constants.RodeProjectSlug,
@@ -83,3 +72,101 @@ func (e *extensions) ListVersionedResourceOccurrences(ctx context.Context, resou
 
 	return allOccurrences.Occurrences, allOccurrences.NextPageToken, nil
 }
+
+// ListAllVersionedResourceOccurrences pages through every occurrence for the resource and the resources related to it
+// by build occurrences, which are only looked up once. ErrTooManyOccurrences is returned if there are more than
+// maxOccurrences, rather than a partial set.
+func (e *extensions) ListAllVersionedResourceOccurrences(ctx context.Context, resourceUri string, maxOccurrences int) ([]*grafeas_proto.Occurrence, error) {
+	log := e.logger.Named("ListAllVersionedResourceOccurrences")
+
+	filter, err := e.versionedResourceFilter(ctx, resourceUri)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("listing occurrences", zap.String("filter", filter))
+	var occurrences []*grafeas_proto.Occurrence
+	pageToken := ""
+	for {
+		response, err := e.grafeasCommon.ListOccurrences(ctx, &grafeas_proto.ListOccurrencesRequest{
+			Parent:    constants.RodeProjectSlug,
+			Filter:    filter,
+			PageSize:  constants.MaxPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing all occurrences: %v", err)
+		}
+
+		occurrences = append(occurrences, response.Occurrences...)
+		if len(occurrences) > maxOccurrences {
+			return nil, fmt.Errorf("resource %s has more than %d occurrences: %w", resourceUri, maxOccurrences, ErrTooManyOccurrences)
+		}
+
+		if response.NextPageToken == "" {
+			return occurrences, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// versionedResourceFilter builds a filter that matches the occurrences of the resource and of every resource related to
+// it by a build occurrence. The resource uris are sorted, so the filter is the same each time it's built.
+func (e *extensions) versionedResourceFilter(ctx context.Context, resourceUri string) (string, error) {
+	buildOccurrences, err := e.listBuildOccurrences(ctx, resourceUri)
+	if err != nil {
+		return "", err
+	}
+
+	resourceUris := map[string]bool{
+		resourceUri: true,
+	}
+	for _, occurrence := range buildOccurrences {
+		resourceUris[occurrence.Resource.Uri] = true
+		for _, artifact := range occurrence.GetBuild().GetProvenance().GetBuiltArtifacts() {
+			resourceUris[artifact.Id] = true
+		}
+	}
+
+	var sortedUris []string
+	for uri := range resourceUris {
+		sortedUris = append(sortedUris, uri)
+	}
+	sort.Strings(sortedUris)
+
+	var resourceFilters []string
+	for _, uri := range sortedUris {
+		resourceFilters = append(resourceFilters, fmt.Sprintf(`resource.uri == "%s"`, uri))
+	}
+
+	return strings.Join(resourceFilters, " || "), nil
+}
+
+// listBuildOccurrences pages through every build occurrence that either produced the resource or ran against it
+func (e *extensions) listBuildOccurrences(ctx context.Context, resourceUri string) ([]*grafeas_proto.Occurrence, error) {
+	filter := fmt.Sprintf(`kind == "BUILD" && (resource.uri == "%[1]s" || build.provenance.builtArtifacts.nestedFilter(id == "%[1]s"))`, resourceUri)
+
+	var buildOccurrences []*grafeas_proto.Occurrence
+	pageToken := ""
+	for {
+		response, err := e.grafeasCommon.ListOccurrences(ctx, &grafeas_proto.ListOccurrencesRequest{
+			Parent:    constants.RodeProjectSlug,
+			PageSize:  constants.MaxPageSize,
+			PageToken: pageToken,
+			Filter:    filter,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching build occurrences: %v", err)
+		}
+
+		buildOccurrences = append(buildOccurrences, response.Occurrences...)
+		if len(buildOccurrences) > e.maxBuildOccurrences {
+			return nil, fmt.Errorf("resource %s has more than %d build occurrences: %w", resourceUri, e.maxBuildOccurrences, ErrTooManyOccurrences)
+		}
+
+		if response.NextPageToken == "" {
+			return buildOccurrences, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
@@ -27,6 +27,7 @@ import (
 	grafeas_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/grafeas_go_proto"
 	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/provenance_go_proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
 	"strings"
 )
 
@@ -35,20 +36,25 @@ var _ = Describe("grafeas extensions", func() {
 		ctx           context.Context
 		grafeasClient *mocks.FakeGrafeasV1Beta1Client
 
-		extensions Extensions
+		maxBuildOccurrences int
+		extensions          Extensions
 	)
 
 	BeforeEach(func() {
 		ctx = context.Background()
 		grafeasClient = &mocks.FakeGrafeasV1Beta1Client{}
+		maxBuildOccurrences = 10
+	})
 
-		extensions = NewExtensions(logger, grafeasClient)
+	JustBeforeEach(func() {
+		extensions = NewExtensions(logger, grafeasClient, maxBuildOccurrences)
 	})
 
 	Context("ListVersionedResourceOccurrences", func() {
 		var (
 			listBuildOccurrencesResponse *grafeas_proto.ListOccurrencesResponse
 			listBuildOccurrencesError    error
+			nextBuildOccurrencesPage     *grafeas_proto.ListOccurrencesResponse
 
 			listAllOccurrencesResponse *grafeas_proto.ListOccurrencesResponse
 			listAllOccurrencesError    error
@@ -95,6 +101,7 @@ var _ = Describe("grafeas extensions", func() {
 				},
 			}
 			listBuildOccurrencesError = nil
+			nextBuildOccurrencesPage = nil
 
 			occurrences := []*grafeas_proto.Occurrence{
 				createRandomOccurrence(grafeas_common_proto.NoteKind_VULNERABILITY),
@@ -109,8 +116,13 @@ var _ = Describe("grafeas extensions", func() {
 		})
 
 		JustBeforeEach(func() {
-			grafeasClient.ListOccurrencesReturnsOnCall(0, listBuildOccurrencesResponse, listBuildOccurrencesError)
-			grafeasClient.ListOccurrencesReturnsOnCall(1, listAllOccurrencesResponse, listAllOccurrencesError)
+			call := 0
+			grafeasClient.ListOccurrencesReturnsOnCall(call, listBuildOccurrencesResponse, listBuildOccurrencesError)
+			if nextBuildOccurrencesPage != nil {
+				call++
+				grafeasClient.ListOccurrencesReturnsOnCall(call, nextBuildOccurrencesPage, nil)
+			}
+			grafeasClient.ListOccurrencesReturnsOnCall(call+1, listAllOccurrencesResponse, listAllOccurrencesError)
 
 			actualOccurrences, actualNextPageToken, actualError = extensions.ListVersionedResourceOccurrences(ctx, resourceUri, currentPageToken, pageSize)
 		})
@@ -142,6 +154,13 @@ var _ = Describe("grafeas extensions", func() {
 			Expect(filterParts).To(ConsistOf(expectedFilter))
 		})
 
+		It("should sort the resource uris so that the filter is the same for every page", func() {
+			_, allOccurrencesRequest, _ := grafeasClient.ListOccurrencesArgsForCall(1)
+
+			filterParts := strings.Split(allOccurrencesRequest.Filter, " || ")
+			Expect(sort.StringsAreSorted(filterParts)).To(BeTrue())
+		})
+
 		It("should return the occurrences and page token from the call to list all occurrences", func() {
 			Expect(actualOccurrences).To(BeEquivalentTo(listAllOccurrencesResponse.Occurrences))
 			Expect(actualNextPageToken).To(BeEquivalentTo(listAllOccurrencesResponse.NextPageToken))
@@ -160,6 +179,66 @@ var _ = Describe("grafeas extensions", func() {
 			})
 		})
 
+		When("the build occurrences span multiple pages", func() {
+			var (
+				buildPageToken      string
+				otherGitResourceUri string
+			)
+
+			BeforeEach(func() {
+				buildPageToken = fake.Word()
+				otherGitResourceUri = fmt.Sprintf("git://%s", fake.DomainName())
+
+				listBuildOccurrencesResponse.NextPageToken = buildPageToken
+				nextBuildOccurrencesPage = &grafeas_proto.ListOccurrencesResponse{
+					Occurrences: []*grafeas_proto.Occurrence{
+						{
+							Resource: &grafeas_proto.Resource{
+								Uri: otherGitResourceUri,
+							},
+							Kind: grafeas_common_proto.NoteKind_BUILD,
+						},
+					},
+				}
+			})
+
+			It("should request the next page of build occurrences", func() {
+				Expect(grafeasClient.ListOccurrencesCallCount()).To(Equal(3))
+
+				_, nextPageRequest, _ := grafeasClient.ListOccurrencesArgsForCall(1)
+				Expect(nextPageRequest.PageToken).To(Equal(buildPageToken))
+				Expect(nextPageRequest.Filter).To(ContainSubstring(`kind == "BUILD"`))
+			})
+
+			It("should use the build occurrences from every page to find all occurrences", func() {
+				_, allOccurrencesRequest, _ := grafeasClient.ListOccurrencesArgsForCall(2)
+
+				filterParts := strings.Split(allOccurrencesRequest.Filter, " || ")
+				Expect(filterParts).To(ConsistOf(
+					fmt.Sprintf(`resource.uri == "%s"`, resourceUri),
+					fmt.Sprintf(`resource.uri == "%s"`, gitResourceUri),
+					fmt.Sprintf(`resource.uri == "%s"`, otherGitResourceUri),
+				))
+				Expect(actualError).NotTo(HaveOccurred())
+			})
+
+			When("there are more build occurrences than allowed", func() {
+				BeforeEach(func() {
+					maxBuildOccurrences = 1
+				})
+
+				It("should return an error", func() {
+					Expect(actualOccurrences).To(BeNil())
+					Expect(actualNextPageToken).To(BeEmpty())
+					Expect(errors.Is(actualError, ErrTooManyOccurrences)).To(BeTrue())
+				})
+
+				It("should not attempt to list all occurrences", func() {
+					Expect(grafeasClient.ListOccurrencesCallCount()).To(Equal(2))
+				})
+			})
+		})
+
 		When("an error occurs listing build occurrences", func() {
 			BeforeEach(func() {
 				listBuildOccurrencesError = errors.New("error listing build occurrences")
@@ -188,6 +267,85 @@ var _ = Describe("grafeas extensions", func() {
 			})
 		})
 	})
+
+	Context("ListAllVersionedResourceOccurrences", func() {
+		var (
+			resourceUri    string
+			maxOccurrences int
+			pages          []*grafeas_proto.ListOccurrencesResponse
+			listError      error
+
+			actualOccurrences []*grafeas_proto.Occurrence
+			actualError       error
+		)
+
+		BeforeEach(func() {
+			resourceUri = fake.URL()
+			maxOccurrences = 10
+			listError = nil
+
+			pages = []*grafeas_proto.ListOccurrencesResponse{
+				{
+					Occurrences:   []*grafeas_proto.Occurrence{createRandomOccurrence(grafeas_common_proto.NoteKind_VULNERABILITY)},
+					NextPageToken: fake.Word(),
+				},
+				{
+					Occurrences: []*grafeas_proto.Occurrence{createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD)},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			grafeasClient.ListOccurrencesReturnsOnCall(0, &grafeas_proto.ListOccurrencesResponse{}, nil)
+			for i, page := range pages {
+				grafeasClient.ListOccurrencesReturnsOnCall(i+1, page, listError)
+			}
+
+			actualOccurrences, actualError = extensions.ListAllVersionedResourceOccurrences(ctx, resourceUri, maxOccurrences)
+		})
+
+		It("should return the occurrences from every page", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualOccurrences).To(ConsistOf(pages[0].Occurrences[0], pages[1].Occurrences[0]))
+		})
+
+		It("should only list the build occurrences once", func() {
+			Expect(grafeasClient.ListOccurrencesCallCount()).To(Equal(3))
+
+			_, buildOccurrencesRequest, _ := grafeasClient.ListOccurrencesArgsForCall(0)
+			Expect(buildOccurrencesRequest.Filter).To(ContainSubstring(`kind == "BUILD"`))
+
+			_, firstPageRequest, _ := grafeasClient.ListOccurrencesArgsForCall(1)
+			_, secondPageRequest, _ := grafeasClient.ListOccurrencesArgsForCall(2)
+			Expect(firstPageRequest.PageToken).To(BeEmpty())
+			Expect(firstPageRequest.Filter).To(Equal(fmt.Sprintf(`resource.uri == "%s"`, resourceUri)))
+			Expect(secondPageRequest.PageToken).To(Equal(pages[0].NextPageToken))
+			Expect(secondPageRequest.Filter).To(Equal(firstPageRequest.Filter))
+		})
+
+		When("there are more occurrences than allowed", func() {
+			BeforeEach(func() {
+				maxOccurrences = 1
+			})
+
+			It("should return an error instead of a partial set", func() {
+				Expect(actualOccurrences).To(BeNil())
+				Expect(errors.Is(actualError, ErrTooManyOccurrences)).To(BeTrue())
+			})
+		})
+
+		When("an error occurs listing the occurrences", func() {
+			BeforeEach(func() {
+				listError = errors.New(fake.Word())
+			})
+
+			It("should return an error", func() {
+				Expect(actualOccurrences).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(errors.Is(actualError, ErrTooManyOccurrences)).To(BeFalse())
+			})
+		})
+	})
 })
 
 func createRandomOccurrence(kind grafeas_common_proto.NoteKind) *grafeas_proto.Occurrence {
@@ -10,6 +10,21 @@ import (
 )
 
 type FakeExtensions struct {
+	ListAllVersionedResourceOccurrencesStub        func(context.Context, string, int) ([]*grafeas_go_proto.Occurrence, error)
+	listAllVersionedResourceOccurrencesMutex       sync.RWMutex
+	listAllVersionedResourceOccurrencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listAllVersionedResourceOccurrencesReturns struct {
+		result1 []*grafeas_go_proto.Occurrence
+		result2 error
+	}
+	listAllVersionedResourceOccurrencesReturnsOnCall map[int]struct {
+		result1 []*grafeas_go_proto.Occurrence
+		result2 error
+	}
 	ListVersionedResourceOccurrencesStub        func(context.Context, string, string, int32) ([]*grafeas_go_proto.Occurrence, string, error)
 	listVersionedResourceOccurrencesMutex       sync.RWMutex
 	listVersionedResourceOccurrencesArgsForCall []struct {
@@ -32,6 +47,72 @@ type FakeExtensions struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrences(arg1 context.Context, arg2 string, arg3 int) ([]*grafeas_go_proto.Occurrence, error) {
+	fake.listAllVersionedResourceOccurrencesMutex.Lock()
+	ret, specificReturn := fake.listAllVersionedResourceOccurrencesReturnsOnCall[len(fake.listAllVersionedResourceOccurrencesArgsForCall)]
+	fake.listAllVersionedResourceOccurrencesArgsForCall = append(fake.listAllVersionedResourceOccurrencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListAllVersionedResourceOccurrencesStub
+	fakeReturns := fake.listAllVersionedResourceOccurrencesReturns
+	fake.recordInvocation("ListAllVersionedResourceOccurrences", []interface{}{arg1, arg2, arg3})
+	fake.listAllVersionedResourceOccurrencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrencesCallCount() int {
+	fake.listAllVersionedResourceOccurrencesMutex.RLock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.RUnlock()
+	return len(fake.listAllVersionedResourceOccurrencesArgsForCall)
+}
+
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrencesCalls(stub func(context.Context, string, int) ([]*grafeas_go_proto.Occurrence, error)) {
+	fake.listAllVersionedResourceOccurrencesMutex.Lock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.Unlock()
+	fake.ListAllVersionedResourceOccurrencesStub = stub
+}
+
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrencesArgsForCall(i int) (context.Context, string, int) {
+	fake.listAllVersionedResourceOccurrencesMutex.RLock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.RUnlock()
+	argsForCall := fake.listAllVersionedResourceOccurrencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrencesReturns(result1 []*grafeas_go_proto.Occurrence, result2 error) {
+	fake.listAllVersionedResourceOccurrencesMutex.Lock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.Unlock()
+	fake.ListAllVersionedResourceOccurrencesStub = nil
+	fake.listAllVersionedResourceOccurrencesReturns = struct {
+		result1 []*grafeas_go_proto.Occurrence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExtensions) ListAllVersionedResourceOccurrencesReturnsOnCall(i int, result1 []*grafeas_go_proto.Occurrence, result2 error) {
+	fake.listAllVersionedResourceOccurrencesMutex.Lock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.Unlock()
+	fake.ListAllVersionedResourceOccurrencesStub = nil
+	if fake.listAllVersionedResourceOccurrencesReturnsOnCall == nil {
+		fake.listAllVersionedResourceOccurrencesReturnsOnCall = make(map[int]struct {
+			result1 []*grafeas_go_proto.Occurrence
+			result2 error
+		})
+	}
+	fake.listAllVersionedResourceOccurrencesReturnsOnCall[i] = struct {
+		result1 []*grafeas_go_proto.Occurrence
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeExtensions) ListVersionedResourceOccurrences(arg1 context.Context, arg2 string, arg3 string, arg4 int32) ([]*grafeas_go_proto.Occurrence, string, error) {
 	fake.listVersionedResourceOccurrencesMutex.Lock()
 	ret, specificReturn := fake.listVersionedResourceOccurrencesReturnsOnCall[len(fake.listVersionedResourceOccurrencesArgsForCall)]
@@ -105,6 +186,8 @@ func (fake *FakeExtensions) ListVersionedResourceOccurrencesReturnsOnCall(i int,
 func (fake *FakeExtensions) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.listAllVersionedResourceOccurrencesMutex.RLock()
+	defer fake.listAllVersionedResourceOccurrencesMutex.RUnlock()
 	fake.listVersionedResourceOccurrencesMutex.RLock()
 	defer fake.listVersionedResourceOccurrencesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
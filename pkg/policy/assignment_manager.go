@@ -23,6 +23,8 @@ import (
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/webhook"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -39,14 +41,23 @@ type AssignmentManager interface {
 	UpdatePolicyAssignment(context.Context, *pb.PolicyAssignment) (*pb.PolicyAssignment, error)
 	DeletePolicyAssignment(context.Context, *pb.DeletePolicyAssignmentRequest) (*emptypb.Empty, error)
 	ListPolicyAssignments(context.Context, *pb.ListPolicyAssignmentsRequest) (*pb.ListPolicyAssignmentsResponse, error)
+	RegisterChangeHandler(AssignmentChangeHandler)
+}
+
+// AssignmentChangeHandler is notified after a policy assignment is created, updated, or deleted
+type AssignmentChangeHandler interface {
+	PolicyAssignmentChanged(policyGroup string)
 }
 
 type assignmentManager struct {
-	logger       *zap.Logger
-	esClient     esutil.Client
-	esConfig     *config.ElasticsearchConfig
-	indexManager indexmanager.IndexManager
-	filterer     filtering.Filterer
+	logger          *zap.Logger
+	esClient        esutil.Client
+	esConfig        *config.ElasticsearchConfig
+	indexManager    indexmanager.IndexManager
+	filterer        filtering.Filterer
+	webhookNotifier webhook.Notifier
+	eventPublisher  events.Publisher
+	changeHandlers  []AssignmentChangeHandler
 }
 
 func NewAssignmentManager(
@@ -55,13 +66,17 @@ func NewAssignmentManager(
 	esConfig *config.ElasticsearchConfig,
 	indexManager indexmanager.IndexManager,
 	filterer filtering.Filterer,
+	webhookNotifier webhook.Notifier,
+	eventPublisher events.Publisher,
 ) AssignmentManager {
 	return &assignmentManager{
-		logger,
-		esClient,
-		esConfig,
-		indexManager,
-		filterer,
+		logger:          logger,
+		esClient:        esClient,
+		esConfig:        esConfig,
+		indexManager:    indexManager,
+		filterer:        filterer,
+		webhookNotifier: webhookNotifier,
+		eventPublisher:  eventPublisher,
 	}
 }
 
@@ -92,6 +107,13 @@ func (m *assignmentManager) CreatePolicyAssignment(ctx context.Context, assignme
 		return nil, createError(log, "error creating policy assignment", err)
 	}
 
+	m.notifyChangeHandlers(assignment.PolicyGroup)
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_ASSIGNMENT_CREATED,
+		Data: &pb.WebhookEvent_PolicyAssignment{PolicyAssignment: assignment},
+	})
+	m.eventPublisher.Publish(events.PolicyAssignmentCreated, assignment.Id, assignment)
+
 	return assignment, nil
 }
 
@@ -142,6 +164,13 @@ func (m *assignmentManager) UpdatePolicyAssignment(ctx context.Context, assignme
 		return nil, createError(log, "error updating policy assignment in Elasticsearch", err)
 	}
 
+	m.notifyChangeHandlers(assignment.PolicyGroup)
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_ASSIGNMENT_UPDATED,
+		Data: &pb.WebhookEvent_PolicyAssignment{PolicyAssignment: assignment},
+	})
+	m.eventPublisher.Publish(events.PolicyAssignmentUpdated, assignment.Id, assignment)
+
 	return assignment, nil
 }
 
@@ -150,7 +179,8 @@ func (m *assignmentManager) DeletePolicyAssignment(ctx context.Context, request
 	log.Debug("received request")
 
 	// check that assignment exists
-	if _, err := m.GetPolicyAssignment(ctx, &pb.GetPolicyAssignmentRequest{Id: request.Id}); err != nil {
+	assignment, err := m.GetPolicyAssignment(ctx, &pb.GetPolicyAssignmentRequest{Id: request.Id})
+	if err != nil {
 		return nil, err
 	}
 
@@ -168,6 +198,13 @@ func (m *assignmentManager) DeletePolicyAssignment(ctx context.Context, request
 		return nil, createError(log, "error deleting assignment", err)
 	}
 
+	m.notifyChangeHandlers(assignment.PolicyGroup)
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_ASSIGNMENT_DELETED,
+		Data: &pb.WebhookEvent_PolicyAssignment{PolicyAssignment: assignment},
+	})
+	m.eventPublisher.Publish(events.PolicyAssignmentDeleted, assignment.Id, assignment)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -244,6 +281,18 @@ func (m *assignmentManager) ListPolicyAssignments(ctx context.Context, request *
 	return response, nil
 }
 
+// RegisterChangeHandler adds a handler that's notified of assignment changes. Handlers should be registered during
+// startup, before any requests are served.
+func (m *assignmentManager) RegisterChangeHandler(handler AssignmentChangeHandler) {
+	m.changeHandlers = append(m.changeHandlers, handler)
+}
+
+func (m *assignmentManager) notifyChangeHandlers(policyGroup string) {
+	for _, handler := range m.changeHandlers {
+		handler.PolicyAssignmentChanged(policyGroup)
+	}
+}
+
 func (m *assignmentManager) policyAssignmentsAlias() string {
 	return m.indexManager.AliasName(constants.PolicyAssignmentsDocumentKind, "")
 }
@@ -264,6 +313,14 @@ func (m *assignmentManager) validateAssignment(ctx context.Context, log *zap.Log
 		return createErrorWithCode(log, "Assignments must use policy version ids", nil, codes.InvalidArgument)
 	}
 
+	if _, ok := pb.PolicyEnforcementMode_name[int32(assignment.EnforcementMode)]; !ok {
+		return createErrorWithCode(log, "invalid enforcement mode", nil, codes.InvalidArgument)
+	}
+
+	if err := validateSelector(assignment.Selector); err != nil {
+		return createErrorWithCode(log, "invalid selector", err, codes.InvalidArgument)
+	}
+
 	if err = validate(ctx, log, policyId, assignment); err != nil {
 		return err
 	}
@@ -28,6 +28,9 @@ import (
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -47,14 +50,19 @@ var _ = Describe("AssignmentManager", func() {
 		esConfig     *config.ElasticsearchConfig
 		indexManager *immocks.FakeIndexManager
 		filterer     *filteringfakes.FakeFilterer
+		notifier     *webhookfakes.FakeNotifier
+		publisher    *eventsfakes.FakePublisher
 
-		manager AssignmentManager
+		changeHandler *recordingChangeHandler
+		manager       AssignmentManager
 	)
 
 	BeforeEach(func() {
 		indexManager = &immocks.FakeIndexManager{}
 		esClient = &esutilfakes.FakeClient{}
 		filterer = &filteringfakes.FakeFilterer{}
+		notifier = &webhookfakes.FakeNotifier{}
+		publisher = &eventsfakes.FakePublisher{}
 		esConfig = randomEsConfig()
 
 		expectedPolicyAssignmentsAlias = fake.LetterN(10)
@@ -68,7 +76,9 @@ var _ = Describe("AssignmentManager", func() {
 			}[documentKind]
 		}
 
-		manager = NewAssignmentManager(logger, esClient, esConfig, indexManager, filterer)
+		changeHandler = &recordingChangeHandler{}
+		manager = NewAssignmentManager(logger, esClient, esConfig, indexManager, filterer, notifier, publisher)
+		manager.RegisterChangeHandler(changeHandler)
 	})
 
 	Context("CreatePolicyAssignment", func() {
@@ -185,6 +195,27 @@ var _ = Describe("AssignmentManager", func() {
 			Expect(actualMessage.Updated.IsValid()).To(BeTrue())
 		})
 
+		It("should notify the change handlers", func() {
+			Expect(changeHandler.policyGroups).To(ConsistOf(assignment.PolicyGroup))
+		})
+
+		It("should notify webhook subscribers", func() {
+			Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+			event := notifier.NotifyArgsForCall(0)
+			Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_ASSIGNMENT_CREATED))
+			Expect(event.GetPolicyAssignment().Id).To(Equal(assignmentId))
+		})
+
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyAssignmentCreated))
+			Expect(subject).To(Equal(assignmentId))
+			Expect(data).To(Equal(actualAssignment))
+		})
+
 		It("should return the created assignment", func() {
 			Expect(actualAssignment.Id).To(Equal(assignmentId))
 			Expect(actualAssignment.PolicyGroup).To(Equal(assignment.PolicyGroup))
@@ -214,6 +245,12 @@ var _ = Describe("AssignmentManager", func() {
 			It("should not try to create a new assignment", func() {
 				Expect(esClient.CreateCallCount()).To(Equal(0))
 			})
+
+			It("should not notify the change handlers", func() {
+				Expect(changeHandler.policyGroups).To(BeEmpty())
+				Expect(notifier.NotifyCallCount()).To(Equal(0))
+				Expect(publisher.PublishCallCount()).To(Equal(0))
+			})
 		})
 
 		When("the policy version does not exist", func() {
@@ -276,6 +313,38 @@ var _ = Describe("AssignmentManager", func() {
 			})
 		})
 
+		When("the enforcement mode is not recognized", func() {
+			BeforeEach(func() {
+				assignment.EnforcementMode = pb.PolicyEnforcementMode(fake.Number(3, 10))
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not try to create a new assignment", func() {
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the selector is invalid", func() {
+			BeforeEach(func() {
+				assignment.Selector = &pb.PolicyAssignmentSelector{
+					NameRegex: "(",
+				}
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not try to create a new assignment", func() {
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
 		When("a policy id is sent instead of a policy version id", func() {
 			BeforeEach(func() {
 				assignment.PolicyVersionId = fake.UUID()
@@ -537,6 +606,7 @@ var _ = Describe("AssignmentManager", func() {
 			newPolicyVersionId = fmt.Sprintf("%s.%d", policyId, fake.Number(6, 10))
 			updatedAssignment = deepCopyPolicyAssignment(currentAssignment)
 			updatedAssignment.PolicyVersionId = newPolicyVersionId
+			updatedAssignment.EnforcementMode = pb.PolicyEnforcementMode_AUDIT
 
 			assignmentJson, _ := protojson.Marshal(currentAssignment)
 			getAssignmentResponse = &esutil.EsGetResponse{
@@ -619,9 +689,31 @@ var _ = Describe("AssignmentManager", func() {
 			Expect(actualMessage.Id).To(Equal(assignmentId))
 			Expect(actualMessage.PolicyVersionId).To(Equal(newPolicyVersionId))
 			Expect(actualMessage.PolicyGroup).To(Equal(currentAssignment.PolicyGroup))
+			Expect(actualMessage.EnforcementMode).To(Equal(pb.PolicyEnforcementMode_AUDIT))
 			Expect(actualMessage.Updated).NotTo(Equal(currentAssignment.Updated))
 		})
 
+		It("should notify the change handlers", func() {
+			Expect(changeHandler.policyGroups).To(ConsistOf(currentAssignment.PolicyGroup))
+		})
+
+		It("should notify webhook subscribers", func() {
+			Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+			event := notifier.NotifyArgsForCall(0)
+			Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_ASSIGNMENT_UPDATED))
+			Expect(event.GetPolicyAssignment().PolicyVersionId).To(Equal(newPolicyVersionId))
+		})
+
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyAssignmentUpdated))
+			Expect(subject).To(Equal(assignmentId))
+			Expect(data).To(Equal(actualAssignment))
+		})
+
 		It("should return the updated assignment", func() {
 			Expect(actualAssignment).NotTo(BeNil())
 			Expect(actualAssignment.Id).To(Equal(assignmentId))
@@ -664,6 +756,7 @@ var _ = Describe("AssignmentManager", func() {
 
 			It("should not attempt to update the assignment", func() {
 				Expect(esClient.UpdateCallCount()).To(Equal(0))
+				Expect(changeHandler.policyGroups).To(BeEmpty())
 			})
 		})
 
@@ -814,7 +907,8 @@ var _ = Describe("AssignmentManager", func() {
 
 	Context("DeletePolicyAssignment", func() {
 		var (
-			assignmentId string
+			assignmentId       string
+			existingAssignment *pb.PolicyAssignment
 
 			getAssignmentResponse *esutil.EsGetResponse
 			getAssignmentError    error
@@ -827,7 +921,8 @@ var _ = Describe("AssignmentManager", func() {
 		BeforeEach(func() {
 			assignmentId = randomPolicyAssignmentId()
 
-			assignmentJson, _ := protojson.Marshal(randomPolicyAssignment(assignmentId))
+			existingAssignment = randomPolicyAssignment(assignmentId)
+			assignmentJson, _ := protojson.Marshal(existingAssignment)
 			getAssignmentResponse = &esutil.EsGetResponse{
 				Id:     assignmentId,
 				Found:  true,
@@ -869,6 +964,26 @@ var _ = Describe("AssignmentManager", func() {
 			Expect(actualError).To(BeNil())
 		})
 
+		It("should notify the change handlers", func() {
+			Expect(changeHandler.policyGroups).To(ConsistOf(existingAssignment.PolicyGroup))
+		})
+
+		It("should notify webhook subscribers", func() {
+			Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+			event := notifier.NotifyArgsForCall(0)
+			Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_ASSIGNMENT_DELETED))
+			Expect(event.GetPolicyAssignment().Id).To(Equal(existingAssignment.Id))
+		})
+
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, _ := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyAssignmentDeleted))
+			Expect(subject).To(Equal(existingAssignment.Id))
+		})
+
 		When("an error occurs deleting the assignment", func() {
 			BeforeEach(func() {
 				deleteAssignmentError = errors.New("delete error")
@@ -879,6 +994,12 @@ var _ = Describe("AssignmentManager", func() {
 				Expect(actualError).To(HaveOccurred())
 				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
 			})
+
+			It("should not notify the change handlers", func() {
+				Expect(changeHandler.policyGroups).To(BeEmpty())
+				Expect(notifier.NotifyCallCount()).To(Equal(0))
+				Expect(publisher.PublishCallCount()).To(Equal(0))
+			})
 		})
 
 		When("fetching the assignment returns an error", func() {
@@ -1110,6 +1231,14 @@ var _ = Describe("AssignmentManager", func() {
 	})
 })
 
+type recordingChangeHandler struct {
+	policyGroups []string
+}
+
+func (h *recordingChangeHandler) PolicyAssignmentChanged(policyGroup string) {
+	h.policyGroups = append(h.policyGroups, policyGroup)
+}
+
 func randomPolicyAssignmentId() string {
 	return fmt.Sprintf("policies/%s/assignments/%s", fake.UUID(), fake.Word())
 }
@@ -1131,5 +1260,7 @@ func deepCopyPolicyAssignment(assignment *pb.PolicyAssignment) *pb.PolicyAssignm
 		PolicyGroup:     assignment.PolicyGroup,
 		Created:         assignment.Created,
 		Updated:         assignment.Updated,
+		EnforcementMode: assignment.EnforcementMode,
+		Selector:        assignment.Selector,
 	}
 }
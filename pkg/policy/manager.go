@@ -29,6 +29,8 @@ import (
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/webhook"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -61,10 +63,12 @@ type Manager interface {
 type manager struct {
 	logger *zap.Logger
 
-	esClient     esutil.Client
-	esConfig     *config.ElasticsearchConfig
-	indexManager indexmanager.IndexManager
-	filterer     filtering.Filterer
+	esClient        esutil.Client
+	esConfig        *config.ElasticsearchConfig
+	indexManager    indexmanager.IndexManager
+	filterer        filtering.Filterer
+	webhookNotifier webhook.Notifier
+	eventPublisher  events.Publisher
 }
 
 func NewManager(
@@ -73,13 +77,17 @@ func NewManager(
 	esConfig *config.ElasticsearchConfig,
 	indexManager indexmanager.IndexManager,
 	filterer filtering.Filterer,
+	webhookNotifier webhook.Notifier,
+	eventPublisher events.Publisher,
 ) Manager {
 	return &manager{
-		logger:       logger,
-		esClient:     esClient,
-		esConfig:     esConfig,
-		indexManager: indexManager,
-		filterer:     filterer,
+		logger:          logger,
+		esClient:        esClient,
+		esConfig:        esConfig,
+		indexManager:    indexManager,
+		filterer:        filterer,
+		webhookNotifier: webhookNotifier,
+		eventPublisher:  eventPublisher,
 	}
 }
 
@@ -165,6 +173,12 @@ func (m *manager) CreatePolicy(ctx context.Context, policy *pb.Policy) (*pb.Poli
 
 	policy.Policy = policyVersion
 
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_CREATED,
+		Data: &pb.WebhookEvent_Policy{Policy: policy},
+	})
+	m.eventPublisher.Publish(events.PolicyCreated, policy.Id, policy)
+
 	log.Debug("successfully created policy")
 	return policy, nil
 }
@@ -224,6 +238,12 @@ func (m *manager) DeletePolicy(ctx context.Context, request *pb.DeletePolicyRequ
 		return nil, createError(log, "error deleting policy and its versions", err)
 	}
 
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_DELETED,
+		Data: &pb.WebhookEvent_Policy{Policy: policy},
+	})
+	m.eventPublisher.Publish(events.PolicyDeleted, policy.Id, policy)
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -486,6 +506,12 @@ func (m *manager) UpdatePolicy(ctx context.Context, request *pb.UpdatePolicyRequ
 
 	currentPolicy.Policy = policyVersion
 
+	m.webhookNotifier.Notify(&pb.WebhookEvent{
+		Type: pb.WebhookEventType_POLICY_UPDATED,
+		Data: &pb.WebhookEvent_Policy{Policy: currentPolicy},
+	})
+	m.eventPublisher.Publish(events.PolicyUpdated, currentPolicy.Id, currentPolicy)
+
 	return currentPolicy, nil
 }
 
@@ -675,6 +701,7 @@ func createError(log *zap.Logger, message string, err error, fields ...zap.Field
 // validateRodeRequirementsForPolicy ensures that these two rules are followed:
 // 1. A policy is expected to return a pass that is simply a boolean representing the AND of all rules.
 // 2. A policy is expected to return an array of violations that are maps containing a description id message name pass. pass here is what will be used to determine the overall pass.
+// Violations may also contain a severity, which must be one of LOW, MEDIUM, HIGH, or CRITICAL when it's a string literal.
 func validateRodeRequirementsForPolicy(mod *ast.Module) []error {
 	errorsList := []error{}
 	// policy must contains a pass block somewhere in the code
@@ -712,6 +739,12 @@ func validateRodeRequirementsForPolicy(mod *ast.Module) []error {
 		err := errors.New(`all "violations" blocks must return a "result" that contains pass, id, message, and name fields`)
 		errorsList = append(errorsList, err)
 	}
+	for _, r := range violations {
+		for _, severity := range invalidResultSeverities(r.Body) {
+			err := fmt.Errorf(`violation severity %s is invalid, must be one of LOW, MEDIUM, HIGH, or CRITICAL`, severity)
+			errorsList = append(errorsList, err)
+		}
+	}
 
 	return errorsList
 }
@@ -753,6 +786,41 @@ func validateResultTermsInBody(body ast.Body) bool {
 	return true
 }
 
+// invalidResultSeverities returns any string literal severities in a violations result that don't match a known severity.
+// Severities computed by the policy can only be checked at evaluation time.
+func invalidResultSeverities(body ast.Body) []string {
+	var severities []string
+	for _, b := range body {
+		if b.Operator().String() != "assign" && b.Operator().String() != "eq" {
+			continue
+		}
+
+		terms := (b.Terms).([]*ast.Term)
+		for i, t := range terms {
+			object, ok := t.Value.(ast.Object)
+			if !ok || i == 0 || terms[i-1].String() != "result" {
+				continue
+			}
+
+			severity := object.Get(ast.StringTerm("severity"))
+			if severity == nil {
+				continue
+			}
+
+			value, ok := severity.Value.(ast.String)
+			if !ok {
+				continue
+			}
+
+			if v, ok := pb.ViolationSeverity_value[string(value)]; !ok || v == int32(pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED) {
+				severities = append(severities, severity.String())
+			}
+		}
+	}
+
+	return severities
+}
+
 func policyVersionId(policyId string, version uint32) string {
 	return fmt.Sprintf("%s.%d", policyId, version)
 }
@@ -30,6 +30,9 @@ import (
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
 	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -49,7 +52,11 @@ var (
 	compilablePolicyMissingResultsReturn string
 	//go:embed test/uncompilable.rego
 	uncompilablePolicy string
-	unparseablePolicy  = `
+	//go:embed test/severities.rego
+	severitiesPolicy string
+	//go:embed test/invalid_severity.rego
+	invalidSeverityPolicy string
+	unparseablePolicy     = `
 		package play
 		default hello = false
 		hello
@@ -67,6 +74,8 @@ var _ = Describe("PolicyManager", func() {
 		esConfig     *config.ElasticsearchConfig
 		indexManager *immocks.FakeIndexManager
 		filterer     *filteringfakes.FakeFilterer
+		notifier     *webhookfakes.FakeNotifier
+		publisher    *eventsfakes.FakePublisher
 
 		manager Manager
 	)
@@ -75,12 +84,14 @@ var _ = Describe("PolicyManager", func() {
 		esClient = &esutilfakes.FakeClient{}
 		indexManager = &immocks.FakeIndexManager{}
 		filterer = &filteringfakes.FakeFilterer{}
+		notifier = &webhookfakes.FakeNotifier{}
+		publisher = &eventsfakes.FakePublisher{}
 		esConfig = randomEsConfig()
 
 		expectedPoliciesAlias = fake.LetterN(10)
 		indexManager.AliasNameReturns(expectedPoliciesAlias)
 
-		manager = NewManager(logger, esClient, esConfig, indexManager, filterer)
+		manager = NewManager(logger, esClient, esConfig, indexManager, filterer, notifier, publisher)
 	})
 
 	Context("CreatePolicy", func() {
@@ -205,6 +216,23 @@ var _ = Describe("PolicyManager", func() {
 				Expect(actualPolicy.Policy.Version).To(Equal(version))
 				Expect(actualPolicy.Policy.Message).To(Equal("Initial policy creation"))
 			})
+
+			It("should notify webhook subscribers", func() {
+				Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+				event := notifier.NotifyArgsForCall(0)
+				Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_CREATED))
+				Expect(event.GetPolicy().Id).To(Equal(policyId))
+			})
+
+			It("should publish an event", func() {
+				Expect(publisher.PublishCallCount()).To(Equal(1))
+
+				eventType, subject, data := publisher.PublishArgsForCall(0)
+				Expect(eventType).To(Equal(events.PolicyCreated))
+				Expect(subject).To(Equal(policyId))
+				Expect(data).To(Equal(actualPolicy))
+			})
 		})
 
 		When("the policy is invalid", func() {
@@ -229,6 +257,7 @@ var _ = Describe("PolicyManager", func() {
 
 			It("should not create any documents", func() {
 				Expect(esClient.BulkCallCount()).To(Equal(0))
+				Expect(notifier.NotifyCallCount()).To(Equal(0))
 			})
 		})
 
@@ -1303,6 +1332,23 @@ var _ = Describe("PolicyManager", func() {
 			Expect(actualResponse.Policy.Created.IsValid()).To(BeTrue())
 		})
 
+		It("should notify webhook subscribers", func() {
+			Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+			event := notifier.NotifyArgsForCall(0)
+			Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_UPDATED))
+			Expect(event.GetPolicy().CurrentVersion).To(Equal(newVersion))
+		})
+
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyUpdated))
+			Expect(subject).To(Equal(policyId))
+			Expect(data).To(Equal(actualResponse))
+		})
+
 		When("the policy was previously deleted", func() {
 			BeforeEach(func() {
 				currentPolicy.Deleted = true
@@ -1573,6 +1619,22 @@ var _ = Describe("PolicyManager", func() {
 				actualPolicy := actualRequest.Message.(*pb.Policy)
 				Expect(actualPolicy.Deleted).To(BeTrue())
 			})
+
+			It("should notify webhook subscribers", func() {
+				Expect(notifier.NotifyCallCount()).To(Equal(1))
+
+				event := notifier.NotifyArgsForCall(0)
+				Expect(event.Type).To(Equal(pb.WebhookEventType_POLICY_DELETED))
+				Expect(event.GetPolicy().Id).To(Equal(policyId))
+			})
+
+			It("should publish an event", func() {
+				Expect(publisher.PublishCallCount()).To(Equal(1))
+
+				eventType, subject, _ := publisher.PublishArgsForCall(0)
+				Expect(eventType).To(Equal(events.PolicyDeleted))
+				Expect(subject).To(Equal(policyId))
+			})
 		})
 
 		When("the policy id isn't specified", func() {
@@ -1617,6 +1679,11 @@ var _ = Describe("PolicyManager", func() {
 				Expect(actualError).To(HaveOccurred())
 				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
 			})
+
+			It("should not notify webhook subscribers", func() {
+				Expect(notifier.NotifyCallCount()).To(Equal(0))
+				Expect(publisher.PublishCallCount()).To(Equal(0))
+			})
 		})
 	})
 
@@ -1712,6 +1779,33 @@ var _ = Describe("PolicyManager", func() {
 			})
 		})
 
+		When("the policy violations have severities", func() {
+			BeforeEach(func() {
+				request.Policy = severitiesPolicy
+			})
+
+			It("should not return an error", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.Errors).To(BeEmpty())
+			})
+		})
+
+		When("a policy violation has an unknown severity", func() {
+			BeforeEach(func() {
+				request.Policy = invalidSeverityPolicy
+			})
+
+			It("should return an error", func() {
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should include an error message about the severity", func() {
+				Expect(actualResponse.Errors).To(HaveLen(1))
+				Expect(actualResponse.Errors[0]).To(ContainSubstring("SEVERE"))
+			})
+		})
+
 		When("the policy does not have pass or violations rules", func() {
 			BeforeEach(func() {
 				request.Policy = compilablePolicyMissingRodeFields
@@ -16,19 +16,26 @@ package policy
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/rode/es-index-manager/indexmanager"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
 	"github.com/rode/rode/config"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/versioned"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -39,31 +46,53 @@ type PolicyGroupManager interface {
 	GetPolicyGroup(context.Context, *pb.GetPolicyGroupRequest) (*pb.PolicyGroup, error)
 	UpdatePolicyGroup(context.Context, *pb.PolicyGroup) (*pb.PolicyGroup, error)
 	DeletePolicyGroup(context.Context, *pb.DeletePolicyGroupRequest) (*emptypb.Empty, error)
+	ResolvePolicyGroupAssignments(context.Context, *pb.ResolvePolicyGroupAssignmentsRequest) (*pb.ResolvePolicyGroupAssignmentsResponse, error)
 }
 
 var policyGroupNamePattern = regexp.MustCompile("^[a-z0-9-_]+$")
 
+// maxPolicyGroupUpdateAttempts is how many times an update is retried when the policy group is written concurrently
+const maxPolicyGroupUpdateAttempts = 3
+
+// editablePolicyGroupFields are the fields that UpdatePolicyGroup can change, named as they appear in an update mask
+var editablePolicyGroupFields = map[string]bool{
+	"description":        true,
+	"attest":             true,
+	"severity_threshold": true,
+	"parents":            true,
+	"auto_evaluate":      true,
+}
+
 type policyGroupManager struct {
-	logger       *zap.Logger
-	esClient     esutil.Client
-	esConfig     *config.ElasticsearchConfig
-	indexManager indexmanager.IndexManager
-	filterer     filtering.Filterer
+	logger          *zap.Logger
+	esClient        esutil.Client
+	versionedClient versioned.Client
+	esConfig        *config.ElasticsearchConfig
+	evalConfig      *config.EvaluationConfig
+	indexManager    indexmanager.IndexManager
+	filterer        filtering.Filterer
+	eventPublisher  events.Publisher
 }
 
 func NewPolicyGroupManager(
 	logger *zap.Logger,
 	esClient esutil.Client,
+	versionedClient versioned.Client,
 	esConfig *config.ElasticsearchConfig,
+	evalConfig *config.EvaluationConfig,
 	indexManager indexmanager.IndexManager,
 	filterer filtering.Filterer,
+	eventPublisher events.Publisher,
 ) PolicyGroupManager {
 	return &policyGroupManager{
 		logger,
 		esClient,
+		versionedClient,
 		esConfig,
+		evalConfig,
 		indexManager,
 		filterer,
+		eventPublisher,
 	}
 }
 
@@ -84,7 +113,20 @@ func (m *policyGroupManager) CreatePolicyGroup(ctx context.Context, policyGroup
 		return nil, createErrorWithCode(log, "policy group name can only contain lowercase alphanumeric characters, dashes, and underscores.", nil, codes.InvalidArgument)
 	}
 
+	if !validSeverityThreshold(policyGroup.SeverityThreshold) {
+		return nil, createErrorWithCode(log, "invalid severity threshold", nil, codes.InvalidArgument)
+	}
+
+	if policyGroup.Attest && !m.attestationsEnabled() {
+		return nil, createErrorWithCode(log, "attestations require an evaluation signing key, but none has been configured", nil, codes.FailedPrecondition)
+	}
+
+	if err := m.validateParents(ctx, log, policyGroup); err != nil {
+		return nil, err
+	}
+
 	currentTime := timestamppb.Now()
+	policyGroup.UpdateMask = nil
 	policyGroup.Created = currentTime
 	policyGroup.Updated = currentTime
 
@@ -97,6 +139,8 @@ func (m *policyGroupManager) CreatePolicyGroup(ctx context.Context, policyGroup
 		return nil, createError(log, "error creating policy group", err)
 	}
 
+	m.eventPublisher.Publish(events.PolicyGroupCreated, policyGroup.Name, policyGroup)
+
 	return policyGroup, nil
 }
 
@@ -184,37 +228,139 @@ func (m *policyGroupManager) GetPolicyGroup(ctx context.Context, request *pb.Get
 	}
 
 	var policyGroup pb.PolicyGroup
-	if err := protojson.Unmarshal(response.Source, &policyGroup); err != nil {
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(response.Source, &policyGroup); err != nil {
 		return nil, createError(log, "error unmarshalling policy group", err)
 	}
 
 	return &policyGroup, nil
 }
 
+// UpdatePolicyGroup replaces the editable fields of a policy group, or only the fields in its update mask. The write is
+// conditional on the policy group not having changed since it was read, and is retried when it has.
 func (m *policyGroupManager) UpdatePolicyGroup(ctx context.Context, policyGroup *pb.PolicyGroup) (*pb.PolicyGroup, error) {
 	log := m.logger.Named("UpdatePolicyGroup").With(zap.String("name", policyGroup.Name))
 	log.Debug("received request")
-	currentPolicyGroup, err := m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: policyGroup.Name})
+
+	if policyGroup.Name == "" {
+		return nil, createErrorWithCode(log, "policy group name must be supplied", nil, codes.InvalidArgument)
+	}
+
+	updatedFields, err := policyGroupUpdatedFields(policyGroup.UpdateMask)
 	if err != nil {
-		return nil, err
+		return nil, createErrorWithCode(log, err.Error(), nil, codes.InvalidArgument)
+	}
+
+	if updatedFields["severity_threshold"] && !validSeverityThreshold(policyGroup.SeverityThreshold) {
+		return nil, createErrorWithCode(log, "invalid severity threshold", nil, codes.InvalidArgument)
+	}
+
+	if updatedFields["attest"] && policyGroup.Attest && !m.attestationsEnabled() {
+		return nil, createErrorWithCode(log, "attestations require an evaluation signing key, but none has been configured", nil, codes.FailedPrecondition)
+	}
+
+	for attempt := 1; ; attempt++ {
+		updatedPolicyGroup, err := m.updatePolicyGroup(ctx, log, policyGroup, updatedFields)
+		if errors.Is(err, versioned.ErrConflict) {
+			if attempt < maxPolicyGroupUpdateAttempts {
+				log.Debug("policy group was modified concurrently, retrying", zap.Int("attempt", attempt))
+				continue
+			}
+
+			return nil, createErrorWithCode(log, "policy group was modified concurrently, try again", err, codes.Aborted)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		m.eventPublisher.Publish(events.PolicyGroupUpdated, updatedPolicyGroup.Name, updatedPolicyGroup)
+
+		return updatedPolicyGroup, nil
+	}
+}
+
+// updatePolicyGroup makes a single attempt at an update, returning versioned.ErrConflict if the policy group was written
+// between reading and updating it
+func (m *policyGroupManager) updatePolicyGroup(ctx context.Context, log *zap.Logger, policyGroup *pb.PolicyGroup, updatedFields map[string]bool) (*pb.PolicyGroup, error) {
+	document, err := m.versionedClient.Get(ctx, &esutil.GetRequest{
+		Index:      m.policyGroupsAlias(),
+		DocumentId: policyGroup.Name,
+	})
+	if err != nil {
+		return nil, createError(log, "error retrieving policy group from elasticsearch", err)
+	}
+
+	if !document.Found {
+		return nil, createErrorWithCode(log, "policy group not found", nil, codes.NotFound)
+	}
+
+	currentPolicyGroup := &pb.PolicyGroup{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(document.Source, currentPolicyGroup); err != nil {
+		return nil, createError(log, "error unmarshalling policy group", err)
 	}
 
 	if currentPolicyGroup.Deleted {
 		return nil, createErrorWithCode(log, "cannot update a deleted policy group", nil, codes.FailedPrecondition)
 	}
 
-	currentPolicyGroup.Description = policyGroup.Description // only description is editable
+	previousPolicyGroup := proto.Clone(currentPolicyGroup).(*pb.PolicyGroup)
+	if updatedFields["description"] {
+		currentPolicyGroup.Description = policyGroup.Description
+	}
+	if updatedFields["attest"] {
+		currentPolicyGroup.Attest = policyGroup.Attest
+	}
+	if updatedFields["severity_threshold"] {
+		currentPolicyGroup.SeverityThreshold = policyGroup.SeverityThreshold
+	}
+	if updatedFields["parents"] {
+		currentPolicyGroup.Parents = policyGroup.Parents
+		if err := m.validateParents(ctx, log, currentPolicyGroup); err != nil {
+			return nil, err
+		}
+	}
+	if updatedFields["auto_evaluate"] {
+		currentPolicyGroup.AutoEvaluate = policyGroup.AutoEvaluate
+	}
 	currentPolicyGroup.Updated = timestamppb.Now()
 
-	if _, err := m.esClient.Update(ctx, &esutil.UpdateRequest{
+	newVersion, err := m.versionedClient.Update(ctx, &esutil.UpdateRequest{
 		Index:      m.policyGroupsAlias(),
 		DocumentId: currentPolicyGroup.Name,
 		Refresh:    m.esConfig.Refresh.String(),
 		Message:    currentPolicyGroup,
-	}); err != nil {
+	}, document.DocumentVersion)
+	if errors.Is(err, versioned.ErrConflict) {
+		return nil, err
+	}
+	if err != nil {
 		return nil, createError(log, "error updating policy group", err)
 	}
 
+	if !updatedFields["parents"] || len(currentPolicyGroup.Parents) == 0 {
+		return currentPolicyGroup, nil
+	}
+
+	// an ancestor may have been given this policy group as a parent after the check above, so check again now that the
+	// change is visible to other updates. Whichever update checks last will see both changes and undo its own.
+	cycle, err := m.findParentCycle(ctx, currentPolicyGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if cycle != nil {
+		if _, err := m.versionedClient.Update(ctx, &esutil.UpdateRequest{
+			Index:      m.policyGroupsAlias(),
+			DocumentId: previousPolicyGroup.Name,
+			Refresh:    m.esConfig.Refresh.String(),
+			Message:    previousPolicyGroup,
+		}, *newVersion); err != nil {
+			log.Error("error reverting policy group update that created a cycle", zap.Error(err))
+		}
+
+		return nil, createErrorWithCode(log, fmt.Sprintf("policy group hierarchy contains a cycle: %s", strings.Join(cycle, " -> ")), nil, codes.InvalidArgument)
+	}
+
 	return currentPolicyGroup, nil
 }
 
@@ -237,9 +383,257 @@ func (m *policyGroupManager) DeletePolicyGroup(ctx context.Context, request *pb.
 		return nil, createError(log, "error marking policy group as deleted", err)
 	}
 
+	m.eventPublisher.Publish(events.PolicyGroupDeleted, currentPolicyGroup.Name, currentPolicyGroup)
+
 	return &emptypb.Empty{}, nil
 }
 
+func (m *policyGroupManager) ResolvePolicyGroupAssignments(ctx context.Context, request *pb.ResolvePolicyGroupAssignmentsRequest) (*pb.ResolvePolicyGroupAssignmentsResponse, error) {
+	log := m.logger.Named("ResolvePolicyGroupAssignments").With(zap.String("name", request.Name))
+	log.Debug("received request")
+
+	policyGroup, err := m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: request.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	policyGroups, err := m.policyGroupHierarchy(ctx, policyGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	groupQueries := filtering.Should{}
+	for _, name := range policyGroups {
+		groupQueries = append(groupQueries, &filtering.Query{
+			Term: &filtering.Term{
+				"policyGroup": name,
+			},
+		})
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, &esutil.SearchRequest{
+		Index: m.indexManager.AliasName(constants.PolicyAssignmentsDocumentKind, ""),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Bool: &filtering.Bool{
+					Should: &groupQueries,
+				},
+			},
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+		},
+	})
+	if err != nil {
+		return nil, createError(log, "error searching for policy assignments", err)
+	}
+
+	assignmentsByGroup := map[string][]*pb.PolicyAssignment{}
+	for _, hit := range searchResponse.Hits.Hits {
+		var assignment pb.PolicyAssignment
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(hit.Source, &assignment); err != nil {
+			return nil, createError(log, "error unmarshalling assignment", err)
+		}
+
+		assignmentsByGroup[assignment.PolicyGroup] = append(assignmentsByGroup[assignment.PolicyGroup], &assignment)
+	}
+
+	response := &pb.ResolvePolicyGroupAssignmentsResponse{
+		PolicyGroups: policyGroups,
+	}
+	// policy groups are ordered from closest to furthest, so the first assignment for a policy takes precedence
+	assignedPolicies := map[string]bool{}
+	for _, name := range policyGroups {
+		for _, assignment := range assignmentsByGroup[name] {
+			policyId, _, err := parsePolicyVersionId(assignment.PolicyVersionId)
+			if err != nil {
+				return nil, createError(log, "error parsing policy version id", err)
+			}
+
+			if assignedPolicies[policyId] {
+				response.OverriddenPolicyAssignments = append(response.OverriddenPolicyAssignments, assignment)
+				continue
+			}
+
+			assignedPolicies[policyId] = true
+			response.PolicyAssignments = append(response.PolicyAssignments, assignment)
+		}
+	}
+
+	return response, nil
+}
+
+// policyGroupHierarchy returns the names of the policy group and its ancestors, ordered by distance from the policy group
+// so that a parent always comes before a grandparent. Parents at the same distance keep the order they're declared in,
+// and deleted ancestors are left out along with their own parents.
+func (m *policyGroupManager) policyGroupHierarchy(ctx context.Context, policyGroup *pb.PolicyGroup) ([]string, error) {
+	visited := map[string]bool{policyGroup.Name: true}
+	hierarchy := []string{policyGroup.Name}
+
+	queue := []*pb.PolicyGroup{policyGroup}
+	for len(queue) > 0 {
+		group := queue[0]
+		queue = queue[1:]
+
+		for _, parentName := range group.Parents {
+			if visited[parentName] {
+				continue
+			}
+			visited[parentName] = true
+
+			parent, err := m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: parentName})
+			if err != nil {
+				return nil, err
+			}
+
+			if parent.Deleted {
+				continue
+			}
+
+			hierarchy = append(hierarchy, parent.Name)
+			queue = append(queue, parent)
+		}
+	}
+
+	return hierarchy, nil
+}
+
+// validateParents checks that each parent of the policy group exists and that following the parents never leads back
+// to the policy group itself
+func (m *policyGroupManager) validateParents(ctx context.Context, log *zap.Logger, policyGroup *pb.PolicyGroup) error {
+	seen := map[string]bool{}
+	for _, parentName := range policyGroup.Parents {
+		if seen[parentName] {
+			return createErrorWithCode(log, fmt.Sprintf("parent policy group %s is listed more than once", parentName), nil, codes.InvalidArgument)
+		}
+		seen[parentName] = true
+
+		if parentName == policyGroup.Name {
+			return createErrorWithCode(log, "policy group cannot be its own parent", nil, codes.InvalidArgument)
+		}
+
+		parent, err := m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: parentName})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return createErrorWithCode(log, fmt.Sprintf("parent policy group %s not found", parentName), nil, codes.FailedPrecondition)
+			}
+
+			return err
+		}
+
+		if parent.Deleted {
+			return createErrorWithCode(log, fmt.Sprintf("parent policy group %s has been deleted", parentName), nil, codes.FailedPrecondition)
+		}
+
+		path, err := m.findAncestorPath(ctx, parent, policyGroup.Name, map[string]bool{})
+		if err != nil {
+			return err
+		}
+
+		if path != nil {
+			cycle := append([]string{policyGroup.Name}, path...)
+			return createErrorWithCode(log, fmt.Sprintf("policy group hierarchy contains a cycle: %s", strings.Join(cycle, " -> ")), nil, codes.InvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+// findParentCycle returns the path from the policy group back to itself through its parents, or nil if there's no cycle
+func (m *policyGroupManager) findParentCycle(ctx context.Context, policyGroup *pb.PolicyGroup) ([]string, error) {
+	visited := map[string]bool{}
+	for _, parentName := range policyGroup.Parents {
+		parent, err := m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: parentName})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		path, err := m.findAncestorPath(ctx, parent, policyGroup.Name, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		if path != nil {
+			return append([]string{policyGroup.Name}, path...), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findAncestorPath searches the ancestors of a policy group for the target, returning the path from the policy group
+// to the target, or nil if the target isn't an ancestor
+func (m *policyGroupManager) findAncestorPath(ctx context.Context, policyGroup *pb.PolicyGroup, target string, visited map[string]bool) ([]string, error) {
+	if policyGroup.Name == target {
+		return []string{target}, nil
+	}
+
+	if visited[policyGroup.Name] {
+		return nil, nil
+	}
+	visited[policyGroup.Name] = true
+
+	for _, parentName := range policyGroup.Parents {
+		var parent *pb.PolicyGroup
+		if parentName == target {
+			parent = &pb.PolicyGroup{Name: target}
+		} else {
+			var err error
+			if parent, err = m.GetPolicyGroup(ctx, &pb.GetPolicyGroupRequest{Name: parentName}); err != nil {
+				if status.Code(err) == codes.NotFound {
+					continue
+				}
+
+				return nil, err
+			}
+		}
+
+		path, err := m.findAncestorPath(ctx, parent, target, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		if path != nil {
+			return append([]string{policyGroup.Name}, path...), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// policyGroupUpdatedFields returns the fields to update. Without an update mask, every editable field is replaced.
+func policyGroupUpdatedFields(updateMask *fieldmaskpb.FieldMask) (map[string]bool, error) {
+	if len(updateMask.GetPaths()) == 0 {
+		return editablePolicyGroupFields, nil
+	}
+
+	updatedFields := map[string]bool{}
+	for _, path := range updateMask.Paths {
+		if !editablePolicyGroupFields[path] {
+			return nil, fmt.Errorf("field %s in the update mask cannot be updated", path)
+		}
+
+		updatedFields[path] = true
+	}
+
+	return updatedFields, nil
+}
+
+func validSeverityThreshold(threshold pb.ViolationSeverity) bool {
+	_, ok := pb.ViolationSeverity_name[int32(threshold)]
+
+	return ok
+}
+
+// attestationsEnabled reports whether an evaluation signing key is configured, without which attestations can't be created
+func (m *policyGroupManager) attestationsEnabled() bool {
+	return m.evalConfig.SigningKeyFile != ""
+}
+
 func (m *policyGroupManager) policyGroupsAlias() string {
 	return m.indexManager.AliasName(constants.PolicyGroupsDocumentKind, "")
 }
@@ -17,6 +17,7 @@ package policy
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,11 +27,16 @@ import (
 	"github.com/rode/rode/pkg/constants"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
 	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
 	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
+	"github.com/rode/rode/pkg/versioned"
+	"github.com/rode/rode/pkg/versioned/versionedfakes"
 	pb "github.com/rode/rode/proto/v1alpha1"
 )
 
@@ -41,22 +47,28 @@ var _ = Describe("PolicyGroupManager", func() {
 		ctx                       = context.Background()
 		expectedPolicyGroupsAlias string
 
-		esClient     *esutilfakes.FakeClient
-		esConfig     *config.ElasticsearchConfig
-		indexManager *immocks.FakeIndexManager
-		filterer     *filteringfakes.FakeFilterer
+		esClient        *esutilfakes.FakeClient
+		versionedClient *versionedfakes.FakeClient
+		esConfig        *config.ElasticsearchConfig
+		evalConfig      *config.EvaluationConfig
+		indexManager    *immocks.FakeIndexManager
+		filterer        *filteringfakes.FakeFilterer
+		publisher       *eventsfakes.FakePublisher
 	)
 
 	BeforeEach(func() {
 		esClient = &esutilfakes.FakeClient{}
+		versionedClient = &versionedfakes.FakeClient{}
 		esConfig = randomEsConfig()
+		evalConfig = &config.EvaluationConfig{SigningKeyFile: fake.Word()}
 		indexManager = &immocks.FakeIndexManager{}
 		filterer = &filteringfakes.FakeFilterer{}
+		publisher = &eventsfakes.FakePublisher{}
 
 		expectedPolicyGroupsAlias = fake.LetterN(10)
 		indexManager.AliasNameReturns(expectedPolicyGroupsAlias)
 
-		manager = NewPolicyGroupManager(logger, esClient, esConfig, indexManager, filterer)
+		manager = NewPolicyGroupManager(logger, esClient, versionedClient, esConfig, evalConfig, indexManager, filterer, publisher)
 	})
 
 	Context("CreatePolicyGroup", func() {
@@ -127,6 +139,15 @@ var _ = Describe("PolicyGroupManager", func() {
 			Expect(actualPolicyGroup.Updated.IsValid()).To(BeTrue())
 		})
 
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyGroupCreated))
+			Expect(subject).To(Equal(policyGroupName))
+			Expect(data).To(Equal(actualPolicyGroup))
+		})
+
 		When("the name is invalid", func() {
 			BeforeEach(func() {
 				createPolicyRequest.Name = fake.URL()
@@ -143,6 +164,51 @@ var _ = Describe("PolicyGroupManager", func() {
 			})
 		})
 
+		When("the severity threshold is invalid", func() {
+			BeforeEach(func() {
+				createPolicyRequest.SeverityThreshold = pb.ViolationSeverity(fake.Number(5, 100))
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not insert the policy group", func() {
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("attestations are enabled without a signing key", func() {
+			BeforeEach(func() {
+				createPolicyRequest.Attest = true
+				evalConfig.SigningKeyFile = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+			})
+
+			It("should not insert the policy group", func() {
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("attestations are disabled without a signing key", func() {
+			BeforeEach(func() {
+				createPolicyRequest.Attest = false
+				evalConfig.SigningKeyFile = ""
+			})
+
+			It("should create the policy group", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(esClient.CreateCallCount()).To(Equal(1))
+			})
+		})
+
 		When("a policy group with that name already exists", func() {
 			BeforeEach(func() {
 				getPolicyGroupResponse.Found = true
@@ -188,6 +254,10 @@ var _ = Describe("PolicyGroupManager", func() {
 				Expect(actualError).To(HaveOccurred())
 				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
 			})
+
+			It("should not publish an event", func() {
+				Expect(publisher.PublishCallCount()).To(Equal(0))
+			})
 		})
 	})
 
@@ -501,10 +571,11 @@ var _ = Describe("PolicyGroupManager", func() {
 			actualPolicyGroup *pb.PolicyGroup
 			actualError       error
 
-			getPolicyGroupResponse *esutil.EsGetResponse
+			getPolicyGroupResponse *versioned.Document
 			getPolicyGroupError    error
 
 			updatePolicyGroupError error
+			conflictingUpdates     int
 		)
 
 		BeforeEach(func() {
@@ -512,37 +583,47 @@ var _ = Describe("PolicyGroupManager", func() {
 			existingPolicyGroup = randomPolicyGroup(policyGroupName)
 			updatedPolicyGroup = deepCopyPolicyGroup(existingPolicyGroup)
 			updatedPolicyGroup.Description = fake.Sentence(5)
+			updatedPolicyGroup.Attest = !existingPolicyGroup.Attest
+			updatedPolicyGroup.SeverityThreshold = pb.ViolationSeverity_HIGH
+			updatedPolicyGroup.AutoEvaluate = true
 
 			policyGroupJson, _ := protojson.Marshal(existingPolicyGroup)
-			getPolicyGroupResponse = &esutil.EsGetResponse{
-				Id:     policyGroupName,
+			getPolicyGroupResponse = &versioned.Document{
+				DocumentVersion: versioned.DocumentVersion{
+					SeqNo:       fake.Number(1, 100),
+					PrimaryTerm: fake.Number(1, 10),
+				},
 				Found:  true,
 				Source: policyGroupJson,
 			}
 			getPolicyGroupError = nil
 			updatePolicyGroupError = nil
+			conflictingUpdates = 0
 		})
 
 		JustBeforeEach(func() {
-			esClient.GetReturns(getPolicyGroupResponse, getPolicyGroupError)
-			esClient.UpdateReturns(nil, updatePolicyGroupError)
+			versionedClient.GetReturns(getPolicyGroupResponse, getPolicyGroupError)
+			versionedClient.UpdateReturns(&versioned.DocumentVersion{}, updatePolicyGroupError)
+			for i := 0; i < conflictingUpdates; i++ {
+				versionedClient.UpdateReturnsOnCall(i, nil, versioned.ErrConflict)
+			}
 
 			actualPolicyGroup, actualError = manager.UpdatePolicyGroup(ctx, deepCopyPolicyGroup(updatedPolicyGroup))
 		})
 
 		It("should fetch the current policy group", func() {
-			Expect(esClient.GetCallCount()).To(Equal(1))
+			Expect(versionedClient.GetCallCount()).To(Equal(1))
 
-			_, actualRequest := esClient.GetArgsForCall(0)
+			_, actualRequest := versionedClient.GetArgsForCall(0)
 
 			Expect(actualRequest.Index).To(Equal(expectedPolicyGroupsAlias))
 			Expect(actualRequest.DocumentId).To(Equal(policyGroupName))
 		})
 
-		It("should update Elasticsearch with the new description", func() {
-			Expect(esClient.UpdateCallCount()).To(Equal(1))
+		It("should update Elasticsearch with the new description, attest, severity threshold, and auto-evaluate settings", func() {
+			Expect(versionedClient.UpdateCallCount()).To(Equal(1))
 
-			_, actualRequest := esClient.UpdateArgsForCall(0)
+			_, actualRequest, _ := versionedClient.UpdateArgsForCall(0)
 
 			Expect(actualRequest.DocumentId).To(Equal(policyGroupName))
 			Expect(actualRequest.Index).To(Equal(expectedPolicyGroupsAlias))
@@ -551,9 +632,18 @@ var _ = Describe("PolicyGroupManager", func() {
 			actualMessage := actualRequest.Message.(*pb.PolicyGroup)
 			Expect(actualMessage.Name).To(Equal(policyGroupName))
 			Expect(actualMessage.Description).To(Equal(updatedPolicyGroup.Description))
+			Expect(actualMessage.Attest).To(Equal(updatedPolicyGroup.Attest))
+			Expect(actualMessage.SeverityThreshold).To(Equal(pb.ViolationSeverity_HIGH))
+			Expect(actualMessage.AutoEvaluate).To(BeTrue())
 			Expect(actualMessage.Updated.IsValid()).To(BeTrue())
 		})
 
+		It("should only update the policy group if it hasn't changed since it was read", func() {
+			_, _, actualVersion := versionedClient.UpdateArgsForCall(0)
+
+			Expect(actualVersion).To(Equal(getPolicyGroupResponse.DocumentVersion))
+		})
+
 		It("should return the updated policy group", func() {
 			Expect(actualPolicyGroup).NotTo(BeNil())
 			Expect(actualPolicyGroup.Name).To(Equal(policyGroupName))
@@ -561,10 +651,123 @@ var _ = Describe("PolicyGroupManager", func() {
 			Expect(actualPolicyGroup.Updated.IsValid()).To(BeTrue())
 		})
 
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyGroupUpdated))
+			Expect(subject).To(Equal(policyGroupName))
+			Expect(data).To(Equal(actualPolicyGroup))
+		})
+
 		It("should not return an error", func() {
 			Expect(actualError).NotTo(HaveOccurred())
 		})
 
+		When("fields are cleared and there is no update mask", func() {
+			BeforeEach(func() {
+				existingPolicyGroup.Attest = true
+				existingPolicyGroup.SeverityThreshold = pb.ViolationSeverity_MEDIUM
+				existingPolicyGroup.AutoEvaluate = true
+				existingPolicyGroup.Parents = []string{fake.Word()}
+				policyGroupJson, _ := protojson.Marshal(existingPolicyGroup)
+				getPolicyGroupResponse.Source = policyGroupJson
+
+				updatedPolicyGroup = &pb.PolicyGroup{
+					Name:        policyGroupName,
+					Description: fake.Sentence(5),
+				}
+			})
+
+			It("should replace every editable field", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(versionedClient.UpdateCallCount()).To(Equal(1))
+
+				_, actualRequest, _ := versionedClient.UpdateArgsForCall(0)
+				actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+				Expect(actualMessage.Description).To(Equal(updatedPolicyGroup.Description))
+				Expect(actualMessage.Attest).To(BeFalse())
+				Expect(actualMessage.SeverityThreshold).To(Equal(pb.ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED))
+				Expect(actualMessage.AutoEvaluate).To(BeFalse())
+				Expect(actualMessage.Parents).To(BeEmpty())
+			})
+		})
+
+		When("there is an update mask", func() {
+			BeforeEach(func() {
+				existingPolicyGroup.Attest = true
+				existingPolicyGroup.AutoEvaluate = true
+				policyGroupJson, _ := protojson.Marshal(existingPolicyGroup)
+				getPolicyGroupResponse.Source = policyGroupJson
+
+				updatedPolicyGroup.Attest = false
+				updatedPolicyGroup.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"attest"}}
+			})
+
+			It("should only update the fields in the mask", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(versionedClient.UpdateCallCount()).To(Equal(1))
+
+				_, actualRequest, _ := versionedClient.UpdateArgsForCall(0)
+				actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+				Expect(actualMessage.Attest).To(BeFalse())
+				Expect(actualMessage.Description).To(Equal(existingPolicyGroup.Description))
+				Expect(actualMessage.SeverityThreshold).To(Equal(existingPolicyGroup.SeverityThreshold))
+				Expect(actualMessage.AutoEvaluate).To(BeTrue())
+			})
+
+			It("should not store the update mask", func() {
+				_, actualRequest, _ := versionedClient.UpdateArgsForCall(0)
+				actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+				Expect(actualMessage.UpdateMask).To(BeNil())
+			})
+		})
+
+		When("the update mask contains a field that can't be updated", func() {
+			BeforeEach(func() {
+				updatedPolicyGroup.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"name"}}
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not fetch or update the policy group", func() {
+				Expect(versionedClient.GetCallCount()).To(Equal(0))
+				Expect(versionedClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the name is empty", func() {
+			BeforeEach(func() {
+				updatedPolicyGroup.Name = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not fetch the policy group", func() {
+				Expect(versionedClient.GetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the policy group doesn't exist", func() {
+			BeforeEach(func() {
+				getPolicyGroupResponse = &versioned.Document{}
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			})
+		})
+
 		When("an error occurs fetching the policy group", func() {
 			BeforeEach(func() {
 				getPolicyGroupError = errors.New("get error")
@@ -577,7 +780,51 @@ var _ = Describe("PolicyGroupManager", func() {
 			})
 
 			It("should not try to update the policy group", func() {
-				Expect(esClient.UpdateCallCount()).To(Equal(0))
+				Expect(versionedClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the severity threshold is invalid", func() {
+			BeforeEach(func() {
+				updatedPolicyGroup.SeverityThreshold = pb.ViolationSeverity(fake.Number(5, 100))
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+			})
+
+			It("should not update the policy group", func() {
+				Expect(versionedClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("attestations are enabled without a signing key", func() {
+			BeforeEach(func() {
+				updatedPolicyGroup.Attest = true
+				evalConfig.SigningKeyFile = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualPolicyGroup).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+			})
+
+			It("should not update the policy group", func() {
+				Expect(versionedClient.UpdateCallCount()).To(Equal(0))
+			})
+
+			When("the update mask doesn't include attest", func() {
+				BeforeEach(func() {
+					updatedPolicyGroup.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"description"}}
+				})
+
+				It("should update the policy group", func() {
+					Expect(actualError).NotTo(HaveOccurred())
+					Expect(versionedClient.UpdateCallCount()).To(Equal(1))
+				})
 			})
 		})
 
@@ -591,6 +838,45 @@ var _ = Describe("PolicyGroupManager", func() {
 				Expect(actualError).To(HaveOccurred())
 				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
 			})
+
+			It("should not publish an event", func() {
+				Expect(publisher.PublishCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the policy group is modified concurrently", func() {
+			BeforeEach(func() {
+				conflictingUpdates = 1
+			})
+
+			It("should read and update the policy group again", func() {
+				Expect(versionedClient.GetCallCount()).To(Equal(2))
+				Expect(versionedClient.UpdateCallCount()).To(Equal(2))
+			})
+
+			It("should return the updated policy group", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualPolicyGroup.Description).To(Equal(updatedPolicyGroup.Description))
+			})
+
+			When("every attempt conflicts", func() {
+				BeforeEach(func() {
+					conflictingUpdates = maxPolicyGroupUpdateAttempts
+				})
+
+				It("should stop retrying", func() {
+					Expect(versionedClient.UpdateCallCount()).To(Equal(maxPolicyGroupUpdateAttempts))
+				})
+
+				It("should return an error", func() {
+					Expect(actualPolicyGroup).To(BeNil())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Aborted))
+				})
+
+				It("should not publish an event", func() {
+					Expect(publisher.PublishCallCount()).To(Equal(0))
+				})
+			})
 		})
 
 		When("the policy group has been deleted", func() {
@@ -606,7 +892,7 @@ var _ = Describe("PolicyGroupManager", func() {
 			})
 
 			It("should not allow the update", func() {
-				Expect(esClient.UpdateCallCount()).To(Equal(0))
+				Expect(versionedClient.UpdateCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -665,6 +951,15 @@ var _ = Describe("PolicyGroupManager", func() {
 			Expect(actualRequest.Message).To(Equal(existingPolicyGroup))
 		})
 
+		It("should publish an event", func() {
+			Expect(publisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := publisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.PolicyGroupDeleted))
+			Expect(subject).To(Equal(policyGroupName))
+			Expect(data.(*pb.PolicyGroup).Deleted).To(BeTrue())
+		})
+
 		It("should not return an error", func() {
 			Expect(actualError).NotTo(HaveOccurred())
 		})
@@ -695,6 +990,367 @@ var _ = Describe("PolicyGroupManager", func() {
 			})
 		})
 	})
+
+	Context("policy group hierarchy", func() {
+		var (
+			policyGroups map[string]*pb.PolicyGroup
+			getError     error
+		)
+
+		BeforeEach(func() {
+			policyGroups = map[string]*pb.PolicyGroup{
+				"base":    randomPolicyGroup("base"),
+				"staging": randomPolicyGroup("staging"),
+				"prod":    randomPolicyGroup("prod"),
+			}
+			policyGroups["staging"].Parents = []string{"base"}
+			policyGroups["prod"].Parents = []string{"staging", "base"}
+			getError = nil
+
+			esClient.GetStub = func(_ context.Context, request *esutil.GetRequest) (*esutil.EsGetResponse, error) {
+				if getError != nil {
+					return nil, getError
+				}
+
+				policyGroup, ok := policyGroups[request.DocumentId]
+				if !ok {
+					return &esutil.EsGetResponse{Id: request.DocumentId, Found: false}, nil
+				}
+
+				policyGroupJson, _ := protojson.Marshal(policyGroup)
+
+				return &esutil.EsGetResponse{Id: request.DocumentId, Found: true, Source: policyGroupJson}, nil
+			}
+			versionedClient.GetStub = func(_ context.Context, request *esutil.GetRequest) (*versioned.Document, error) {
+				policyGroup, ok := policyGroups[request.DocumentId]
+				if !ok {
+					return &versioned.Document{}, nil
+				}
+
+				policyGroupJson, _ := protojson.Marshal(policyGroup)
+
+				return &versioned.Document{Found: true, Source: policyGroupJson}, nil
+			}
+			versionedClient.UpdateStub = func(_ context.Context, request *esutil.UpdateRequest, _ versioned.DocumentVersion) (*versioned.DocumentVersion, error) {
+				policyGroups[request.DocumentId] = request.Message.(*pb.PolicyGroup)
+
+				return &versioned.DocumentVersion{SeqNo: versionedClient.UpdateCallCount()}, nil
+			}
+		})
+
+		Context("CreatePolicyGroup", func() {
+			var (
+				policyGroup *pb.PolicyGroup
+				actualError error
+			)
+
+			BeforeEach(func() {
+				policyGroup = randomPolicyGroup("dev")
+				policyGroup.Parents = []string{"base"}
+			})
+
+			JustBeforeEach(func() {
+				_, actualError = manager.CreatePolicyGroup(ctx, deepCopyPolicyGroup(policyGroup))
+			})
+
+			It("should create the policy group with its parents", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(esClient.CreateCallCount()).To(Equal(1))
+
+				_, actualRequest := esClient.CreateArgsForCall(0)
+				actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+				Expect(actualMessage.Parents).To(ConsistOf("base"))
+			})
+
+			When("a parent doesn't exist", func() {
+				BeforeEach(func() {
+					policyGroup.Parents = []string{fake.Word()}
+				})
+
+				It("should return an error", func() {
+					Expect(actualError).To(HaveOccurred())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+				})
+
+				It("should not create the policy group", func() {
+					Expect(esClient.CreateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a parent has been deleted", func() {
+				BeforeEach(func() {
+					policyGroups["base"].Deleted = true
+				})
+
+				It("should return an error", func() {
+					Expect(actualError).To(HaveOccurred())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+				})
+			})
+
+			When("a parent is listed more than once", func() {
+				BeforeEach(func() {
+					policyGroup.Parents = []string{"base", "staging", "base"}
+				})
+
+				It("should return an error", func() {
+					Expect(actualError).To(HaveOccurred())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				})
+			})
+
+			When("the policy group is its own parent", func() {
+				BeforeEach(func() {
+					policyGroup.Parents = []string{"dev"}
+				})
+
+				It("should return an error", func() {
+					Expect(actualError).To(HaveOccurred())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				})
+
+				It("should not create the policy group", func() {
+					Expect(esClient.CreateCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("UpdatePolicyGroup", func() {
+			var (
+				policyGroup *pb.PolicyGroup
+				actualError error
+			)
+
+			BeforeEach(func() {
+				policyGroup = deepCopyPolicyGroup(policyGroups["staging"])
+				policyGroup.Parents = nil
+			})
+
+			JustBeforeEach(func() {
+				_, actualError = manager.UpdatePolicyGroup(ctx, policyGroup)
+			})
+
+			It("should update the parents", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(versionedClient.UpdateCallCount()).To(Equal(1))
+
+				_, actualRequest, _ := versionedClient.UpdateArgsForCall(0)
+				actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+				Expect(actualMessage.Parents).To(BeEmpty())
+			})
+
+			When("the new parents would create a cycle", func() {
+				BeforeEach(func() {
+					policyGroup = deepCopyPolicyGroup(policyGroups["base"])
+					policyGroup.Parents = []string{"prod"}
+				})
+
+				It("should return an error describing the cycle", func() {
+					Expect(actualError).To(HaveOccurred())
+
+					actualStatus := getGRPCStatusFromError(actualError)
+					Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+					Expect(actualStatus.Message()).To(ContainSubstring("base -> prod -> staging -> base"))
+				})
+
+				It("should not update the policy group", func() {
+					Expect(versionedClient.UpdateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a concurrent update creates a cycle after the parents are checked", func() {
+				BeforeEach(func() {
+					policyGroups["dev"] = randomPolicyGroup("dev")
+					policyGroup = deepCopyPolicyGroup(policyGroups["base"])
+					policyGroup.Parents = []string{"dev"}
+
+					updateStub := versionedClient.UpdateStub
+					versionedClient.UpdateStub = func(ctx context.Context, request *esutil.UpdateRequest, version versioned.DocumentVersion) (*versioned.DocumentVersion, error) {
+						policyGroups["dev"].Parents = []string{"prod"}
+
+						return updateStub(ctx, request, version)
+					}
+				})
+
+				It("should return an error describing the cycle", func() {
+					actualStatus := getGRPCStatusFromError(actualError)
+					Expect(actualStatus.Code()).To(Equal(codes.InvalidArgument))
+					Expect(actualStatus.Message()).To(ContainSubstring("base -> dev -> prod -> staging -> base"))
+				})
+
+				It("should restore the previous parents", func() {
+					Expect(versionedClient.UpdateCallCount()).To(Equal(2))
+
+					_, actualRequest, actualVersion := versionedClient.UpdateArgsForCall(1)
+					actualMessage := actualRequest.Message.(*pb.PolicyGroup)
+
+					Expect(actualMessage.Parents).To(BeEmpty())
+					Expect(actualVersion).To(Equal(versioned.DocumentVersion{SeqNo: 1}))
+				})
+
+				It("should not publish an event", func() {
+					Expect(publisher.PublishCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("ResolvePolicyGroupAssignments", func() {
+			var (
+				policyIds                []string
+				assignments              []*pb.PolicyAssignment
+				searchError              error
+				expectedAssignmentsAlias string
+				policyGroupName          string
+
+				actualResponse *pb.ResolvePolicyGroupAssignmentsResponse
+				actualError    error
+			)
+
+			newAssignment := func(policyId, policyGroup string, version int) *pb.PolicyAssignment {
+				return &pb.PolicyAssignment{
+					Id:              policyAssignmentId(policyId, policyGroup),
+					PolicyVersionId: fmt.Sprintf("%s.%d", policyId, version),
+					PolicyGroup:     policyGroup,
+				}
+			}
+
+			BeforeEach(func() {
+				policyGroupName = "prod"
+				policyIds = []string{fake.UUID(), fake.UUID(), fake.UUID()}
+				assignments = []*pb.PolicyAssignment{
+					newAssignment(policyIds[0], "base", 1),
+					newAssignment(policyIds[1], "staging", 1),
+					newAssignment(policyIds[0], "prod", 2),
+					newAssignment(policyIds[2], "base", 1),
+				}
+				expectedAssignmentsAlias = fake.LetterN(10)
+				indexManager.AliasNameStub = func(documentKind, _ string) string {
+					if documentKind == constants.PolicyAssignmentsDocumentKind {
+						return expectedAssignmentsAlias
+					}
+
+					return expectedPolicyGroupsAlias
+				}
+				searchError = nil
+			})
+
+			JustBeforeEach(func() {
+				searchResponse := &esutil.SearchResponse{
+					Hits: &esutil.EsSearchResponseHits{},
+				}
+				for _, assignment := range assignments {
+					assignmentJson, _ := protojson.Marshal(assignment)
+					searchResponse.Hits.Hits = append(searchResponse.Hits.Hits, &esutil.EsSearchResponseHit{
+						Source: assignmentJson,
+					})
+				}
+				esClient.SearchReturns(searchResponse, searchError)
+
+				actualResponse, actualError = manager.ResolvePolicyGroupAssignments(ctx, &pb.ResolvePolicyGroupAssignmentsRequest{Name: policyGroupName})
+			})
+
+			It("should return the policy group and its ancestors, closest first", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualResponse.PolicyGroups).To(Equal([]string{"prod", "staging", "base"}))
+			})
+
+			It("should search for the assignments of every policy group in the hierarchy", func() {
+				Expect(esClient.SearchCallCount()).To(Equal(1))
+
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(actualRequest.Index).To(Equal(expectedAssignmentsAlias))
+				Expect(actualRequest.Pagination).To(BeNil())
+				Expect(*actualRequest.Search.Query.Bool.Should).To(ConsistOf(
+					&filtering.Query{Term: &filtering.Term{"policyGroup": "prod"}},
+					&filtering.Query{Term: &filtering.Term{"policyGroup": "staging"}},
+					&filtering.Query{Term: &filtering.Term{"policyGroup": "base"}},
+				))
+			})
+
+			It("should prefer the assignment closest to the policy group", func() {
+				Expect(actualResponse.PolicyAssignments).To(HaveLen(3))
+				Expect(actualResponse.PolicyAssignments[0].Id).To(Equal(assignments[2].Id))
+				Expect(actualResponse.PolicyAssignments[1].Id).To(Equal(assignments[1].Id))
+				Expect(actualResponse.PolicyAssignments[2].Id).To(Equal(assignments[3].Id))
+			})
+
+			It("should return the overridden assignments", func() {
+				Expect(actualResponse.OverriddenPolicyAssignments).To(HaveLen(1))
+				Expect(actualResponse.OverriddenPolicyAssignments[0].Id).To(Equal(assignments[0].Id))
+			})
+
+			When("a parent is closer than the ancestors of an earlier parent", func() {
+				BeforeEach(func() {
+					policyGroups["qa"] = randomPolicyGroup("qa")
+					policyGroups["prod"].Parents = []string{"staging", "qa"}
+					assignments = []*pb.PolicyAssignment{
+						newAssignment(policyIds[0], "base", 1),
+						newAssignment(policyIds[0], "qa", 2),
+					}
+				})
+
+				It("should order the hierarchy by distance from the policy group", func() {
+					Expect(actualResponse.PolicyGroups).To(Equal([]string{"prod", "staging", "qa", "base"}))
+				})
+
+				It("should prefer the assignment of the closer parent", func() {
+					Expect(actualResponse.PolicyAssignments).To(HaveLen(1))
+					Expect(actualResponse.PolicyAssignments[0].Id).To(Equal(assignments[1].Id))
+					Expect(actualResponse.OverriddenPolicyAssignments).To(HaveLen(1))
+					Expect(actualResponse.OverriddenPolicyAssignments[0].Id).To(Equal(assignments[0].Id))
+				})
+			})
+
+			When("an ancestor has been deleted", func() {
+				BeforeEach(func() {
+					policyGroups["staging"].Deleted = true
+				})
+
+				It("should leave it out of the hierarchy", func() {
+					Expect(actualResponse.PolicyGroups).To(Equal([]string{"prod", "base"}))
+				})
+			})
+
+			When("the policy group doesn't have any parents", func() {
+				BeforeEach(func() {
+					policyGroupName = "base"
+				})
+
+				It("should only include the policy group", func() {
+					Expect(actualResponse.PolicyGroups).To(Equal([]string{"base"}))
+				})
+			})
+
+			When("the policy group doesn't exist", func() {
+				BeforeEach(func() {
+					policyGroupName = fake.Word()
+				})
+
+				It("should return an error", func() {
+					Expect(actualResponse).To(BeNil())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				})
+
+				It("should not search for assignments", func() {
+					Expect(esClient.SearchCallCount()).To(Equal(0))
+				})
+			})
+
+			When("an error occurs searching for assignments", func() {
+				BeforeEach(func() {
+					searchError = errors.New("search error")
+				})
+
+				It("should return an error", func() {
+					Expect(actualResponse).To(BeNil())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+				})
+			})
+		})
+	})
 })
 
 func randomPolicyGroup(name string) *pb.PolicyGroup {
@@ -709,7 +1365,12 @@ func randomPolicyGroup(name string) *pb.PolicyGroup {
 
 func deepCopyPolicyGroup(group *pb.PolicyGroup) *pb.PolicyGroup {
 	return &pb.PolicyGroup{
-		Name:        group.Name,
-		Description: group.Description,
+		Name:              group.Name,
+		Description:       group.Description,
+		Attest:            group.Attest,
+		SeverityThreshold: group.SeverityThreshold,
+		Parents:           group.Parents,
+		AutoEvaluate:      group.AutoEvaluate,
+		UpdateMask:        group.UpdateMask,
 	}
 }
@@ -67,6 +67,11 @@ type FakeAssignmentManager struct {
 		result1 *v1alpha1.ListPolicyAssignmentsResponse
 		result2 error
 	}
+	RegisterChangeHandlerStub        func(policy.AssignmentChangeHandler)
+	registerChangeHandlerMutex       sync.RWMutex
+	registerChangeHandlerArgsForCall []struct {
+		arg1 policy.AssignmentChangeHandler
+	}
 	UpdatePolicyAssignmentStub        func(context.Context, *v1alpha1.PolicyAssignment) (*v1alpha1.PolicyAssignment, error)
 	updatePolicyAssignmentMutex       sync.RWMutex
 	updatePolicyAssignmentArgsForCall []struct {
@@ -345,6 +350,38 @@ func (fake *FakeAssignmentManager) ListPolicyAssignmentsReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeAssignmentManager) RegisterChangeHandler(arg1 policy.AssignmentChangeHandler) {
+	fake.registerChangeHandlerMutex.Lock()
+	fake.registerChangeHandlerArgsForCall = append(fake.registerChangeHandlerArgsForCall, struct {
+		arg1 policy.AssignmentChangeHandler
+	}{arg1})
+	stub := fake.RegisterChangeHandlerStub
+	fake.recordInvocation("RegisterChangeHandler", []interface{}{arg1})
+	fake.registerChangeHandlerMutex.Unlock()
+	if stub != nil {
+		fake.RegisterChangeHandlerStub(arg1)
+	}
+}
+
+func (fake *FakeAssignmentManager) RegisterChangeHandlerCallCount() int {
+	fake.registerChangeHandlerMutex.RLock()
+	defer fake.registerChangeHandlerMutex.RUnlock()
+	return len(fake.registerChangeHandlerArgsForCall)
+}
+
+func (fake *FakeAssignmentManager) RegisterChangeHandlerCalls(stub func(policy.AssignmentChangeHandler)) {
+	fake.registerChangeHandlerMutex.Lock()
+	defer fake.registerChangeHandlerMutex.Unlock()
+	fake.RegisterChangeHandlerStub = stub
+}
+
+func (fake *FakeAssignmentManager) RegisterChangeHandlerArgsForCall(i int) policy.AssignmentChangeHandler {
+	fake.registerChangeHandlerMutex.RLock()
+	defer fake.registerChangeHandlerMutex.RUnlock()
+	argsForCall := fake.registerChangeHandlerArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeAssignmentManager) UpdatePolicyAssignment(arg1 context.Context, arg2 *v1alpha1.PolicyAssignment) (*v1alpha1.PolicyAssignment, error) {
 	fake.updatePolicyAssignmentMutex.Lock()
 	ret, specificReturn := fake.updatePolicyAssignmentReturnsOnCall[len(fake.updatePolicyAssignmentArgsForCall)]
@@ -421,6 +458,8 @@ func (fake *FakeAssignmentManager) Invocations() map[string][][]interface{} {
 	defer fake.getPolicyAssignmentMutex.RUnlock()
 	fake.listPolicyAssignmentsMutex.RLock()
 	defer fake.listPolicyAssignmentsMutex.RUnlock()
+	fake.registerChangeHandlerMutex.RLock()
+	defer fake.registerChangeHandlerMutex.RUnlock()
 	fake.updatePolicyAssignmentMutex.RLock()
 	defer fake.updatePolicyAssignmentMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -67,6 +67,20 @@ type FakePolicyGroupManager struct {
 		result1 *v1alpha1.ListPolicyGroupsResponse
 		result2 error
 	}
+	ResolvePolicyGroupAssignmentsStub        func(context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error)
+	resolvePolicyGroupAssignmentsMutex       sync.RWMutex
+	resolvePolicyGroupAssignmentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest
+	}
+	resolvePolicyGroupAssignmentsReturns struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}
+	resolvePolicyGroupAssignmentsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}
 	UpdatePolicyGroupStub        func(context.Context, *v1alpha1.PolicyGroup) (*v1alpha1.PolicyGroup, error)
 	updatePolicyGroupMutex       sync.RWMutex
 	updatePolicyGroupArgsForCall []struct {
@@ -345,6 +359,71 @@ func (fake *FakePolicyGroupManager) ListPolicyGroupsReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignments(arg1 context.Context, arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	ret, specificReturn := fake.resolvePolicyGroupAssignmentsReturnsOnCall[len(fake.resolvePolicyGroupAssignmentsArgsForCall)]
+	fake.resolvePolicyGroupAssignmentsArgsForCall = append(fake.resolvePolicyGroupAssignmentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest
+	}{arg1, arg2})
+	stub := fake.ResolvePolicyGroupAssignmentsStub
+	fakeReturns := fake.resolvePolicyGroupAssignmentsReturns
+	fake.recordInvocation("ResolvePolicyGroupAssignments", []interface{}{arg1, arg2})
+	fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignmentsCallCount() int {
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
+	return len(fake.resolvePolicyGroupAssignmentsArgsForCall)
+}
+
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignmentsCalls(stub func(context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error)) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = stub
+}
+
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignmentsArgsForCall(i int) (context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest) {
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
+	argsForCall := fake.resolvePolicyGroupAssignmentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignmentsReturns(result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse, result2 error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = nil
+	fake.resolvePolicyGroupAssignmentsReturns = struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePolicyGroupManager) ResolvePolicyGroupAssignmentsReturnsOnCall(i int, result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse, result2 error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = nil
+	if fake.resolvePolicyGroupAssignmentsReturnsOnCall == nil {
+		fake.resolvePolicyGroupAssignmentsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+			result2 error
+		})
+	}
+	fake.resolvePolicyGroupAssignmentsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePolicyGroupManager) UpdatePolicyGroup(arg1 context.Context, arg2 *v1alpha1.PolicyGroup) (*v1alpha1.PolicyGroup, error) {
 	fake.updatePolicyGroupMutex.Lock()
 	ret, specificReturn := fake.updatePolicyGroupReturnsOnCall[len(fake.updatePolicyGroupArgsForCall)]
@@ -421,6 +500,8 @@ func (fake *FakePolicyGroupManager) Invocations() map[string][][]interface{} {
 	defer fake.getPolicyGroupMutex.RUnlock()
 	fake.listPolicyGroupsMutex.RLock()
 	defer fake.listPolicyGroupsMutex.RUnlock()
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
 	fake.updatePolicyGroupMutex.RLock()
 	defer fake.updatePolicyGroupMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,445 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package policyfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rode/rode/pkg/policy"
+	"github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FakeWaiverManager struct {
+	CreateWaiverStub        func(context.Context, *v1alpha1.Waiver) (*v1alpha1.Waiver, error)
+	createWaiverMutex       sync.RWMutex
+	createWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+	}
+	createWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	createWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	DeleteWaiverStub        func(context.Context, *v1alpha1.DeleteWaiverRequest) (*emptypb.Empty, error)
+	deleteWaiverMutex       sync.RWMutex
+	deleteWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWaiverRequest
+	}
+	deleteWaiverReturns struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	deleteWaiverReturnsOnCall map[int]struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	GetWaiverStub        func(context.Context, *v1alpha1.GetWaiverRequest) (*v1alpha1.Waiver, error)
+	getWaiverMutex       sync.RWMutex
+	getWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWaiverRequest
+	}
+	getWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	getWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	ListWaiversStub        func(context.Context, *v1alpha1.ListWaiversRequest) (*v1alpha1.ListWaiversResponse, error)
+	listWaiversMutex       sync.RWMutex
+	listWaiversArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWaiversRequest
+	}
+	listWaiversReturns struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}
+	listWaiversReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}
+	UpdateWaiverStub        func(context.Context, *v1alpha1.Waiver) (*v1alpha1.Waiver, error)
+	updateWaiverMutex       sync.RWMutex
+	updateWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+	}
+	updateWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	updateWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWaiverManager) CreateWaiver(arg1 context.Context, arg2 *v1alpha1.Waiver) (*v1alpha1.Waiver, error) {
+	fake.createWaiverMutex.Lock()
+	ret, specificReturn := fake.createWaiverReturnsOnCall[len(fake.createWaiverArgsForCall)]
+	fake.createWaiverArgsForCall = append(fake.createWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+	}{arg1, arg2})
+	stub := fake.CreateWaiverStub
+	fakeReturns := fake.createWaiverReturns
+	fake.recordInvocation("CreateWaiver", []interface{}{arg1, arg2})
+	fake.createWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWaiverManager) CreateWaiverCallCount() int {
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	return len(fake.createWaiverArgsForCall)
+}
+
+func (fake *FakeWaiverManager) CreateWaiverCalls(stub func(context.Context, *v1alpha1.Waiver) (*v1alpha1.Waiver, error)) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = stub
+}
+
+func (fake *FakeWaiverManager) CreateWaiverArgsForCall(i int) (context.Context, *v1alpha1.Waiver) {
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	argsForCall := fake.createWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWaiverManager) CreateWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = nil
+	fake.createWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) CreateWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = nil
+	if fake.createWaiverReturnsOnCall == nil {
+		fake.createWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.createWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) DeleteWaiver(arg1 context.Context, arg2 *v1alpha1.DeleteWaiverRequest) (*emptypb.Empty, error) {
+	fake.deleteWaiverMutex.Lock()
+	ret, specificReturn := fake.deleteWaiverReturnsOnCall[len(fake.deleteWaiverArgsForCall)]
+	fake.deleteWaiverArgsForCall = append(fake.deleteWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWaiverRequest
+	}{arg1, arg2})
+	stub := fake.DeleteWaiverStub
+	fakeReturns := fake.deleteWaiverReturns
+	fake.recordInvocation("DeleteWaiver", []interface{}{arg1, arg2})
+	fake.deleteWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWaiverManager) DeleteWaiverCallCount() int {
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	return len(fake.deleteWaiverArgsForCall)
+}
+
+func (fake *FakeWaiverManager) DeleteWaiverCalls(stub func(context.Context, *v1alpha1.DeleteWaiverRequest) (*emptypb.Empty, error)) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = stub
+}
+
+func (fake *FakeWaiverManager) DeleteWaiverArgsForCall(i int) (context.Context, *v1alpha1.DeleteWaiverRequest) {
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	argsForCall := fake.deleteWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWaiverManager) DeleteWaiverReturns(result1 *emptypb.Empty, result2 error) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = nil
+	fake.deleteWaiverReturns = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) DeleteWaiverReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = nil
+	if fake.deleteWaiverReturnsOnCall == nil {
+		fake.deleteWaiverReturnsOnCall = make(map[int]struct {
+			result1 *emptypb.Empty
+			result2 error
+		})
+	}
+	fake.deleteWaiverReturnsOnCall[i] = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) GetWaiver(arg1 context.Context, arg2 *v1alpha1.GetWaiverRequest) (*v1alpha1.Waiver, error) {
+	fake.getWaiverMutex.Lock()
+	ret, specificReturn := fake.getWaiverReturnsOnCall[len(fake.getWaiverArgsForCall)]
+	fake.getWaiverArgsForCall = append(fake.getWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWaiverRequest
+	}{arg1, arg2})
+	stub := fake.GetWaiverStub
+	fakeReturns := fake.getWaiverReturns
+	fake.recordInvocation("GetWaiver", []interface{}{arg1, arg2})
+	fake.getWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWaiverManager) GetWaiverCallCount() int {
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	return len(fake.getWaiverArgsForCall)
+}
+
+func (fake *FakeWaiverManager) GetWaiverCalls(stub func(context.Context, *v1alpha1.GetWaiverRequest) (*v1alpha1.Waiver, error)) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = stub
+}
+
+func (fake *FakeWaiverManager) GetWaiverArgsForCall(i int) (context.Context, *v1alpha1.GetWaiverRequest) {
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	argsForCall := fake.getWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWaiverManager) GetWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = nil
+	fake.getWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) GetWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = nil
+	if fake.getWaiverReturnsOnCall == nil {
+		fake.getWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.getWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) ListWaivers(arg1 context.Context, arg2 *v1alpha1.ListWaiversRequest) (*v1alpha1.ListWaiversResponse, error) {
+	fake.listWaiversMutex.Lock()
+	ret, specificReturn := fake.listWaiversReturnsOnCall[len(fake.listWaiversArgsForCall)]
+	fake.listWaiversArgsForCall = append(fake.listWaiversArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWaiversRequest
+	}{arg1, arg2})
+	stub := fake.ListWaiversStub
+	fakeReturns := fake.listWaiversReturns
+	fake.recordInvocation("ListWaivers", []interface{}{arg1, arg2})
+	fake.listWaiversMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWaiverManager) ListWaiversCallCount() int {
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	return len(fake.listWaiversArgsForCall)
+}
+
+func (fake *FakeWaiverManager) ListWaiversCalls(stub func(context.Context, *v1alpha1.ListWaiversRequest) (*v1alpha1.ListWaiversResponse, error)) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = stub
+}
+
+func (fake *FakeWaiverManager) ListWaiversArgsForCall(i int) (context.Context, *v1alpha1.ListWaiversRequest) {
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	argsForCall := fake.listWaiversArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWaiverManager) ListWaiversReturns(result1 *v1alpha1.ListWaiversResponse, result2 error) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = nil
+	fake.listWaiversReturns = struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) ListWaiversReturnsOnCall(i int, result1 *v1alpha1.ListWaiversResponse, result2 error) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = nil
+	if fake.listWaiversReturnsOnCall == nil {
+		fake.listWaiversReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWaiversResponse
+			result2 error
+		})
+	}
+	fake.listWaiversReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) UpdateWaiver(arg1 context.Context, arg2 *v1alpha1.Waiver) (*v1alpha1.Waiver, error) {
+	fake.updateWaiverMutex.Lock()
+	ret, specificReturn := fake.updateWaiverReturnsOnCall[len(fake.updateWaiverArgsForCall)]
+	fake.updateWaiverArgsForCall = append(fake.updateWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+	}{arg1, arg2})
+	stub := fake.UpdateWaiverStub
+	fakeReturns := fake.updateWaiverReturns
+	fake.recordInvocation("UpdateWaiver", []interface{}{arg1, arg2})
+	fake.updateWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWaiverManager) UpdateWaiverCallCount() int {
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	return len(fake.updateWaiverArgsForCall)
+}
+
+func (fake *FakeWaiverManager) UpdateWaiverCalls(stub func(context.Context, *v1alpha1.Waiver) (*v1alpha1.Waiver, error)) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = stub
+}
+
+func (fake *FakeWaiverManager) UpdateWaiverArgsForCall(i int) (context.Context, *v1alpha1.Waiver) {
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	argsForCall := fake.updateWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWaiverManager) UpdateWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = nil
+	fake.updateWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) UpdateWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = nil
+	if fake.updateWaiverReturnsOnCall == nil {
+		fake.updateWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.updateWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWaiverManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWaiverManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ policy.WaiverManager = new(FakeWaiverManager)
@@ -0,0 +1,89 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+// MatchSelector determines whether a resource matches a policy assignment selector. When it doesn't, the returned
+// reason describes the part of the selector that didn't match. A nil selector matches every resource.
+func MatchSelector(selector *pb.PolicyAssignmentSelector, resource *pb.Resource) (bool, string) {
+	if selector == nil {
+		return true, ""
+	}
+
+	if len(selector.ResourceTypes) != 0 {
+		typeMatches := false
+		for _, resourceType := range selector.ResourceTypes {
+			if resourceType == resource.Type {
+				typeMatches = true
+				break
+			}
+		}
+
+		if !typeMatches {
+			return false, fmt.Sprintf("resource type %s is not selected", resource.Type)
+		}
+	}
+
+	if selector.NamePattern != "" {
+		pattern, err := globToRegexp(selector.NamePattern)
+		if err != nil || !pattern.MatchString(resource.Name) {
+			return false, fmt.Sprintf("resource name %s does not match pattern %s", resource.Name, selector.NamePattern)
+		}
+	}
+
+	if selector.NameRegex != "" {
+		pattern, err := regexp.Compile(selector.NameRegex)
+		if err != nil || !pattern.MatchString(resource.Name) {
+			return false, fmt.Sprintf("resource name %s does not match regex %s", resource.Name, selector.NameRegex)
+		}
+	}
+
+	return true, ""
+}
+
+func validateSelector(selector *pb.PolicyAssignmentSelector) error {
+	if selector == nil {
+		return nil
+	}
+
+	for _, resourceType := range selector.ResourceTypes {
+		if _, ok := pb.ResourceType_name[int32(resourceType)]; !ok || resourceType == pb.ResourceType_RESOURCE_TYPE_UNSPECIFIED {
+			return fmt.Errorf("invalid resource type %d", resourceType)
+		}
+	}
+
+	if _, err := regexp.Compile(selector.NameRegex); err != nil {
+		return fmt.Errorf("invalid name regex: %s", err)
+	}
+
+	return nil
+}
+
+// globToRegexp converts a glob to an anchored regular expression, where "*" matches any sequence of characters and "?"
+// matches a single character
+func globToRegexp(glob string) (*regexp.Regexp, error) {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+
+	return regexp.Compile("^" + pattern + "$")
+}
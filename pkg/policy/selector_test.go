@@ -0,0 +1,95 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+var _ = Describe("selectors", func() {
+	resource := &pb.Resource{
+		Id:   "harbor.localhost/rode-demo/node-app",
+		Name: "harbor.localhost/rode-demo/node-app",
+		Type: pb.ResourceType_DOCKER,
+	}
+
+	DescribeTable("MatchSelector", func(selector *pb.PolicyAssignmentSelector, expected bool) {
+		actual, reason := MatchSelector(selector, resource)
+
+		Expect(actual).To(Equal(expected))
+		if expected {
+			Expect(reason).To(BeEmpty())
+		} else {
+			Expect(reason).NotTo(BeEmpty())
+		}
+	},
+		Entry("no selector", nil, true),
+		Entry("empty selector", &pb.PolicyAssignmentSelector{}, true),
+		Entry("matching resource type", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType_MAVEN, pb.ResourceType_DOCKER},
+		}, true),
+		Entry("different resource type", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType_MAVEN},
+		}, false),
+		Entry("matching name pattern", &pb.PolicyAssignmentSelector{
+			NamePattern: "harbor.localhost/*",
+		}, true),
+		Entry("name pattern with a single character wildcard", &pb.PolicyAssignmentSelector{
+			NamePattern: "*/node-ap?",
+		}, true),
+		Entry("name pattern that only matches part of the name", &pb.PolicyAssignmentSelector{
+			NamePattern: "rode-demo",
+		}, false),
+		Entry("matching name regex", &pb.PolicyAssignmentSelector{
+			NameRegex: "rode-demo/.+-app$",
+		}, true),
+		Entry("different name regex", &pb.PolicyAssignmentSelector{
+			NameRegex: "^gcr.io/",
+		}, false),
+		Entry("matching type and different name", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType_DOCKER},
+			NamePattern:   "*/api",
+		}, false),
+	)
+
+	DescribeTable("validateSelector", func(selector *pb.PolicyAssignmentSelector, expectError bool) {
+		err := validateSelector(selector)
+
+		if expectError {
+			Expect(err).To(HaveOccurred())
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("no selector", nil, false),
+		Entry("valid selector", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType_NPM},
+			NamePattern:   "@rode/*",
+			NameRegex:     "^@rode/",
+		}, false),
+		Entry("unspecified resource type", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType_RESOURCE_TYPE_UNSPECIFIED},
+		}, true),
+		Entry("unknown resource type", &pb.PolicyAssignmentSelector{
+			ResourceTypes: []pb.ResourceType{pb.ResourceType(100)},
+		}, true),
+		Entry("invalid regex", &pb.PolicyAssignmentSelector{
+			NameRegex: "[",
+		}, true),
+	)
+})
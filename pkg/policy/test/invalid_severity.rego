@@ -0,0 +1,16 @@
+package severities
+
+pass {
+    true
+}
+
+violations[result] {
+	result = {
+		"pass": true,
+		"id": "invalid_severity",
+		"name": "name",
+		"description": "description",
+		"message": "message",
+		"severity": "SEVERE",
+	}
+}
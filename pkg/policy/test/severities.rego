@@ -0,0 +1,28 @@
+package severities
+
+pass {
+    true
+}
+
+violations[result] {
+	result = {
+		"pass": true,
+		"id": "literal_severity",
+		"name": "name",
+		"description": "description",
+		"message": "message",
+		"severity": "HIGH",
+	}
+}
+
+violations[result] {
+	severity := input.severity
+	result = {
+		"pass": true,
+		"id": "computed_severity",
+		"name": "name",
+		"description": "description",
+		"message": "message",
+		"severity": severity,
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"time"
+
+	"github.com/rode/es-index-manager/indexmanager"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/resource"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//counterfeiter:generate . WaiverManager
+type WaiverManager interface {
+	CreateWaiver(context.Context, *pb.Waiver) (*pb.Waiver, error)
+	GetWaiver(context.Context, *pb.GetWaiverRequest) (*pb.Waiver, error)
+	UpdateWaiver(context.Context, *pb.Waiver) (*pb.Waiver, error)
+	DeleteWaiver(context.Context, *pb.DeleteWaiverRequest) (*emptypb.Empty, error)
+	ListWaivers(context.Context, *pb.ListWaiversRequest) (*pb.ListWaiversResponse, error)
+}
+
+type waiverManager struct {
+	logger       *zap.Logger
+	esClient     esutil.Client
+	esConfig     *config.ElasticsearchConfig
+	indexManager indexmanager.IndexManager
+	filterer     filtering.Filterer
+}
+
+func NewWaiverManager(
+	logger *zap.Logger,
+	esClient esutil.Client,
+	esConfig *config.ElasticsearchConfig,
+	indexManager indexmanager.IndexManager,
+	filterer filtering.Filterer,
+) WaiverManager {
+	return &waiverManager{
+		logger,
+		esClient,
+		esConfig,
+		indexManager,
+		filterer,
+	}
+}
+
+func (m *waiverManager) CreateWaiver(ctx context.Context, waiver *pb.Waiver) (*pb.Waiver, error) {
+	log := m.logger.Named("CreateWaiver").
+		With(zap.String("policyId", waiver.PolicyId)).
+		With(zap.String("resourceId", waiver.ResourceId)).
+		With(zap.String("resourceUri", waiver.ResourceUri))
+	log.Debug("received request")
+
+	if err := m.validateWaiver(ctx, log, waiver); err != nil {
+		return nil, err
+	}
+
+	currentTime := timestamppb.Now()
+	waiver.Id = newUuid().String()
+	waiver.Created = currentTime
+	waiver.Updated = currentTime
+
+	if _, err := m.esClient.Create(ctx, &esutil.CreateRequest{
+		Index:      m.waiversAlias(),
+		Refresh:    m.esConfig.Refresh.String(),
+		Message:    waiver,
+		DocumentId: waiver.Id,
+	}); err != nil {
+		return nil, createError(log, "error creating waiver", err)
+	}
+
+	return waiver, nil
+}
+
+func (m *waiverManager) GetWaiver(ctx context.Context, request *pb.GetWaiverRequest) (*pb.Waiver, error) {
+	log := m.logger.Named("GetWaiver").With(zap.String("id", request.Id))
+	log.Debug("received request")
+
+	if request.Id == "" {
+		return nil, createErrorWithCode(log, "waiver id is required", nil, codes.InvalidArgument)
+	}
+
+	response, err := m.esClient.Get(ctx, &esutil.GetRequest{
+		Index:      m.waiversAlias(),
+		DocumentId: request.Id,
+	})
+	if err != nil {
+		return nil, createError(log, "error retrieving waiver", err)
+	}
+
+	if !response.Found {
+		return nil, createErrorWithCode(log, "waiver not found", nil, codes.NotFound)
+	}
+
+	var waiver pb.Waiver
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(response.Source, &waiver); err != nil {
+		return nil, createError(log, "error unmarshalling waiver", err)
+	}
+
+	return &waiver, nil
+}
+
+func (m *waiverManager) UpdateWaiver(ctx context.Context, waiver *pb.Waiver) (*pb.Waiver, error) {
+	log := m.logger.Named("UpdateWaiver").With(zap.String("id", waiver.Id))
+	log.Debug("received request")
+
+	currentWaiver, err := m.GetWaiver(ctx, &pb.GetWaiverRequest{Id: waiver.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if currentWaiver.PolicyId != waiver.PolicyId || currentWaiver.ResourceId != waiver.ResourceId || currentWaiver.ResourceUri != waiver.ResourceUri {
+		return nil, createErrorWithCode(log, "the policy and resource of a waiver cannot be changed", nil, codes.InvalidArgument)
+	}
+
+	if err := m.validateWaiver(ctx, log, waiver); err != nil {
+		return nil, err
+	}
+
+	waiver.Created = currentWaiver.Created // prevent updates from overwriting the original timestamp
+	waiver.Updated = timestamppb.Now()
+
+	if _, err := m.esClient.Update(ctx, &esutil.UpdateRequest{
+		Index:      m.waiversAlias(),
+		DocumentId: waiver.Id,
+		Refresh:    m.esConfig.Refresh.String(),
+		Message:    waiver,
+	}); err != nil {
+		return nil, createError(log, "error updating waiver", err)
+	}
+
+	return waiver, nil
+}
+
+func (m *waiverManager) DeleteWaiver(ctx context.Context, request *pb.DeleteWaiverRequest) (*emptypb.Empty, error) {
+	log := m.logger.Named("DeleteWaiver").With(zap.String("id", request.Id))
+	log.Debug("received request")
+
+	if _, err := m.GetWaiver(ctx, &pb.GetWaiverRequest{Id: request.Id}); err != nil {
+		return nil, err
+	}
+
+	if err := m.esClient.Delete(ctx, &esutil.DeleteRequest{
+		Index: m.waiversAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Term: &filtering.Term{
+					"_id": request.Id,
+				},
+			},
+		},
+		Refresh: m.esConfig.Refresh.String(),
+	}); err != nil {
+		return nil, createError(log, "error deleting waiver", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListWaivers searches for waivers. Unless the request includes expired waivers, only waivers that are currently
+// in effect are returned. When a resource uri is specified, waivers for the version and for its resource are both returned.
+func (m *waiverManager) ListWaivers(ctx context.Context, request *pb.ListWaiversRequest) (*pb.ListWaiversResponse, error) {
+	log := m.logger.Named("ListWaivers")
+	log.Debug("received request", zap.Any("request", request))
+
+	queries := filtering.Must{}
+
+	if request.PolicyId != "" {
+		queries = append(queries, &filtering.Query{
+			Term: &filtering.Term{
+				"policyId": request.PolicyId,
+			},
+		})
+	}
+
+	if request.ResourceUri != "" {
+		resourceQueries := filtering.Should{
+			&filtering.Query{
+				Term: &filtering.Term{
+					"resourceUri": request.ResourceUri,
+				},
+			},
+		}
+
+		if resourceId, err := resource.ResourceId(request.ResourceUri); err == nil {
+			resourceQueries = append(resourceQueries, &filtering.Query{
+				Term: &filtering.Term{
+					"resourceId": resourceId,
+				},
+			})
+		}
+
+		queries = append(queries, &filtering.Query{
+			Bool: &filtering.Bool{
+				Should: &resourceQueries,
+			},
+		})
+	}
+
+	if !request.IncludeExpired {
+		queries = append(queries, &filtering.Query{
+			Range: &filtering.Range{
+				"expiration": &filtering.RangeOperator{
+					Greater: "now",
+				},
+			},
+		})
+	}
+
+	if request.Filter != "" {
+		filterQuery, err := m.filterer.ParseExpression(request.Filter)
+		if err != nil {
+			return nil, createError(log, "error creating filter query", err)
+		}
+
+		queries = append(queries, filterQuery)
+	}
+
+	searchRequest := &esutil.SearchRequest{
+		Index: m.waiversAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Bool: &filtering.Bool{
+					Must: &queries,
+				},
+			},
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+		},
+	}
+
+	if request.PageSize != 0 {
+		searchRequest.Pagination = &esutil.SearchPaginationOptions{
+			Size:  int(request.PageSize),
+			Token: request.PageToken,
+		}
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, searchRequest)
+	if err != nil {
+		return nil, createError(log, "error searching for waivers", err)
+	}
+
+	response := &pb.ListWaiversResponse{
+		NextPageToken: searchResponse.NextPageToken,
+	}
+	for _, hit := range searchResponse.Hits.Hits {
+		var waiver pb.Waiver
+		if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(hit.Source, &waiver); err != nil {
+			return nil, createError(log, "error unmarshalling waiver", err)
+		}
+
+		response.Waivers = append(response.Waivers, &waiver)
+	}
+
+	return response, nil
+}
+
+func (m *waiverManager) waiversAlias() string {
+	return m.indexManager.AliasName(constants.WaiversDocumentKind, "")
+}
+
+// validateWaiver checks the scope, justification, and expiration of a waiver, and that the waived policy exists
+func (m *waiverManager) validateWaiver(ctx context.Context, log *zap.Logger, waiver *pb.Waiver) error {
+	if (waiver.ResourceId == "") == (waiver.ResourceUri == "") {
+		return createErrorWithCode(log, "exactly one of resource id or resource uri is required", nil, codes.InvalidArgument)
+	}
+
+	if waiver.ResourceUri != "" {
+		if _, err := resource.ResourceId(waiver.ResourceUri); err != nil {
+			return createErrorWithCode(log, "invalid resource uri", err, codes.InvalidArgument)
+		}
+	}
+
+	if waiver.ResourceId != "" {
+		if err := resource.ValidateResourceId(waiver.ResourceId); err != nil {
+			return createErrorWithCode(log, "invalid resource id", err, codes.InvalidArgument)
+		}
+	}
+
+	if waiver.PolicyId == "" {
+		return createErrorWithCode(log, "policy id is required", nil, codes.InvalidArgument)
+	}
+
+	if waiver.Justification == "" {
+		return createErrorWithCode(log, "justification is required", nil, codes.InvalidArgument)
+	}
+
+	if !waiver.Expiration.IsValid() || !waiver.Expiration.AsTime().After(time.Now()) {
+		return createErrorWithCode(log, "expiration must be in the future", nil, codes.InvalidArgument)
+	}
+
+	response, err := m.esClient.Get(ctx, &esutil.GetRequest{
+		Index:      m.indexManager.AliasName(constants.PoliciesDocumentKind, ""),
+		DocumentId: waiver.PolicyId,
+	})
+	if err != nil {
+		return createError(log, "error retrieving policy", err)
+	}
+
+	if !response.Found {
+		return createErrorWithCode(log, "policy not found", nil, codes.FailedPrecondition)
+	}
+
+	var policy pb.Policy
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(response.Source, &policy); err != nil {
+		return createError(log, "error parsing policy", err)
+	}
+
+	if policy.Deleted {
+		return createErrorWithCode(log, "cannot waive a policy that has been deleted", nil, codes.FailedPrecondition)
+	}
+
+	return nil
+}
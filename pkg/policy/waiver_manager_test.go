@@ -0,0 +1,681 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("WaiverManager", func() {
+	var (
+		ctx                   = context.Background()
+		expectedWaiversAlias  string
+		expectedPoliciesAlias string
+
+		esClient     *esutilfakes.FakeClient
+		esConfig     *config.ElasticsearchConfig
+		indexManager *immocks.FakeIndexManager
+		filterer     *filteringfakes.FakeFilterer
+
+		manager WaiverManager
+	)
+
+	BeforeEach(func() {
+		indexManager = &immocks.FakeIndexManager{}
+		esClient = &esutilfakes.FakeClient{}
+		filterer = &filteringfakes.FakeFilterer{}
+		esConfig = randomEsConfig()
+
+		expectedWaiversAlias = fake.LetterN(10)
+		expectedPoliciesAlias = fake.LetterN(10)
+		indexManager.AliasNameStub = func(documentKind string, _ string) string {
+			return map[string]string{
+				constants.PoliciesDocumentKind: expectedPoliciesAlias,
+				constants.WaiversDocumentKind:  expectedWaiversAlias,
+			}[documentKind]
+		}
+
+		manager = NewWaiverManager(logger, esClient, esConfig, indexManager, filterer)
+	})
+
+	Context("CreateWaiver", func() {
+		var (
+			waiver *pb.Waiver
+			policy *pb.Policy
+
+			getPolicyResponse *esutil.EsGetResponse
+			getPolicyError    error
+			createError       error
+
+			actualWaiver *pb.Waiver
+			actualError  error
+		)
+
+		BeforeEach(func() {
+			waiver = randomWaiver()
+			waiver.Id = ""
+			policy = createRandomPolicy(waiver.PolicyId, 1)
+
+			policyJson, _ := protojson.Marshal(policy)
+			getPolicyResponse = &esutil.EsGetResponse{
+				Id:     policy.Id,
+				Found:  true,
+				Source: policyJson,
+			}
+			getPolicyError = nil
+			createError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturns(getPolicyResponse, getPolicyError)
+			esClient.CreateReturns("", createError)
+
+			actualWaiver, actualError = manager.CreateWaiver(ctx, proto.Clone(waiver).(*pb.Waiver))
+		})
+
+		It("should check that the policy exists", func() {
+			Expect(esClient.GetCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.GetArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedPoliciesAlias))
+			Expect(actualRequest.DocumentId).To(Equal(waiver.PolicyId))
+		})
+
+		It("should create the waiver", func() {
+			Expect(esClient.CreateCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.CreateArgsForCall(0)
+			actualMessage := actualRequest.Message.(*pb.Waiver)
+
+			Expect(actualRequest.Index).To(Equal(expectedWaiversAlias))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(actualRequest.DocumentId).To(Equal(actualMessage.Id))
+			Expect(actualMessage.PolicyId).To(Equal(waiver.PolicyId))
+			Expect(actualMessage.ResourceUri).To(Equal(waiver.ResourceUri))
+			Expect(actualMessage.Justification).To(Equal(waiver.Justification))
+		})
+
+		It("should return the new waiver", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWaiver.Id).NotTo(BeEmpty())
+			Expect(actualWaiver.Created.IsValid()).To(BeTrue())
+			Expect(actualWaiver.Updated.IsValid()).To(BeTrue())
+		})
+
+		When("the waiver is scoped to a resource", func() {
+			BeforeEach(func() {
+				waiver.ResourceUri = ""
+				waiver.ResourceId = fake.URL()
+			})
+
+			It("should create the waiver", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(esClient.CreateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the waiver is invalid", func() {
+			type invalidWaiverCase struct {
+				description string
+				update      func(*pb.Waiver)
+			}
+
+			for _, c := range []invalidWaiverCase{
+				{"resource is missing", func(w *pb.Waiver) { w.ResourceUri = "" }},
+				{"both a resource id and uri are specified", func(w *pb.Waiver) { w.ResourceId = fake.URL() }},
+				{"resource uri is invalid", func(w *pb.Waiver) { w.ResourceUri = "foo://bar" }},
+				{"resource id is invalid", func(w *pb.Waiver) { w.ResourceUri, w.ResourceId = "", "foo://bar" }},
+				{"resource id is a resource version", func(w *pb.Waiver) {
+					w.ResourceUri, w.ResourceId = "", "git://github.com/rode/rode@bca0e1b89be42a61131b6de09fd2836e7b00c252"
+				}},
+				{"policy id is missing", func(w *pb.Waiver) { w.PolicyId = "" }},
+				{"justification is missing", func(w *pb.Waiver) { w.Justification = "" }},
+				{"expiration is missing", func(w *pb.Waiver) { w.Expiration = nil }},
+				{"expiration is in the past", func(w *pb.Waiver) { w.Expiration = timestamppb.New(time.Now().Add(-time.Hour)) }},
+			} {
+				c := c
+				When(c.description, func() {
+					BeforeEach(func() {
+						c.update(waiver)
+					})
+
+					It("should return an error", func() {
+						Expect(actualWaiver).To(BeNil())
+						Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+						Expect(esClient.CreateCallCount()).To(Equal(0))
+					})
+				})
+			}
+		})
+
+		When("the policy does not exist", func() {
+			BeforeEach(func() {
+				getPolicyResponse.Found = false
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the policy has been deleted", func() {
+			BeforeEach(func() {
+				policy.Deleted = true
+				getPolicyResponse.Source, _ = protojson.Marshal(policy)
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an error occurs fetching the policy", func() {
+			BeforeEach(func() {
+				getPolicyError = errors.New("get error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+
+		When("an error occurs creating the waiver", func() {
+			BeforeEach(func() {
+				createError = errors.New("create error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("GetWaiver", func() {
+		var (
+			waiverId       string
+			expectedWaiver *pb.Waiver
+
+			getWaiverResponse *esutil.EsGetResponse
+			getWaiverError    error
+
+			actualWaiver *pb.Waiver
+			actualError  error
+		)
+
+		BeforeEach(func() {
+			expectedWaiver = randomWaiver()
+			waiverId = expectedWaiver.Id
+
+			waiverJson, _ := protojson.Marshal(expectedWaiver)
+			getWaiverResponse = &esutil.EsGetResponse{
+				Id:     waiverId,
+				Found:  true,
+				Source: waiverJson,
+			}
+			getWaiverError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturns(getWaiverResponse, getWaiverError)
+
+			actualWaiver, actualError = manager.GetWaiver(ctx, &pb.GetWaiverRequest{Id: waiverId})
+		})
+
+		It("should fetch the waiver", func() {
+			Expect(esClient.GetCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.GetArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWaiversAlias))
+			Expect(actualRequest.DocumentId).To(Equal(waiverId))
+		})
+
+		It("should return the waiver", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWaiver).To(Equal(expectedWaiver))
+		})
+
+		When("the stored waiver has fields that this version doesn't know about", func() {
+			BeforeEach(func() {
+				getWaiverResponse.Source = append(getWaiverResponse.Source[:len(getWaiverResponse.Source)-1], []byte(`,"unknownField":true}`)...)
+			})
+
+			It("should ignore them", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualWaiver.Id).To(Equal(waiverId))
+			})
+		})
+
+		When("the id is not specified", func() {
+			BeforeEach(func() {
+				waiverId = ""
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.GetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the waiver does not exist", func() {
+			BeforeEach(func() {
+				getWaiverResponse.Found = false
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("an error occurs fetching the waiver", func() {
+			BeforeEach(func() {
+				getWaiverError = errors.New("get error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("UpdateWaiver", func() {
+		var (
+			currentWaiver *pb.Waiver
+			updatedWaiver *pb.Waiver
+
+			getWaiverResponse *esutil.EsGetResponse
+			getPolicyResponse *esutil.EsGetResponse
+			updateError       error
+
+			actualWaiver *pb.Waiver
+			actualError  error
+		)
+
+		BeforeEach(func() {
+			currentWaiver = randomWaiver()
+			updatedWaiver = proto.Clone(currentWaiver).(*pb.Waiver)
+			updatedWaiver.Justification = fake.Sentence(5)
+			updatedWaiver.Expiration = timestamppb.New(time.Now().Add(48 * time.Hour))
+			updatedWaiver.Created = nil
+
+			waiverJson, _ := protojson.Marshal(currentWaiver)
+			getWaiverResponse = &esutil.EsGetResponse{
+				Id:     currentWaiver.Id,
+				Found:  true,
+				Source: waiverJson,
+			}
+			policyJson, _ := protojson.Marshal(createRandomPolicy(currentWaiver.PolicyId, 1))
+			getPolicyResponse = &esutil.EsGetResponse{
+				Id:     currentWaiver.PolicyId,
+				Found:  true,
+				Source: policyJson,
+			}
+			updateError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturnsOnCall(0, getWaiverResponse, nil)
+			esClient.GetReturnsOnCall(1, getPolicyResponse, nil)
+			esClient.UpdateReturns(nil, updateError)
+
+			actualWaiver, actualError = manager.UpdateWaiver(ctx, proto.Clone(updatedWaiver).(*pb.Waiver))
+		})
+
+		It("should update the waiver", func() {
+			Expect(esClient.UpdateCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.UpdateArgsForCall(0)
+			actualMessage := actualRequest.Message.(*pb.Waiver)
+
+			Expect(actualRequest.Index).To(Equal(expectedWaiversAlias))
+			Expect(actualRequest.DocumentId).To(Equal(currentWaiver.Id))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(actualMessage.Justification).To(Equal(updatedWaiver.Justification))
+			Expect(actualMessage.Expiration.AsTime()).To(Equal(updatedWaiver.Expiration.AsTime()))
+		})
+
+		It("should keep the original creation time", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWaiver.Created.AsTime()).To(Equal(currentWaiver.Created.AsTime()))
+			Expect(actualWaiver.Updated.IsValid()).To(BeTrue())
+		})
+
+		When("the waiver does not exist", func() {
+			BeforeEach(func() {
+				getWaiverResponse.Found = false
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				Expect(esClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the waived policy is changed", func() {
+			BeforeEach(func() {
+				updatedWaiver.PolicyId = fake.UUID()
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the resource is changed", func() {
+			BeforeEach(func() {
+				updatedWaiver.ResourceUri = "npm://lodash:4.17.21"
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an error occurs updating the waiver", func() {
+			BeforeEach(func() {
+				updateError = errors.New("update error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWaiver).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("DeleteWaiver", func() {
+		var (
+			waiverId string
+
+			getWaiverResponse *esutil.EsGetResponse
+			deleteError       error
+
+			actualResponse *emptypb.Empty
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			waiver := randomWaiver()
+			waiverId = waiver.Id
+
+			waiverJson, _ := protojson.Marshal(waiver)
+			getWaiverResponse = &esutil.EsGetResponse{
+				Id:     waiverId,
+				Found:  true,
+				Source: waiverJson,
+			}
+			deleteError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturns(getWaiverResponse, nil)
+			esClient.DeleteReturns(deleteError)
+
+			actualResponse, actualError = manager.DeleteWaiver(ctx, &pb.DeleteWaiverRequest{Id: waiverId})
+		})
+
+		It("should delete the waiver", func() {
+			Expect(esClient.DeleteCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.DeleteArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWaiversAlias))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect((*actualRequest.Search.Query.Term)["_id"]).To(Equal(waiverId))
+		})
+
+		It("should not return an error", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse).To(Equal(&emptypb.Empty{}))
+		})
+
+		When("the waiver does not exist", func() {
+			BeforeEach(func() {
+				getWaiverResponse.Found = false
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				Expect(esClient.DeleteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an error occurs deleting the waiver", func() {
+			BeforeEach(func() {
+				deleteError = errors.New("delete error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("ListWaivers", func() {
+		var (
+			request         *pb.ListWaiversRequest
+			expectedWaivers []*pb.Waiver
+
+			searchResponse *esutil.SearchResponse
+			searchError    error
+			filterQuery    *filtering.Query
+			filterError    error
+
+			actualResponse *pb.ListWaiversResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			request = &pb.ListWaiversRequest{}
+			searchResponse = &esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{},
+			}
+			searchError = nil
+
+			expectedWaivers = []*pb.Waiver{}
+			for i := 0; i < fake.Number(2, 5); i++ {
+				waiver := randomWaiver()
+				expectedWaivers = append(expectedWaivers, waiver)
+
+				waiverJson, _ := protojson.Marshal(waiver)
+				searchResponse.Hits.Hits = append(searchResponse.Hits.Hits, &esutil.EsSearchResponseHit{
+					ID:     waiver.Id,
+					Source: waiverJson,
+				})
+			}
+
+			filterQuery = nil
+			filterError = nil
+		})
+
+		JustBeforeEach(func() {
+			filterer.ParseExpressionReturns(filterQuery, filterError)
+			esClient.SearchReturns(searchResponse, searchError)
+
+			actualResponse, actualError = manager.ListWaivers(ctx, request)
+		})
+
+		It("should search for waivers that haven't expired", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.SearchArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWaiversAlias))
+			Expect(actualRequest.Pagination).To(BeNil())
+			Expect(actualRequest.Search.Sort["created"]).To(Equal(esutil.EsSortOrderDescending))
+			Expect(*actualRequest.Search.Query.Bool.Must).To(ConsistOf(&filtering.Query{
+				Range: &filtering.Range{
+					"expiration": &filtering.RangeOperator{
+						Greater: "now",
+					},
+				},
+			}))
+		})
+
+		It("should return the waivers", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Waivers).To(Equal(expectedWaivers))
+		})
+
+		When("expired waivers are included", func() {
+			BeforeEach(func() {
+				request.IncludeExpired = true
+			})
+
+			It("should not filter by expiration", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(*actualRequest.Search.Query.Bool.Must).To(BeEmpty())
+			})
+		})
+
+		When("a policy id is specified", func() {
+			BeforeEach(func() {
+				request.PolicyId = fake.UUID()
+			})
+
+			It("should include the policy id in the query", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+				actualQuery := (*actualRequest.Search.Query.Bool.Must)[0].(*filtering.Query)
+
+				Expect((*actualQuery.Term)["policyId"]).To(Equal(request.PolicyId))
+			})
+		})
+
+		When("a resource uri is specified", func() {
+			BeforeEach(func() {
+				request.ResourceUri = "npm://lodash:4.17.21"
+			})
+
+			It("should search for waivers on the resource version or the resource", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+				actualQuery := (*actualRequest.Search.Query.Bool.Must)[0].(*filtering.Query)
+
+				Expect(*actualQuery.Bool.Should).To(ConsistOf(
+					&filtering.Query{Term: &filtering.Term{"resourceUri": "npm://lodash:4.17.21"}},
+					&filtering.Query{Term: &filtering.Term{"resourceId": "npm://lodash"}},
+				))
+			})
+		})
+
+		When("pagination options are specified", func() {
+			BeforeEach(func() {
+				request.PageSize = int32(fake.Number(10, 100))
+				request.PageToken = fake.Word()
+				searchResponse.NextPageToken = fake.Word()
+			})
+
+			It("should include the page size and token in the search request", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(actualRequest.Pagination.Size).To(BeEquivalentTo(request.PageSize))
+				Expect(actualRequest.Pagination.Token).To(Equal(request.PageToken))
+			})
+
+			It("should return the next page token", func() {
+				Expect(actualResponse.NextPageToken).To(Equal(searchResponse.NextPageToken))
+			})
+		})
+
+		When("a filter is specified", func() {
+			BeforeEach(func() {
+				request.Filter = fake.Word()
+				filterQuery = &filtering.Query{
+					Term: &filtering.Term{
+						fake.Word(): fake.Word(),
+					},
+				}
+			})
+
+			It("should include the filter in the query", func() {
+				Expect(filterer.ParseExpressionArgsForCall(0)).To(Equal(request.Filter))
+
+				_, actualRequest := esClient.SearchArgsForCall(0)
+				Expect(*actualRequest.Search.Query.Bool.Must).To(ContainElement(filterQuery))
+			})
+
+			When("the filter is invalid", func() {
+				BeforeEach(func() {
+					filterError = errors.New("filter error")
+				})
+
+				It("should return an error", func() {
+					Expect(actualResponse).To(BeNil())
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+					Expect(esClient.SearchCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("an error occurs searching for waivers", func() {
+			BeforeEach(func() {
+				searchError = errors.New("search error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+})
+
+func randomWaiver() *pb.Waiver {
+	return &pb.Waiver{
+		Id:            fake.UUID(),
+		ResourceUri:   "harbor.localhost/rode-demo/rode-demo-node-app@sha256:a235554754f9bf075ac1c1b70c224ef5997176b776f0c56e340aeb63f429ace8",
+		PolicyId:      fake.UUID(),
+		ViolationId:   fake.LetterN(10),
+		Justification: fake.Sentence(5),
+		Expiration:    timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Created:       timestamppb.New(fake.Date()),
+		Updated:       timestamppb.New(fake.Date()),
+	}
+}
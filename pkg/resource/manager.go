@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
 )
 
 //go:generate counterfeiter -generate
@@ -472,3 +473,24 @@ func resourceVersionsFromOccurrence(o *grafeas_proto.Occurrence) map[string]*pb.
 
 	return result
 }
+
+// ResourceVersionUris returns the unique, sorted uris of the resource versions referenced by a set of occurrences.
+// These are the same resource versions that are created by BatchCreateResourceVersions.
+func ResourceVersionUris(occurrences []*grafeas_proto.Occurrence) []string {
+	seen := map[string]bool{}
+	var uris []string
+	for _, occurrence := range occurrences {
+		for uri := range resourceVersionsFromOccurrence(occurrence) {
+			if seen[uri] {
+				continue
+			}
+
+			seen[uri] = true
+			uris = append(uris, uri)
+		}
+	}
+
+	sort.Strings(uris)
+
+	return uris
+}
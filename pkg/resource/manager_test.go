@@ -1041,6 +1041,28 @@ var _ = Describe("resource manager", func() {
 			})
 		})
 	})
+
+	Context("ResourceVersionUris", func() {
+		It("should return the unique resource versions, including built artifacts", func() {
+			dockerOccurrence := createRandomOccurrence(grafeas_common_proto.NoteKind_VULNERABILITY)
+			buildOccurrence := createRandomOccurrence(grafeas_common_proto.NoteKind_BUILD)
+			buildOccurrence.Details = &grafeas_go_proto.Occurrence_Build{
+				Build: &build_go_proto.Details{
+					Provenance: &provenance_go_proto.BuildProvenance{
+						BuiltArtifacts: []*provenance_go_proto.Artifact{
+							{
+								Id: dockerOccurrence.Resource.Uri,
+							},
+						},
+					},
+				},
+			}
+
+			actualUris := ResourceVersionUris([]*grafeas_go_proto.Occurrence{dockerOccurrence, buildOccurrence, dockerOccurrence})
+
+			Expect(actualUris).To(ConsistOf(dockerOccurrence.Resource.Uri, buildOccurrence.Resource.Uri))
+		})
+	})
 })
 
 func createRandomOccurrence(kind grafeas_common_proto.NoteKind) *grafeas_go_proto.Occurrence {
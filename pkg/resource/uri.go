@@ -15,9 +15,11 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	pb "github.com/rode/rode/proto/v1alpha1"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -59,6 +61,9 @@ var (
 			pattern: regexp.MustCompile("^rpm:/{2}.*:(?P<name>.+):(?P<version>.+)"),
 		},
 	}
+
+	hexDigestPattern = regexp.MustCompile("^[a-f0-9]+$")
+	schemePattern    = regexp.MustCompile("^([a-z]+)://")
 )
 
 type uriHandler struct {
@@ -98,3 +103,90 @@ func parseResourceUri(uri string) (*uriComponents, error) {
 
 	return &uriComponents{name, version, resourceType, prefixedName}, nil
 }
+
+// ResourceFromUri returns the id, name, and type of the resource that a resource version belongs to
+func ResourceFromUri(uri string) (*pb.Resource, error) {
+	components, err := parseResourceUri(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Resource{
+		Id:   components.prefixedName,
+		Name: components.name,
+		Type: components.resourceType,
+	}, nil
+}
+
+// ResourceId returns the id of the resource that a resource version belongs to
+func ResourceId(uri string) (string, error) {
+	components, err := parseResourceUri(uri)
+	if err != nil {
+		return "", err
+	}
+
+	return components.prefixedName, nil
+}
+
+// ValidateResourceId checks that id looks like the id of a resource, as returned by ResourceId, rather than a resource
+// version uri or a uri for an unknown resource type. Docker image names don't have a prefix, and may include an http(s) scheme.
+func ValidateResourceId(id string) error {
+	if id == "" || strings.ContainsAny(id, " \t\n") {
+		return errors.New("resource id must not be empty or contain whitespace")
+	}
+
+	scheme := schemePattern.FindStringSubmatch(id)
+	if scheme == nil || scheme[1] == "http" || scheme[1] == "https" {
+		if strings.Contains(id, "@sha256:") {
+			return fmt.Errorf("resource id %s includes an image digest, use the resource uri instead", id)
+		}
+
+		return nil
+	}
+
+	for resourceType, handler := range uriHandlers {
+		if handler.prefix != scheme[0] {
+			continue
+		}
+
+		name := strings.TrimPrefix(id, handler.prefix)
+		if name == "" {
+			return fmt.Errorf("resource id %s is missing a name", id)
+		}
+
+		if (resourceType == pb.ResourceType_GIT && strings.Contains(name, "@")) || (resourceType == pb.ResourceType_FILE && strings.HasPrefix(name, "sha256:")) {
+			return fmt.Errorf("resource id %s includes a version, use the resource uri instead", id)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to determine resource type for id: %s", id)
+}
+
+// VersionDigest returns the content digest that identifies the version of a resource, keyed by algorithm name like an
+// in-toto subject. Only Docker images, files, and git commits are identified by a digest.
+func VersionDigest(uri string) (map[string]string, error) {
+	components, err := parseResourceUri(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	switch components.resourceType {
+	case pb.ResourceType_DOCKER, pb.ResourceType_FILE:
+		return map[string]string{"sha256": components.version}, nil
+	case pb.ResourceType_GIT:
+		if hexDigestPattern.MatchString(components.version) {
+			switch len(components.version) {
+			case 40:
+				return map[string]string{"sha1": components.version}, nil
+			case 64:
+				return map[string]string{"sha256": components.version}, nil
+			}
+		}
+
+		return nil, fmt.Errorf("git version %s is not a commit hash", components.version)
+	default:
+		return nil, fmt.Errorf("%s resources are not identified by a digest", components.resourceType)
+	}
+}
@@ -122,4 +122,89 @@ var _ = Describe("uri", func() {
 			})
 		})
 	})
+
+	Describe("ResourceFromUri", func() {
+		It("should return the resource id, name, and type", func() {
+			actual, err := ResourceFromUri("npm://@babel/core:7.13.14")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Id).To(Equal("npm://@babel/core"))
+			Expect(actual.Name).To(Equal("@babel/core"))
+			Expect(actual.Type).To(Equal(pb.ResourceType_NPM))
+		})
+
+		It("should return an error when the resource type is unknown", func() {
+			actual, err := ResourceFromUri("foo://bar")
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+	})
+
+	DescribeTable("ResourceId", func(resourceUri, expected string) {
+		actual, err := ResourceId(resourceUri)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	},
+		Entry("Docker image", "harbor.localhost/rode-demo/rode-demo-node-app@sha256:a235554754f9bf075ac1c1b70c224ef5997176b776f0c56e340aeb63f429ace8", "harbor.localhost/rode-demo/rode-demo-node-app"),
+		Entry("npm package", "npm://lodash:4.17.21", "npm://lodash"),
+	)
+
+	It("should return an error when the resource id can't be determined", func() {
+		_, err := ResourceId("foo://bar")
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("ValidateResourceId", func(resourceId string, valid bool) {
+		err := ValidateResourceId(resourceId)
+
+		if valid {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("Docker image", "harbor.localhost/rode-demo/rode-demo-node-app", true),
+		Entry("Docker image with a scheme", "https://gcr.io/scanning-customer/dockerimage", true),
+		Entry("npm package", "npm://@babel/core", true),
+		Entry("git repository", "git://github.com/rode/rode", true),
+		Entry("file", "file://foo.jar", true),
+		Entry("empty", "", false),
+		Entry("whitespace", "npm://lodash 4.17.21", false),
+		Entry("unknown resource type", "foo://bar", false),
+		Entry("missing name", "npm://", false),
+		Entry("Docker image version", "harbor.localhost/rode-demo/rode-demo-node-app@sha256:a235554754f9bf075ac1c1b70c224ef5997176b776f0c56e340aeb63f429ace8", false),
+		Entry("git commit", "git://github.com/rode/rode@bca0e1b89be42a61131b6de09fd2836e7b00c252", false),
+		Entry("file version", "file://sha256:244fd47e07d1004f0aed9c156aa09083c82bf8944eceb67c946ff7430510a77b:foo.jar", false),
+	)
+
+	DescribeTable("VersionDigest", func(resourceUri string, expected map[string]string) {
+		actual, err := VersionDigest(resourceUri)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	},
+		Entry("Docker image", "harbor.localhost/rode-demo/rode-demo-node-app@sha256:a235554754f9bf075ac1c1b70c224ef5997176b776f0c56e340aeb63f429ace8", map[string]string{
+			"sha256": "a235554754f9bf075ac1c1b70c224ef5997176b776f0c56e340aeb63f429ace8",
+		}),
+		Entry("file", "file://sha256:244fd47e07d1004f0aed9c156aa09083c82bf8944eceb67c946ff7430510a77b:foo.jar", map[string]string{
+			"sha256": "244fd47e07d1004f0aed9c156aa09083c82bf8944eceb67c946ff7430510a77b",
+		}),
+		Entry("git commit", "git://github.com/rode/rode@bca0e1b89be42a61131b6de09fd2836e7b00c252", map[string]string{
+			"sha1": "bca0e1b89be42a61131b6de09fd2836e7b00c252",
+		}),
+	)
+
+	DescribeTable("VersionDigest without a digest", func(resourceUri string) {
+		actual, err := VersionDigest(resourceUri)
+
+		Expect(err).To(HaveOccurred())
+		Expect(actual).To(BeNil())
+	},
+		Entry("npm package", "npm://lodash:4.17.21"),
+		Entry("git tag", "git://github.com/rode/rode@v0.1.0"),
+		Entry("unknown resource type", "foo://bar"),
+	)
 })
@@ -0,0 +1,164 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+// PayloadVersion is the format of the payloads returned by ResourceEvaluationPayload and PolicyEvaluationPayload.
+// It's recorded in each EvaluationSignature, and must change whenever a payload gains or loses a field, so that
+// signatures keep verifying when fields that aren't signed are added to the evaluations.
+const PayloadVersion = "v1"
+
+// resourceEvaluationPayload is the signed content of a resource evaluation. Fields are declared in alphabetical
+// order, so that the JSON encoding has sorted keys.
+type resourceEvaluationPayload struct {
+	AuditFailures           []string                        `json:"auditFailures"`
+	Created                 string                          `json:"created"`
+	Id                      string                          `json:"id"`
+	InputHash               string                          `json:"inputHash"`
+	OccurrenceNames         []string                        `json:"occurrenceNames"`
+	Pass                    bool                            `json:"pass"`
+	PayloadVersion          string                          `json:"payloadVersion"`
+	PolicyEvaluationDigests []policyEvaluationDigestPayload `json:"policyEvaluationDigests"`
+	PolicyGroup             string                          `json:"policyGroup"`
+	ResourceUri             string                          `json:"resourceUri"`
+	SeverityThreshold       string                          `json:"severityThreshold"`
+	State                   string                          `json:"state"`
+}
+
+type policyEvaluationDigestPayload struct {
+	PayloadDigest      string `json:"payloadDigest"`
+	PolicyEvaluationId string `json:"policyEvaluationId"`
+}
+
+// policyEvaluationPayload is the signed content of a policy evaluation, with fields in alphabetical order
+type policyEvaluationPayload struct {
+	EnforcementMode      string             `json:"enforcementMode"`
+	Id                   string             `json:"id"`
+	Pass                 bool               `json:"pass"`
+	PayloadVersion       string             `json:"payloadVersion"`
+	PolicyVersionId      string             `json:"policyVersionId"`
+	ResourceEvaluationId string             `json:"resourceEvaluationId"`
+	Violations           []violationPayload `json:"violations"`
+	Waived               bool               `json:"waived"`
+	WaiverIds            []string           `json:"waiverIds"`
+}
+
+type violationPayload struct {
+	Id       string `json:"id"`
+	Message  string `json:"message"`
+	Pass     bool   `json:"pass"`
+	Severity string `json:"severity"`
+	Waived   bool   `json:"waived"`
+	WaiverId string `json:"waiverId"`
+}
+
+// ResourceEvaluationPayload returns the bytes that are signed for a resource evaluation in the given payload version:
+// a JSON object with sorted keys, holding the fields that describe the outcome of the evaluation
+func ResourceEvaluationPayload(resourceEvaluation *pb.ResourceEvaluation, payloadVersion string) ([]byte, error) {
+	if payloadVersion != PayloadVersion {
+		return nil, fmt.Errorf("unsupported payload version %q", payloadVersion)
+	}
+
+	var created string
+	if resourceEvaluation.Created != nil {
+		created = resourceEvaluation.Created.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+
+	policyEvaluationDigests := []policyEvaluationDigestPayload{}
+	for _, digest := range resourceEvaluation.PolicyEvaluationDigests {
+		policyEvaluationDigests = append(policyEvaluationDigests, policyEvaluationDigestPayload{
+			PayloadDigest:      digest.PayloadDigest,
+			PolicyEvaluationId: digest.PolicyEvaluationId,
+		})
+	}
+
+	return json.Marshal(&resourceEvaluationPayload{
+		AuditFailures:           nonNilStrings(resourceEvaluation.AuditFailures),
+		Created:                 created,
+		Id:                      resourceEvaluation.Id,
+		InputHash:               resourceEvaluation.InputHash,
+		OccurrenceNames:         nonNilStrings(resourceEvaluation.OccurrenceNames),
+		Pass:                    resourceEvaluation.Pass,
+		PayloadVersion:          payloadVersion,
+		PolicyEvaluationDigests: policyEvaluationDigests,
+		PolicyGroup:             resourceEvaluation.PolicyGroup,
+		ResourceUri:             resourceEvaluation.GetResourceVersion().GetVersion(),
+		SeverityThreshold:       resourceEvaluation.SeverityThreshold.String(),
+		State:                   resourceEvaluation.State.String(),
+	})
+}
+
+// PolicyEvaluationPayload returns the bytes that are signed for a policy evaluation in the given payload version:
+// a JSON object with sorted keys, holding the fields that describe the outcome of the evaluation
+func PolicyEvaluationPayload(policyEvaluation *pb.PolicyEvaluation, payloadVersion string) ([]byte, error) {
+	if payloadVersion != PayloadVersion {
+		return nil, fmt.Errorf("unsupported payload version %q", payloadVersion)
+	}
+
+	violations := []violationPayload{}
+	for _, violation := range policyEvaluation.Violations {
+		violations = append(violations, violationPayload{
+			Id:       violation.Id,
+			Message:  violation.Message,
+			Pass:     violation.Pass,
+			Severity: violation.Severity.String(),
+			Waived:   violation.Waived,
+			WaiverId: violation.WaiverId,
+		})
+	}
+
+	return json.Marshal(&policyEvaluationPayload{
+		EnforcementMode:      policyEvaluation.EnforcementMode.String(),
+		Id:                   policyEvaluation.Id,
+		Pass:                 policyEvaluation.Pass,
+		PayloadVersion:       payloadVersion,
+		PolicyVersionId:      policyEvaluation.PolicyVersionId,
+		ResourceEvaluationId: policyEvaluation.ResourceEvaluationId,
+		Violations:           violations,
+		Waived:               policyEvaluation.Waived,
+		WaiverIds:            nonNilStrings(policyEvaluation.WaiverIds),
+	})
+}
+
+// PolicyEvaluationDigest returns the hex-encoded SHA-256 digest of the policy evaluation's payload, which is recorded on
+// the resource evaluation so that the resource evaluation's signature covers its policy evaluations too
+func PolicyEvaluationDigest(policyEvaluation *pb.PolicyEvaluation, payloadVersion string) (string, error) {
+	payload, err := PolicyEvaluationPayload(policyEvaluation, payloadVersion)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(payload)
+
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// nonNilStrings encodes missing lists as empty arrays, rather than null, so that there's one encoding for both
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate . Signer
+type Signer interface {
+	// Sign returns a signature over the payload. ECDSA signatures are ASN.1 encoded and computed over the SHA-256 digest
+	// of the payload, while ed25519 signatures are computed over the payload itself.
+	Sign(payload []byte) ([]byte, error)
+	// KeyId is the hex-encoded SHA-256 digest of the DER encoded public key
+	KeyId() string
+	PublicKey() crypto.PublicKey
+}
+
+type signer struct {
+	privateKey crypto.Signer
+	keyId      string
+}
+
+// NewSignerFromFile loads a PEM encoded ed25519 or ECDSA private key. Both PKCS #8 and SEC 1 ("EC PRIVATE KEY") encodings
+// are supported.
+func NewSignerFromFile(path string) (Signer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading signing key: %v", err)
+	}
+
+	return NewSigner(data)
+}
+
+// NewSigner parses a PEM encoded ed25519 or ECDSA private key
+func NewSigner(pemData []byte) (Signer, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, errors.New("signing key is not PEM encoded")
+	}
+
+	var privateKey crypto.Signer
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing ECDSA signing key: %v", err)
+		}
+		privateKey = key
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing signing key: %v", err)
+		}
+
+		switch k := key.(type) {
+		case ed25519.PrivateKey:
+			privateKey = k
+		case *ecdsa.PrivateKey:
+			privateKey = k
+		default:
+			return nil, fmt.Errorf("unsupported signing key type %T, only ed25519 and ECDSA keys are supported", key)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %s", block.Type)
+	}
+
+	keyId, err := KeyId(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &signer{
+		privateKey: privateKey,
+		keyId:      keyId,
+	}, nil
+}
+
+func (s *signer) Sign(payload []byte) ([]byte, error) {
+	if _, ok := s.privateKey.(ed25519.PrivateKey); ok {
+		return s.privateKey.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+
+	digest := sha256.Sum256(payload)
+
+	return s.privateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+func (s *signer) KeyId() string {
+	return s.keyId
+}
+
+func (s *signer) PublicKey() crypto.PublicKey {
+	return s.privateKey.Public()
+}
+
+// KeyId returns the hex-encoded SHA-256 digest of the DER encoded public key
+func KeyId(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("error encoding public key: %v", err)
+	}
+
+	digest := sha256.Sum256(der)
+
+	return hex.EncodeToString(digest[:]), nil
+}
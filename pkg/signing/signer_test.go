@@ -0,0 +1,167 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("signer", func() {
+	var payload []byte
+
+	BeforeEach(func() {
+		payload = []byte(fake.LetterN(20))
+	})
+
+	Context("ed25519 keys", func() {
+		var (
+			publicKey ed25519.PublicKey
+			signer    Signer
+		)
+
+		BeforeEach(func() {
+			var (
+				privateKey ed25519.PrivateKey
+				err        error
+			)
+			publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			signer, err = NewSigner(encodePKCS8(privateKey))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should sign the payload", func() {
+			signature, err := signer.Sign(payload)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ed25519.Verify(publicKey, payload, signature)).To(BeTrue())
+		})
+
+		It("should expose the public key and its id", func() {
+			expectedKeyId, err := KeyId(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(signer.PublicKey()).To(Equal(publicKey))
+			Expect(signer.KeyId()).To(Equal(expectedKeyId))
+			Expect(signer.KeyId()).To(HaveLen(64))
+		})
+	})
+
+	DescribeTable("ECDSA keys", func(encode func(*ecdsa.PrivateKey) []byte) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		signer, err := NewSigner(encode(privateKey))
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(payload)
+		Expect(err).NotTo(HaveOccurred())
+
+		digest := sha256.Sum256(payload)
+		Expect(ecdsa.VerifyASN1(&privateKey.PublicKey, digest[:], signature)).To(BeTrue())
+	},
+		Entry("PKCS #8", func(key *ecdsa.PrivateKey) []byte {
+			return encodePKCS8(key)
+		}),
+		Entry("SEC 1", func(key *ecdsa.PrivateKey) []byte {
+			der, err := x509.MarshalECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		}),
+	)
+
+	DescribeTable("invalid keys", func(pemData func() []byte) {
+		signer, err := NewSigner(pemData())
+
+		Expect(err).To(HaveOccurred())
+		Expect(signer).To(BeNil())
+	},
+		Entry("not PEM encoded", func() []byte {
+			return []byte(fake.LetterN(10))
+		}),
+		Entry("unsupported PEM block", func() []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(fake.LetterN(10))})
+		}),
+		Entry("malformed key", func() []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte(fake.LetterN(10))})
+		}),
+		Entry("RSA key", func() []byte {
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			Expect(err).NotTo(HaveOccurred())
+
+			return encodePKCS8(key)
+		}),
+	)
+
+	Context("NewSignerFromFile", func() {
+		var (
+			dir     string
+			keyPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "signing")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			keyPath = filepath.Join(dir, "key.pem")
+			Expect(ioutil.WriteFile(keyPath, encodePKCS8(privateKey), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should load the key", func() {
+			signer, err := NewSignerFromFile(keyPath)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer).NotTo(BeNil())
+		})
+
+		It("should return an error when the file does not exist", func() {
+			signer, err := NewSignerFromFile(keyPath + fake.LetterN(5))
+
+			Expect(err).To(HaveOccurred())
+			Expect(signer).To(BeNil())
+		})
+	})
+})
+
+func encodePKCS8(key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
@@ -0,0 +1,252 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package signingfakes
+
+import (
+	"crypto"
+	"sync"
+
+	"github.com/rode/rode/pkg/signing"
+)
+
+type FakeSigner struct {
+	KeyIdStub        func() string
+	keyIdMutex       sync.RWMutex
+	keyIdArgsForCall []struct {
+	}
+	keyIdReturns struct {
+		result1 string
+	}
+	keyIdReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PublicKeyStub        func() crypto.PublicKey
+	publicKeyMutex       sync.RWMutex
+	publicKeyArgsForCall []struct {
+	}
+	publicKeyReturns struct {
+		result1 crypto.PublicKey
+	}
+	publicKeyReturnsOnCall map[int]struct {
+		result1 crypto.PublicKey
+	}
+	SignStub        func([]byte) ([]byte, error)
+	signMutex       sync.RWMutex
+	signArgsForCall []struct {
+		arg1 []byte
+	}
+	signReturns struct {
+		result1 []byte
+		result2 error
+	}
+	signReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSigner) KeyId() string {
+	fake.keyIdMutex.Lock()
+	ret, specificReturn := fake.keyIdReturnsOnCall[len(fake.keyIdArgsForCall)]
+	fake.keyIdArgsForCall = append(fake.keyIdArgsForCall, struct {
+	}{})
+	stub := fake.KeyIdStub
+	fakeReturns := fake.keyIdReturns
+	fake.recordInvocation("KeyId", []interface{}{})
+	fake.keyIdMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSigner) KeyIdCallCount() int {
+	fake.keyIdMutex.RLock()
+	defer fake.keyIdMutex.RUnlock()
+	return len(fake.keyIdArgsForCall)
+}
+
+func (fake *FakeSigner) KeyIdCalls(stub func() string) {
+	fake.keyIdMutex.Lock()
+	defer fake.keyIdMutex.Unlock()
+	fake.KeyIdStub = stub
+}
+
+func (fake *FakeSigner) KeyIdReturns(result1 string) {
+	fake.keyIdMutex.Lock()
+	defer fake.keyIdMutex.Unlock()
+	fake.KeyIdStub = nil
+	fake.keyIdReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSigner) KeyIdReturnsOnCall(i int, result1 string) {
+	fake.keyIdMutex.Lock()
+	defer fake.keyIdMutex.Unlock()
+	fake.KeyIdStub = nil
+	if fake.keyIdReturnsOnCall == nil {
+		fake.keyIdReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.keyIdReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSigner) PublicKey() crypto.PublicKey {
+	fake.publicKeyMutex.Lock()
+	ret, specificReturn := fake.publicKeyReturnsOnCall[len(fake.publicKeyArgsForCall)]
+	fake.publicKeyArgsForCall = append(fake.publicKeyArgsForCall, struct {
+	}{})
+	stub := fake.PublicKeyStub
+	fakeReturns := fake.publicKeyReturns
+	fake.recordInvocation("PublicKey", []interface{}{})
+	fake.publicKeyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSigner) PublicKeyCallCount() int {
+	fake.publicKeyMutex.RLock()
+	defer fake.publicKeyMutex.RUnlock()
+	return len(fake.publicKeyArgsForCall)
+}
+
+func (fake *FakeSigner) PublicKeyCalls(stub func() crypto.PublicKey) {
+	fake.publicKeyMutex.Lock()
+	defer fake.publicKeyMutex.Unlock()
+	fake.PublicKeyStub = stub
+}
+
+func (fake *FakeSigner) PublicKeyReturns(result1 crypto.PublicKey) {
+	fake.publicKeyMutex.Lock()
+	defer fake.publicKeyMutex.Unlock()
+	fake.PublicKeyStub = nil
+	fake.publicKeyReturns = struct {
+		result1 crypto.PublicKey
+	}{result1}
+}
+
+func (fake *FakeSigner) PublicKeyReturnsOnCall(i int, result1 crypto.PublicKey) {
+	fake.publicKeyMutex.Lock()
+	defer fake.publicKeyMutex.Unlock()
+	fake.PublicKeyStub = nil
+	if fake.publicKeyReturnsOnCall == nil {
+		fake.publicKeyReturnsOnCall = make(map[int]struct {
+			result1 crypto.PublicKey
+		})
+	}
+	fake.publicKeyReturnsOnCall[i] = struct {
+		result1 crypto.PublicKey
+	}{result1}
+}
+
+func (fake *FakeSigner) Sign(arg1 []byte) ([]byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.signMutex.Lock()
+	ret, specificReturn := fake.signReturnsOnCall[len(fake.signArgsForCall)]
+	fake.signArgsForCall = append(fake.signArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.SignStub
+	fakeReturns := fake.signReturns
+	fake.recordInvocation("Sign", []interface{}{arg1Copy})
+	fake.signMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSigner) SignCallCount() int {
+	fake.signMutex.RLock()
+	defer fake.signMutex.RUnlock()
+	return len(fake.signArgsForCall)
+}
+
+func (fake *FakeSigner) SignCalls(stub func([]byte) ([]byte, error)) {
+	fake.signMutex.Lock()
+	defer fake.signMutex.Unlock()
+	fake.SignStub = stub
+}
+
+func (fake *FakeSigner) SignArgsForCall(i int) []byte {
+	fake.signMutex.RLock()
+	defer fake.signMutex.RUnlock()
+	argsForCall := fake.signArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSigner) SignReturns(result1 []byte, result2 error) {
+	fake.signMutex.Lock()
+	defer fake.signMutex.Unlock()
+	fake.SignStub = nil
+	fake.signReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSigner) SignReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.signMutex.Lock()
+	defer fake.signMutex.Unlock()
+	fake.SignStub = nil
+	if fake.signReturnsOnCall == nil {
+		fake.signReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.signReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSigner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.keyIdMutex.RLock()
+	defer fake.keyIdMutex.RUnlock()
+	fake.publicKeyMutex.RLock()
+	defer fake.publicKeyMutex.RUnlock()
+	fake.signMutex.RLock()
+	defer fake.signMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSigner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ signing.Signer = new(FakeSigner)
@@ -0,0 +1,194 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("verification", func() {
+	DescribeTable("signatures", func(newPrivateKey func() crypto.PrivateKey) {
+		signer, err := NewSigner(encodePKCS8(newPrivateKey()))
+		Expect(err).NotTo(HaveOccurred())
+
+		publicKeyPem, err := MarshalPublicKey(signer.PublicKey())
+		Expect(err).NotTo(HaveOccurred())
+
+		publicKey, err := ParsePublicKey(publicKeyPem)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(publicKey).To(Equal(signer.PublicKey()))
+
+		payload := []byte(fake.LetterN(20))
+		signature, err := signer.Sign(payload)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(Verify(publicKey, payload, signature)).To(Succeed())
+		Expect(Verify(publicKey, []byte(fake.LetterN(21)), signature)).NotTo(Succeed())
+	},
+		Entry("ed25519", func() crypto.PrivateKey {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			return key
+		}),
+		Entry("ECDSA", func() crypto.PrivateKey {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			return key
+		}),
+	)
+
+	DescribeTable("invalid public keys", func(pemData func() []byte) {
+		publicKey, err := ParsePublicKey(pemData())
+
+		Expect(err).To(HaveOccurred())
+		Expect(publicKey).To(BeNil())
+	},
+		Entry("not PEM encoded", func() []byte {
+			return []byte(fake.LetterN(10))
+		}),
+		Entry("private key", func() []byte {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			return encodePKCS8(key)
+		}),
+		Entry("malformed key", func() []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte(fake.LetterN(10))})
+		}),
+		Entry("RSA key", func() []byte {
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			Expect(err).NotTo(HaveOccurred())
+
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		}),
+	)
+
+	Context("payloads", func() {
+		It("should ignore the resource evaluation signature", func() {
+			resourceEvaluation := &pb.ResourceEvaluation{
+				Id:          fake.UUID(),
+				PolicyGroup: fake.LetterN(10),
+			}
+			unsigned, err := ResourceEvaluationPayload(resourceEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			resourceEvaluation.Signature = &pb.EvaluationSignature{KeyId: fake.LetterN(10)}
+			signed, err := ResourceEvaluationPayload(resourceEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(signed).To(Equal(unsigned))
+			Expect(resourceEvaluation.Signature).NotTo(BeNil())
+		})
+
+		It("should ignore the policy evaluation signature", func() {
+			policyEvaluation := &pb.PolicyEvaluation{
+				Id:              fake.UUID(),
+				PolicyVersionId: fake.UUID(),
+			}
+			unsigned, err := PolicyEvaluationPayload(policyEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			policyEvaluation.Signature = &pb.EvaluationSignature{KeyId: fake.LetterN(10)}
+			signed, err := PolicyEvaluationPayload(policyEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(signed).To(Equal(unsigned))
+		})
+
+		It("should encode the resource evaluation as JSON with sorted keys", func() {
+			payload, err := ResourceEvaluationPayload(&pb.ResourceEvaluation{
+				Id:              "abc",
+				Pass:            true,
+				PolicyGroup:     "prod",
+				Created:         timestamppb.New(time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)),
+				ResourceVersion: &pb.ResourceVersion{Version: "example.com/app@sha256:123"},
+				State:           pb.ResourceEvaluationState_COMPLETE,
+				InputHash:       "def",
+				OccurrenceNames: []string{"projects/rode/occurrences/1"},
+				ViolationCounts: map[string]int32{"HIGH": 1},
+				PolicyEvaluationDigests: []*pb.PolicyEvaluationDigest{
+					{PolicyEvaluationId: "ghi", PayloadDigest: "jkl"},
+				},
+			}, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(payload)).To(Equal(`{"auditFailures":[],"created":"2021-06-01T12:00:00Z","id":"abc","inputHash":"def",` +
+				`"occurrenceNames":["projects/rode/occurrences/1"],"pass":true,"payloadVersion":"v1",` +
+				`"policyEvaluationDigests":[{"payloadDigest":"jkl","policyEvaluationId":"ghi"}],"policyGroup":"prod",` +
+				`"resourceUri":"example.com/app@sha256:123","severityThreshold":"VIOLATION_SEVERITY_UNSPECIFIED","state":"COMPLETE"}`))
+		})
+
+		It("should encode the policy evaluation as JSON with sorted keys", func() {
+			payload, err := PolicyEvaluationPayload(&pb.PolicyEvaluation{
+				Id:                   "abc",
+				ResourceEvaluationId: "def",
+				PolicyVersionId:      "policy.1",
+				EnforcementMode:      pb.PolicyEnforcementMode_ENFORCED,
+				Violations: []*pb.EvaluatePolicyViolation{
+					{Id: "rule", Message: "failed", Severity: pb.ViolationSeverity_HIGH},
+				},
+			}, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(payload)).To(Equal(`{"enforcementMode":"ENFORCED","id":"abc","pass":false,"payloadVersion":"v1",` +
+				`"policyVersionId":"policy.1","resourceEvaluationId":"def","violations":[{"id":"rule","message":"failed",` +
+				`"pass":false,"severity":"HIGH","waived":false,"waiverId":""}],"waived":false,"waiverIds":[]}`))
+		})
+
+		It("should digest the policy evaluation payload", func() {
+			policyEvaluation := &pb.PolicyEvaluation{
+				Id:              fake.UUID(),
+				PolicyVersionId: fake.UUID(),
+			}
+			payload, err := PolicyEvaluationPayload(policyEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+			expectedDigest := sha256.Sum256(payload)
+
+			digest, err := PolicyEvaluationDigest(policyEvaluation, PayloadVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(digest).To(Equal(hex.EncodeToString(expectedDigest[:])))
+		})
+
+		It("should reject unsupported payload versions", func() {
+			_, err := ResourceEvaluationPayload(&pb.ResourceEvaluation{}, "v0")
+			Expect(err).To(HaveOccurred())
+
+			_, err = PolicyEvaluationPayload(&pb.PolicyEvaluation{}, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioned
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrConflict is returned by Client.Update when the document has changed since it was read
+var ErrConflict = errors.New("document was modified concurrently")
+
+// DocumentVersion identifies a revision of an Elasticsearch document, for optimistic concurrency control
+type DocumentVersion struct {
+	SeqNo       int `json:"_seq_no"`
+	PrimaryTerm int `json:"_primary_term"`
+}
+
+// Document is a stored document and the version it was read at
+type Document struct {
+	DocumentVersion
+	Found  bool            `json:"found"`
+	Source json.RawMessage `json:"_source"`
+}
+
+//go:generate counterfeiter -generate
+
+// Client reads and writes documents along with their sequence number and primary term, so that a
+// read-modify-write only succeeds when nothing else wrote the document in between. The esutil client doesn't expose these.
+//
+//counterfeiter:generate . Client
+type Client interface {
+	Get(ctx context.Context, request *esutil.GetRequest) (*Document, error)
+	Update(ctx context.Context, request *esutil.UpdateRequest, version DocumentVersion) (*DocumentVersion, error)
+}
+
+type client struct {
+	esClient *elasticsearch.Client
+}
+
+// NewClient returns a Client backed by the raw Elasticsearch client
+func NewClient(esClient *elasticsearch.Client) Client {
+	return &client{esClient}
+}
+
+func (c *client) Get(ctx context.Context, request *esutil.GetRequest) (*Document, error) {
+	res, err := c.esClient.Get(request.Index, url.PathEscape(request.DocumentId), c.esClient.Get.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return &Document{}, nil
+	}
+
+	if res.IsError() {
+		return nil, fmt.Errorf("unexpected response from elasticsearch: %s", res.String())
+	}
+
+	var document Document
+	if err := json.NewDecoder(res.Body).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+func (c *client) Update(ctx context.Context, request *esutil.UpdateRequest, version DocumentVersion) (*DocumentVersion, error) {
+	body, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := request.Refresh
+	if refresh == "" {
+		refresh = "true"
+	}
+
+	res, err := c.esClient.Index(
+		request.Index,
+		bytes.NewReader(body),
+		c.esClient.Index.WithContext(ctx),
+		c.esClient.Index.WithDocumentID(url.PathEscape(request.DocumentId)),
+		c.esClient.Index.WithRefresh(refresh),
+		c.esClient.Index.WithIfSeqNo(version.SeqNo),
+		c.esClient.Index.WithIfPrimaryTerm(version.PrimaryTerm),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusConflict {
+		return nil, ErrConflict
+	}
+
+	if res.IsError() {
+		return nil, fmt.Errorf("unexpected response from elasticsearch: %s", res.String())
+	}
+
+	var newVersion DocumentVersion
+	if err := json.NewDecoder(res.Body).Decode(&newVersion); err != nil {
+		return nil, err
+	}
+
+	return &newVersion, nil
+}
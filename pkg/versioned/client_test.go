@@ -0,0 +1,192 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioned
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	pb "github.com/rode/rode/proto/v1alpha1"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx = context.Background()
+
+		server       *httptest.Server
+		client       Client
+		index        string
+		documentId   string
+		statusCode   int
+		responseBody string
+
+		actualRequest *http.Request
+		actualBody    []byte
+	)
+
+	BeforeEach(func() {
+		index = fake.LetterN(10)
+		documentId = fake.LetterN(10)
+		statusCode = http.StatusOK
+		responseBody = "{}"
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			actualRequest = r
+			actualBody, _ = ioutil.ReadAll(r.Body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			fmt.Fprint(w, responseBody)
+		}))
+
+		esClient, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+		Expect(err).NotTo(HaveOccurred())
+
+		client = NewClient(esClient)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("Get", func() {
+		var (
+			actualDocument *Document
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			responseBody = `{"_seq_no": 4, "_primary_term": 2, "found": true, "_source": {"name": "foo"}}`
+		})
+
+		JustBeforeEach(func() {
+			actualDocument, actualError = client.Get(ctx, &esutil.GetRequest{Index: index, DocumentId: documentId})
+		})
+
+		It("should fetch the document", func() {
+			Expect(actualRequest.Method).To(Equal(http.MethodGet))
+			Expect(actualRequest.URL.Path).To(Equal(fmt.Sprintf("/%s/_doc/%s", index, documentId)))
+		})
+
+		It("should return the document and its version", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualDocument.Found).To(BeTrue())
+			Expect(actualDocument.DocumentVersion).To(Equal(DocumentVersion{SeqNo: 4, PrimaryTerm: 2}))
+			Expect(actualDocument.Source).To(MatchJSON(`{"name": "foo"}`))
+		})
+
+		When("the document doesn't exist", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusNotFound
+				responseBody = `{"found": false}`
+			})
+
+			It("should return a document that wasn't found", func() {
+				Expect(actualError).NotTo(HaveOccurred())
+				Expect(actualDocument.Found).To(BeFalse())
+			})
+		})
+
+		When("Elasticsearch returns an error", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+			})
+
+			It("should return an error", func() {
+				Expect(actualDocument).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Update", func() {
+		var (
+			message *pb.PolicyGroup
+			version DocumentVersion
+
+			actualVersion *DocumentVersion
+			actualError   error
+		)
+
+		BeforeEach(func() {
+			message = &pb.PolicyGroup{Name: documentId, Description: fake.Sentence(5)}
+			version = DocumentVersion{SeqNo: fake.Number(1, 100), PrimaryTerm: fake.Number(1, 10)}
+			responseBody = `{"_seq_no": 101, "_primary_term": 11, "result": "updated"}`
+		})
+
+		JustBeforeEach(func() {
+			actualVersion, actualError = client.Update(ctx, &esutil.UpdateRequest{
+				Index:      index,
+				DocumentId: documentId,
+				Refresh:    "wait_for",
+				Message:    message,
+			}, version)
+		})
+
+		It("should index the document on the condition that it's unchanged", func() {
+			Expect(actualRequest.Method).To(Equal(http.MethodPut))
+			Expect(actualRequest.URL.Path).To(Equal(fmt.Sprintf("/%s/_doc/%s", index, documentId)))
+
+			query := actualRequest.URL.Query()
+			Expect(query.Get("if_seq_no")).To(Equal(fmt.Sprint(version.SeqNo)))
+			Expect(query.Get("if_primary_term")).To(Equal(fmt.Sprint(version.PrimaryTerm)))
+			Expect(query.Get("refresh")).To(Equal("wait_for"))
+		})
+
+		It("should send the message, including unpopulated fields", func() {
+			var actualDocument map[string]interface{}
+			Expect(json.Unmarshal(actualBody, &actualDocument)).To(Succeed())
+
+			Expect(actualDocument).To(HaveKeyWithValue("description", message.Description))
+			Expect(actualDocument).To(HaveKeyWithValue("attest", false))
+		})
+
+		It("should return the new version", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualVersion).To(Equal(&DocumentVersion{SeqNo: 101, PrimaryTerm: 11}))
+		})
+
+		When("the document was modified since it was read", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusConflict
+			})
+
+			It("should return a conflict", func() {
+				Expect(actualVersion).To(BeNil())
+				Expect(actualError).To(MatchError(ErrConflict))
+			})
+		})
+
+		When("Elasticsearch returns an error", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+			})
+
+			It("should return an error", func() {
+				Expect(actualVersion).To(BeNil())
+				Expect(actualError).To(HaveOccurred())
+				Expect(actualError).NotTo(MatchError(ErrConflict))
+			})
+		})
+	})
+})
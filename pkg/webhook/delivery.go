@@ -0,0 +1,311 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	eventHeader     = "X-Rode-Event"
+	deliveryHeader  = "X-Rode-Delivery"
+	signatureHeader = "X-Rode-Signature"
+)
+
+func (m *manager) Notify(event *pb.WebhookEvent) {
+	event = proto.Clone(event).(*pb.WebhookEvent)
+	if event.Id == "" {
+		event.Id = newUuid().String()
+	}
+	if event.Created == nil {
+		event.Created = timestamppb.Now()
+	}
+
+	select {
+	case m.events <- event:
+	default:
+		m.logger.Warn("webhook event queue is full, dropping event", zap.String("id", event.Id), zap.Stringer("type", event.Type))
+	}
+}
+
+// webhookCacheTtl is how long the enabled webhooks are cached for delivery. Changes made through this instance clear
+// the cache immediately, while changes made through other instances are picked up once it expires.
+const webhookCacheTtl = 30 * time.Second
+
+// pendingDelivery is a delivery of an event to a webhook that hasn't completed yet
+type pendingDelivery struct {
+	webhook  *pb.Webhook
+	event    *pb.WebhookEvent
+	payload  []byte
+	delivery *pb.WebhookDelivery
+}
+
+// webhookCache holds the enabled webhooks, so that they aren't searched for on every event
+type webhookCache struct {
+	mu       sync.Mutex
+	webhooks []*pb.Webhook
+	expires  time.Time
+}
+
+func (m *manager) startDeliveryWorkers() {
+	for i := 0; i < m.webhookConfig.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case event := <-m.events:
+					m.deliverEvent(context.Background(), event)
+				case pending := <-m.retries:
+					m.attemptDelivery(context.Background(), pending)
+				}
+			}
+		}()
+	}
+}
+
+// deliverEvent makes the first attempt to send an event to every webhook that subscribes to it. Failed attempts are
+// retried later by the delivery workers, see attemptDelivery.
+func (m *manager) deliverEvent(ctx context.Context, event *pb.WebhookEvent) {
+	log := m.logger.Named("deliverEvent").With(zap.String("eventId", event.Id), zap.Stringer("type", event.Type))
+
+	webhooks, err := m.listSubscribedWebhooks(ctx, event.Type)
+	if err != nil {
+		log.Error("error listing webhooks", zap.Error(err))
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		log.Error("error marshalling webhook event", zap.Error(err))
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	for _, webhook := range webhooks {
+		wg.Add(1)
+		go func(webhook *pb.Webhook) {
+			defer wg.Done()
+
+			m.attemptDelivery(ctx, &pendingDelivery{
+				webhook: webhook,
+				event:   event,
+				payload: payload,
+				delivery: &pb.WebhookDelivery{
+					Id:        newUuid().String(),
+					WebhookId: webhook.Id,
+					EventId:   event.Id,
+					EventType: event.Type,
+					Created:   timestamppb.Now(),
+				},
+			})
+		}(webhook)
+	}
+	wg.Wait()
+}
+
+// listSubscribedWebhooks returns the enabled webhooks that subscribe to the event type
+func (m *manager) listSubscribedWebhooks(ctx context.Context, eventType pb.WebhookEventType) ([]*pb.Webhook, error) {
+	enabledWebhooks, err := m.listEnabledWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*pb.Webhook
+	for _, webhook := range enabledWebhooks {
+		if subscribes(webhook, eventType) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+// listEnabledWebhooks returns the enabled webhooks from the cache, searching for them if the cache has expired
+func (m *manager) listEnabledWebhooks(ctx context.Context) ([]*pb.Webhook, error) {
+	m.webhookCache.mu.Lock()
+	defer m.webhookCache.mu.Unlock()
+
+	if time.Now().Before(m.webhookCache.expires) {
+		return m.webhookCache.webhooks, nil
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, &esutil.SearchRequest{
+		Index: m.webhooksAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Term: &filtering.Term{
+					"disabled": "false",
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*pb.Webhook
+	for _, hit := range searchResponse.Hits.Hits {
+		var webhook pb.Webhook
+		if err = protojson.Unmarshal(hit.Source, &webhook); err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, &webhook)
+	}
+
+	m.webhookCache.webhooks = webhooks
+	m.webhookCache.expires = time.Now().Add(webhookCacheTtl)
+
+	return webhooks, nil
+}
+
+// invalidateWebhookCache clears the cached webhooks after a webhook is created, updated, or deleted
+func (m *manager) invalidateWebhookCache() {
+	m.webhookCache.mu.Lock()
+	defer m.webhookCache.mu.Unlock()
+
+	m.webhookCache.webhooks = nil
+	m.webhookCache.expires = time.Time{}
+}
+
+// subscribes checks whether a webhook should receive an event. Webhooks without event types receive every event.
+func subscribes(webhook *pb.Webhook, eventType pb.WebhookEventType) bool {
+	if len(webhook.EventTypes) == 0 {
+		return true
+	}
+
+	for _, subscribedType := range webhook.EventTypes {
+		if subscribedType == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attemptDelivery makes the next attempt to send the event to the webhook. When the attempt fails with an error that a
+// retry could fix, and WebhookConfig.MaxAttempts hasn't been reached, the delivery is queued again after an exponential
+// backoff instead of holding a worker while it waits. Otherwise, the outcome of the delivery is recorded.
+func (m *manager) attemptDelivery(ctx context.Context, pending *pendingDelivery) {
+	delivery := pending.delivery
+	log := m.logger.Named("attemptDelivery").With(zap.String("webhookId", pending.webhook.Id), zap.String("eventId", pending.event.Id))
+
+	delivery.Attempts++
+	statusCode, err := m.send(ctx, pending.webhook, delivery.Id, pending.event.Type, pending.payload)
+	delivery.StatusCode = int32(statusCode)
+	if err == nil {
+		delivery.Success = true
+		delivery.ErrorMessage = ""
+		m.completeDelivery(ctx, log, delivery)
+		return
+	}
+
+	delivery.ErrorMessage = err.Error()
+	log.Debug("webhook delivery attempt failed", zap.Int32("attempt", delivery.Attempts), zap.Error(err))
+
+	if !retryable(statusCode) || int(delivery.Attempts) >= m.webhookConfig.MaxAttempts {
+		m.completeDelivery(ctx, log, delivery)
+		return
+	}
+
+	backoff := m.webhookConfig.InitialBackoff << (delivery.Attempts - 1)
+	time.AfterFunc(backoff, func() {
+		m.retries <- pending
+	})
+}
+
+// completeDelivery records the outcome of a delivery once no more attempts will be made
+func (m *manager) completeDelivery(ctx context.Context, log *zap.Logger, delivery *pb.WebhookDelivery) {
+	delivery.Completed = timestamppb.Now()
+	if !delivery.Success {
+		log.Warn("unable to deliver webhook event", zap.Int32("attempts", delivery.Attempts), zap.String("error", delivery.ErrorMessage))
+	}
+
+	if _, err := m.esClient.Create(ctx, &esutil.CreateRequest{
+		Index:      m.deliveriesAlias(),
+		Refresh:    m.esConfig.Refresh.String(),
+		Message:    delivery,
+		DocumentId: delivery.Id,
+	}); err != nil {
+		log.Error("error storing webhook delivery", zap.Error(err))
+	}
+}
+
+// send makes a single delivery attempt, returning the response status code if a response was received
+func (m *manager) send(ctx context.Context, webhook *pb.Webhook, deliveryId string, eventType pb.WebhookEventType, payload []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(eventHeader, eventType.String())
+	request.Header.Set(deliveryHeader, deliveryId)
+	if webhook.Secret != "" {
+		request.Header.Set(signatureHeader, "sha256="+sign(webhook.Secret, payload))
+	}
+
+	response, err := m.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("unexpected response status code %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// retryable determines whether a failed attempt should be retried. Requests that never received a response, server
+// errors, and rate limiting are retried, while other client errors are not.
+func retryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of the payload
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
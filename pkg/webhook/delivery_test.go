@@ -0,0 +1,395 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/config"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("webhook delivery", func() {
+	var (
+		ctx             = context.Background()
+		esClient        *esutilfakes.FakeClient
+		webhookConfig   *config.WebhookConfig
+		deliveriesAlias string
+		webhookManager  *manager
+
+		server    *httptest.Server
+		requests  *recordedRequests
+		responses []int
+
+		webhook *pb.Webhook
+		event   *pb.WebhookEvent
+	)
+
+	BeforeEach(func() {
+		esClient = &esutilfakes.FakeClient{}
+		webhookConfig = &config.WebhookConfig{
+			QueueSize:   1,
+			MaxAttempts: 3,
+		}
+		deliveriesAlias = fake.LetterN(10)
+		indexManager := &immocks.FakeIndexManager{}
+		indexManager.AliasNameReturns(deliveriesAlias)
+
+		requests = &recordedRequests{}
+		responses = []int{http.StatusOK}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempt := requests.record(r)
+			if attempt >= len(responses) {
+				attempt = len(responses) - 1
+			}
+
+			w.WriteHeader(responses[attempt])
+		}))
+
+		webhookManager = NewManager(logger, esClient, &config.ElasticsearchConfig{Refresh: config.RefreshTrue}, webhookConfig, indexManager, &filteringfakes.FakeFilterer{}).(*manager)
+
+		webhook = randomWebhook()
+		webhook.Url = server.URL
+		event = &pb.WebhookEvent{
+			Id:   fake.UUID(),
+			Type: pb.WebhookEventType_RESOURCE_EVALUATION_FAILED,
+			Data: &pb.WebhookEvent_ResourceEvaluationResult{
+				ResourceEvaluationResult: &pb.ResourceEvaluationResult{
+					ResourceEvaluation: &pb.ResourceEvaluation{Id: fake.UUID()},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("Notify", func() {
+		It("should queue a copy of the event with an id and timestamp", func() {
+			event.Id = ""
+
+			webhookManager.Notify(event)
+
+			var queued *pb.WebhookEvent
+			Expect(webhookManager.events).To(Receive(&queued))
+			Expect(queued).NotTo(BeIdenticalTo(event))
+			Expect(queued.Id).NotTo(BeEmpty())
+			Expect(queued.Created.IsValid()).To(BeTrue())
+		})
+
+		It("should drop events when the queue is full", func() {
+			webhookManager.Notify(event)
+			webhookManager.Notify(event)
+
+			Expect(webhookManager.events).To(HaveLen(1))
+		})
+	})
+
+	Context("deliverEvent", func() {
+		var (
+			webhooks       []*pb.Webhook
+			deliverRetries func(int)
+		)
+
+		BeforeEach(func() {
+			webhooks = []*pb.Webhook{webhook}
+			// there aren't any delivery workers, so the queued retries are attempted here instead
+			deliverRetries = func(count int) {
+				for i := 0; i < count; i++ {
+					var retry *pendingDelivery
+					Eventually(webhookManager.retries).Should(Receive(&retry))
+					webhookManager.attemptDelivery(ctx, retry)
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			var hits []*esutil.EsSearchResponseHit
+			for _, w := range webhooks {
+				webhookJson, _ := protojson.Marshal(w)
+				hits = append(hits, &esutil.EsSearchResponseHit{ID: w.Id, Source: webhookJson})
+			}
+			esClient.SearchReturns(&esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{Hits: hits},
+			}, nil)
+
+			webhookManager.deliverEvent(ctx, event)
+		})
+
+		It("should only search for enabled webhooks", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+
+			_, searchRequest := esClient.SearchArgsForCall(0)
+			Expect(searchRequest.Search.Query.Term).To(Equal(&filtering.Term{"disabled": "false"}))
+		})
+
+		It("should send the event to the webhook", func() {
+			Expect(requests.count()).To(Equal(1))
+
+			request := requests.get(0)
+			Expect(request.method).To(Equal(http.MethodPost))
+			Expect(request.header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(request.header.Get(eventHeader)).To(Equal(event.Type.String()))
+			Expect(request.header.Get(deliveryHeader)).NotTo(BeEmpty())
+
+			var actualEvent pb.WebhookEvent
+			Expect(protojson.Unmarshal(request.body, &actualEvent)).To(Succeed())
+			Expect(proto.Equal(&actualEvent, event)).To(BeTrue())
+		})
+
+		It("should sign the payload with the webhook secret", func() {
+			request := requests.get(0)
+
+			Expect(request.header.Get(signatureHeader)).To(Equal("sha256=" + sign(webhook.Secret, request.body)))
+		})
+
+		It("should record the delivery", func() {
+			Expect(esClient.CreateCallCount()).To(Equal(1))
+
+			_, createRequest := esClient.CreateArgsForCall(0)
+			delivery := createRequest.Message.(*pb.WebhookDelivery)
+
+			Expect(createRequest.Index).To(Equal(deliveriesAlias))
+			Expect(createRequest.DocumentId).To(Equal(delivery.Id))
+			Expect(delivery.Id).To(Equal(requests.get(0).header.Get(deliveryHeader)))
+			Expect(delivery.WebhookId).To(Equal(webhook.Id))
+			Expect(delivery.EventId).To(Equal(event.Id))
+			Expect(delivery.EventType).To(Equal(event.Type))
+			Expect(delivery.Success).To(BeTrue())
+			Expect(delivery.Attempts).To(BeEquivalentTo(1))
+			Expect(delivery.StatusCode).To(BeEquivalentTo(http.StatusOK))
+			Expect(delivery.Completed.IsValid()).To(BeTrue())
+		})
+
+		When("the webhook doesn't have a secret", func() {
+			BeforeEach(func() {
+				webhook.Secret = ""
+			})
+
+			It("should not sign the payload", func() {
+				Expect(requests.get(0).header.Get(signatureHeader)).To(BeEmpty())
+			})
+		})
+
+		When("the webhook subscribes to other events", func() {
+			BeforeEach(func() {
+				webhook.EventTypes = []pb.WebhookEventType{pb.WebhookEventType_POLICY_CREATED}
+			})
+
+			It("should not send the event", func() {
+				Expect(requests.count()).To(Equal(0))
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the webhook doesn't specify event types", func() {
+			BeforeEach(func() {
+				webhook.EventTypes = nil
+			})
+
+			It("should send the event", func() {
+				Expect(requests.count()).To(Equal(1))
+			})
+		})
+
+		When("there are multiple subscribed webhooks", func() {
+			BeforeEach(func() {
+				otherWebhook := randomWebhook()
+				otherWebhook.Url = server.URL
+				webhooks = append(webhooks, otherWebhook)
+			})
+
+			It("should send the event to each webhook", func() {
+				Expect(requests.count()).To(Equal(2))
+				Expect(esClient.CreateCallCount()).To(Equal(2))
+			})
+		})
+
+		It("should cache the enabled webhooks", func() {
+			webhookManager.deliverEvent(ctx, event)
+
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+			Expect(requests.count()).To(Equal(2))
+		})
+
+		When("the webhook cache has expired", func() {
+			It("should search for the webhooks again", func() {
+				webhookManager.webhookCache.expires = time.Now().Add(-time.Second)
+
+				webhookManager.deliverEvent(ctx, event)
+
+				Expect(esClient.SearchCallCount()).To(Equal(2))
+			})
+		})
+
+		When("a webhook is created", func() {
+			It("should clear the webhook cache", func() {
+				_, err := webhookManager.CreateWebhook(ctx, randomWebhook())
+				Expect(err).NotTo(HaveOccurred())
+
+				webhookManager.deliverEvent(ctx, event)
+
+				Expect(esClient.SearchCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the webhook responds with a server error", func() {
+			BeforeEach(func() {
+				responses = []int{http.StatusInternalServerError, http.StatusOK}
+			})
+
+			It("should queue a retry instead of waiting for it", func() {
+				Expect(requests.count()).To(Equal(1))
+				Expect(esClient.CreateCallCount()).To(Equal(0))
+				Eventually(webhookManager.retries).Should(HaveLen(1))
+			})
+
+			It("should retry the delivery", func() {
+				deliverRetries(1)
+
+				Expect(requests.count()).To(Equal(2))
+				Expect(requests.get(1).header.Get(deliveryHeader)).To(Equal(requests.get(0).header.Get(deliveryHeader)))
+
+				_, createRequest := esClient.CreateArgsForCall(0)
+				delivery := createRequest.Message.(*pb.WebhookDelivery)
+
+				Expect(delivery.Success).To(BeTrue())
+				Expect(delivery.Attempts).To(BeEquivalentTo(2))
+				Expect(delivery.ErrorMessage).To(BeEmpty())
+			})
+		})
+
+		When("the webhook never succeeds", func() {
+			BeforeEach(func() {
+				responses = []int{http.StatusServiceUnavailable}
+			})
+
+			It("should give up after the maximum number of attempts", func() {
+				deliverRetries(webhookConfig.MaxAttempts - 1)
+
+				Expect(requests.count()).To(Equal(webhookConfig.MaxAttempts))
+				Consistently(webhookManager.retries).Should(BeEmpty())
+
+				_, createRequest := esClient.CreateArgsForCall(0)
+				delivery := createRequest.Message.(*pb.WebhookDelivery)
+
+				Expect(delivery.Success).To(BeFalse())
+				Expect(delivery.Attempts).To(BeEquivalentTo(webhookConfig.MaxAttempts))
+				Expect(delivery.StatusCode).To(BeEquivalentTo(http.StatusServiceUnavailable))
+				Expect(delivery.ErrorMessage).NotTo(BeEmpty())
+			})
+		})
+
+		When("the webhook responds with a client error", func() {
+			BeforeEach(func() {
+				responses = []int{http.StatusBadRequest}
+			})
+
+			It("should not retry the delivery", func() {
+				Expect(requests.count()).To(Equal(1))
+
+				_, createRequest := esClient.CreateArgsForCall(0)
+				delivery := createRequest.Message.(*pb.WebhookDelivery)
+
+				Expect(delivery.Success).To(BeFalse())
+				Expect(delivery.StatusCode).To(BeEquivalentTo(http.StatusBadRequest))
+			})
+		})
+
+		When("the webhook can't be reached", func() {
+			BeforeEach(func() {
+				webhook.Url = "http://" + fake.LetterN(10) + ".invalid"
+			})
+
+			It("should record the failure", func() {
+				deliverRetries(webhookConfig.MaxAttempts - 1)
+
+				_, createRequest := esClient.CreateArgsForCall(0)
+				delivery := createRequest.Message.(*pb.WebhookDelivery)
+
+				Expect(delivery.Success).To(BeFalse())
+				Expect(delivery.Attempts).To(BeEquivalentTo(webhookConfig.MaxAttempts))
+				Expect(delivery.StatusCode).To(BeEquivalentTo(0))
+			})
+		})
+	})
+
+	When("listing webhooks fails", func() {
+		It("should not send the event", func() {
+			esClient.SearchReturns(nil, errors.New("search error"))
+
+			webhookManager.deliverEvent(ctx, event)
+
+			Expect(requests.count()).To(Equal(0))
+			Expect(esClient.CreateCallCount()).To(Equal(0))
+		})
+	})
+})
+
+type recordedRequest struct {
+	method string
+	header http.Header
+	body   []byte
+}
+
+type recordedRequests struct {
+	mu       sync.Mutex
+	requests []*recordedRequest
+}
+
+// record stores the request, returning the number of requests that were received before it
+func (r *recordedRequests) record(request *http.Request) int {
+	body, _ := ioutil.ReadAll(request.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, &recordedRequest{
+		method: request.Method,
+		header: request.Header.Clone(),
+		body:   body,
+	})
+
+	return len(r.requests) - 1
+}
+
+func (r *recordedRequests) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.requests)
+}
+
+func (r *recordedRequests) get(i int) *recordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.requests[i]
+}
@@ -0,0 +1,379 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/rode/es-index-manager/indexmanager"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/util"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate . Manager
+type Manager interface {
+	CreateWebhook(context.Context, *pb.Webhook) (*pb.Webhook, error)
+	GetWebhook(context.Context, *pb.GetWebhookRequest) (*pb.Webhook, error)
+	UpdateWebhook(context.Context, *pb.Webhook) (*pb.Webhook, error)
+	DeleteWebhook(context.Context, *pb.DeleteWebhookRequest) (*emptypb.Empty, error)
+	ListWebhooks(context.Context, *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error)
+	ListWebhookDeliveries(context.Context, *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error)
+	Notifier
+}
+
+// Notifier queues events to be delivered to the webhooks that subscribe to them. Delivery happens in the background,
+// so Notify never blocks the caller.
+//
+//counterfeiter:generate . Notifier
+type Notifier interface {
+	Notify(*pb.WebhookEvent)
+}
+
+type WebhookManager Manager
+
+type manager struct {
+	logger        *zap.Logger
+	esClient      esutil.Client
+	esConfig      *config.ElasticsearchConfig
+	webhookConfig *config.WebhookConfig
+	indexManager  indexmanager.IndexManager
+	filterer      filtering.Filterer
+	httpClient    *http.Client
+	events        chan *pb.WebhookEvent
+	retries       chan *pendingDelivery
+	webhookCache  webhookCache
+}
+
+func NewManager(
+	logger *zap.Logger,
+	esClient esutil.Client,
+	esConfig *config.ElasticsearchConfig,
+	webhookConfig *config.WebhookConfig,
+	indexManager indexmanager.IndexManager,
+	filterer filtering.Filterer,
+) Manager {
+	m := &manager{
+		logger:        logger,
+		esClient:      esClient,
+		esConfig:      esConfig,
+		webhookConfig: webhookConfig,
+		indexManager:  indexManager,
+		filterer:      filterer,
+		httpClient: &http.Client{
+			Timeout: webhookConfig.Timeout,
+		},
+		events:  make(chan *pb.WebhookEvent, webhookConfig.QueueSize),
+		retries: make(chan *pendingDelivery, webhookConfig.QueueSize),
+	}
+	m.startDeliveryWorkers()
+
+	return m
+}
+
+var newUuid = uuid.New
+
+func (m *manager) CreateWebhook(ctx context.Context, webhook *pb.Webhook) (*pb.Webhook, error) {
+	log := m.logger.Named("CreateWebhook").With(zap.String("name", webhook.Name))
+	log.Debug("received request")
+
+	if err := validateWebhook(log, webhook); err != nil {
+		return nil, err
+	}
+
+	currentTime := timestamppb.Now()
+	webhook.Id = newUuid().String()
+	webhook.Created = currentTime
+	webhook.Updated = currentTime
+
+	if _, err := m.esClient.Create(ctx, &esutil.CreateRequest{
+		Index:      m.webhooksAlias(),
+		Refresh:    m.esConfig.Refresh.String(),
+		Message:    webhook,
+		DocumentId: webhook.Id,
+	}); err != nil {
+		return nil, util.GrpcInternalError(log, "error creating webhook", err)
+	}
+	m.invalidateWebhookCache()
+
+	return redactWebhook(webhook), nil
+}
+
+func (m *manager) GetWebhook(ctx context.Context, request *pb.GetWebhookRequest) (*pb.Webhook, error) {
+	log := m.logger.Named("GetWebhook").With(zap.String("id", request.Id))
+	log.Debug("received request")
+
+	webhook, err := m.getWebhook(ctx, log, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return redactWebhook(webhook), nil
+}
+
+func (m *manager) UpdateWebhook(ctx context.Context, webhook *pb.Webhook) (*pb.Webhook, error) {
+	log := m.logger.Named("UpdateWebhook").With(zap.String("id", webhook.Id))
+	log.Debug("received request")
+
+	currentWebhook, err := m.getWebhook(ctx, log, webhook.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhook(log, webhook); err != nil {
+		return nil, err
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret = currentWebhook.Secret
+	}
+	webhook.Created = currentWebhook.Created // prevent updates from overwriting the original timestamp
+	webhook.Updated = timestamppb.Now()
+
+	if _, err := m.esClient.Update(ctx, &esutil.UpdateRequest{
+		Index:      m.webhooksAlias(),
+		DocumentId: webhook.Id,
+		Refresh:    m.esConfig.Refresh.String(),
+		Message:    webhook,
+	}); err != nil {
+		return nil, util.GrpcInternalError(log, "error updating webhook", err)
+	}
+	m.invalidateWebhookCache()
+
+	return redactWebhook(webhook), nil
+}
+
+func (m *manager) DeleteWebhook(ctx context.Context, request *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	log := m.logger.Named("DeleteWebhook").With(zap.String("id", request.Id))
+	log.Debug("received request")
+
+	if _, err := m.getWebhook(ctx, log, request.Id); err != nil {
+		return nil, err
+	}
+
+	if err := m.esClient.Delete(ctx, &esutil.DeleteRequest{
+		Index: m.webhooksAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Term: &filtering.Term{
+					"_id": request.Id,
+				},
+			},
+		},
+		Refresh: m.esConfig.Refresh.String(),
+	}); err != nil {
+		return nil, util.GrpcInternalError(log, "error deleting webhook", err)
+	}
+	m.invalidateWebhookCache()
+
+	return &emptypb.Empty{}, nil
+}
+
+func (m *manager) ListWebhooks(ctx context.Context, request *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	log := m.logger.Named("ListWebhooks")
+	log.Debug("received request", zap.Any("request", request))
+
+	queries := filtering.Must{}
+
+	if request.Filter != "" {
+		filterQuery, err := m.filterer.ParseExpression(request.Filter)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, "error creating filter query", err)
+		}
+
+		queries = append(queries, filterQuery)
+	}
+
+	searchRequest := &esutil.SearchRequest{
+		Index: m.webhooksAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Bool: &filtering.Bool{
+					Must: &queries,
+				},
+			},
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+		},
+	}
+
+	if request.PageSize != 0 {
+		searchRequest.Pagination = &esutil.SearchPaginationOptions{
+			Size:  int(request.PageSize),
+			Token: request.PageToken,
+		}
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, searchRequest)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error searching for webhooks", err)
+	}
+
+	response := &pb.ListWebhooksResponse{
+		NextPageToken: searchResponse.NextPageToken,
+	}
+	for _, hit := range searchResponse.Hits.Hits {
+		var webhook pb.Webhook
+		if err = protojson.Unmarshal(hit.Source, &webhook); err != nil {
+			return nil, util.GrpcInternalError(log, "error unmarshalling webhook", err)
+		}
+
+		response.Webhooks = append(response.Webhooks, redactWebhook(&webhook))
+	}
+
+	return response, nil
+}
+
+func (m *manager) ListWebhookDeliveries(ctx context.Context, request *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	log := m.logger.Named("ListWebhookDeliveries").With(zap.String("webhookId", request.WebhookId))
+	log.Debug("received request", zap.Any("request", request))
+
+	if request.WebhookId == "" {
+		return nil, util.GrpcErrorWithCode(log, "webhook id is required", nil, codes.InvalidArgument)
+	}
+
+	queries := filtering.Must{
+		&filtering.Query{
+			Term: &filtering.Term{
+				"webhookId": request.WebhookId,
+			},
+		},
+	}
+
+	if request.Filter != "" {
+		filterQuery, err := m.filterer.ParseExpression(request.Filter)
+		if err != nil {
+			return nil, util.GrpcInternalError(log, "error creating filter query", err)
+		}
+
+		queries = append(queries, filterQuery)
+	}
+
+	searchRequest := &esutil.SearchRequest{
+		Index: m.deliveriesAlias(),
+		Search: &esutil.EsSearch{
+			Query: &filtering.Query{
+				Bool: &filtering.Bool{
+					Must: &queries,
+				},
+			},
+			Sort: map[string]esutil.EsSortOrder{
+				"created": esutil.EsSortOrderDescending,
+			},
+		},
+	}
+
+	if request.PageSize != 0 {
+		searchRequest.Pagination = &esutil.SearchPaginationOptions{
+			Size:  int(request.PageSize),
+			Token: request.PageToken,
+		}
+	}
+
+	searchResponse, err := m.esClient.Search(ctx, searchRequest)
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error searching for webhook deliveries", err)
+	}
+
+	response := &pb.ListWebhookDeliveriesResponse{
+		NextPageToken: searchResponse.NextPageToken,
+	}
+	for _, hit := range searchResponse.Hits.Hits {
+		var delivery pb.WebhookDelivery
+		if err = protojson.Unmarshal(hit.Source, &delivery); err != nil {
+			return nil, util.GrpcInternalError(log, "error unmarshalling webhook delivery", err)
+		}
+
+		response.WebhookDeliveries = append(response.WebhookDeliveries, &delivery)
+	}
+
+	return response, nil
+}
+
+// getWebhook fetches a webhook, including its secret
+func (m *manager) getWebhook(ctx context.Context, log *zap.Logger, id string) (*pb.Webhook, error) {
+	if id == "" {
+		return nil, util.GrpcErrorWithCode(log, "webhook id is required", nil, codes.InvalidArgument)
+	}
+
+	response, err := m.esClient.Get(ctx, &esutil.GetRequest{
+		Index:      m.webhooksAlias(),
+		DocumentId: id,
+	})
+	if err != nil {
+		return nil, util.GrpcInternalError(log, "error retrieving webhook", err)
+	}
+
+	if !response.Found {
+		return nil, util.GrpcErrorWithCode(log, "webhook not found", nil, codes.NotFound)
+	}
+
+	var webhook pb.Webhook
+	if err = protojson.Unmarshal(response.Source, &webhook); err != nil {
+		return nil, util.GrpcInternalError(log, "error unmarshalling webhook", err)
+	}
+
+	return &webhook, nil
+}
+
+func (m *manager) webhooksAlias() string {
+	return m.indexManager.AliasName(constants.WebhooksDocumentKind, "")
+}
+
+func (m *manager) deliveriesAlias() string {
+	return m.indexManager.AliasName(constants.WebhookDeliveriesDocumentKind, "")
+}
+
+func validateWebhook(log *zap.Logger, webhook *pb.Webhook) error {
+	if webhook.Name == "" {
+		return util.GrpcErrorWithCode(log, "webhook name is required", nil, codes.InvalidArgument)
+	}
+
+	webhookUrl, err := url.Parse(webhook.Url)
+	if err != nil || (webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https") || webhookUrl.Host == "" {
+		return util.GrpcErrorWithCode(log, "webhook url must be an absolute http or https url", err, codes.InvalidArgument)
+	}
+
+	for _, eventType := range webhook.EventTypes {
+		if _, ok := pb.WebhookEventType_name[int32(eventType)]; !ok || eventType == pb.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED {
+			return util.GrpcErrorWithCode(log, "invalid webhook event type", nil, codes.InvalidArgument, zap.Int32("eventType", int32(eventType)))
+		}
+	}
+
+	return nil
+}
+
+// redactWebhook returns a copy of the webhook without its secret, so that the secret isn't returned to clients
+func redactWebhook(webhook *pb.Webhook) *pb.Webhook {
+	redacted := proto.Clone(webhook).(*pb.Webhook)
+	redacted.Secret = ""
+
+	return redacted
+}
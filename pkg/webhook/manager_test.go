@@ -0,0 +1,617 @@
+// Copyright 2021 The Rode Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	immocks "github.com/rode/es-index-manager/mocks"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/esutil/esutilfakes"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering"
+	"github.com/rode/grafeas-elasticsearch/go/v1beta1/storage/filtering/filteringfakes"
+	"github.com/rode/rode/config"
+	"github.com/rode/rode/pkg/constants"
+	pb "github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("webhook manager", func() {
+	var (
+		ctx                     = context.Background()
+		expectedWebhooksAlias   string
+		expectedDeliveriesAlias string
+		esClient                *esutilfakes.FakeClient
+		esConfig                *config.ElasticsearchConfig
+		indexManager            *immocks.FakeIndexManager
+		filterer                *filteringfakes.FakeFilterer
+		manager                 Manager
+	)
+
+	BeforeEach(func() {
+		esClient = &esutilfakes.FakeClient{}
+		esConfig = &config.ElasticsearchConfig{
+			Refresh: config.RefreshTrue,
+		}
+		indexManager = &immocks.FakeIndexManager{}
+		filterer = &filteringfakes.FakeFilterer{}
+
+		expectedWebhooksAlias = fake.LetterN(10)
+		expectedDeliveriesAlias = fake.LetterN(10)
+		indexManager.AliasNameStub = func(documentKind, _ string) string {
+			return map[string]string{
+				constants.WebhooksDocumentKind:          expectedWebhooksAlias,
+				constants.WebhookDeliveriesDocumentKind: expectedDeliveriesAlias,
+			}[documentKind]
+		}
+
+		// no workers are started, so events stay on the queue
+		manager = NewManager(logger, esClient, esConfig, &config.WebhookConfig{QueueSize: 1}, indexManager, filterer)
+	})
+
+	Context("CreateWebhook", func() {
+		var (
+			webhook     *pb.Webhook
+			createError error
+
+			actualWebhook *pb.Webhook
+			actualError   error
+		)
+
+		BeforeEach(func() {
+			webhook = randomWebhook()
+			webhook.Id = ""
+			createError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.CreateReturns("", createError)
+
+			actualWebhook, actualError = manager.CreateWebhook(ctx, proto.Clone(webhook).(*pb.Webhook))
+		})
+
+		It("should create the webhook", func() {
+			Expect(esClient.CreateCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.CreateArgsForCall(0)
+			actualMessage := actualRequest.Message.(*pb.Webhook)
+
+			Expect(actualRequest.Index).To(Equal(expectedWebhooksAlias))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(actualRequest.DocumentId).To(Equal(actualMessage.Id))
+			Expect(actualMessage.Name).To(Equal(webhook.Name))
+			Expect(actualMessage.Url).To(Equal(webhook.Url))
+			Expect(actualMessage.EventTypes).To(Equal(webhook.EventTypes))
+			Expect(actualMessage.Secret).To(Equal(webhook.Secret))
+		})
+
+		It("should return the new webhook without its secret", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWebhook.Id).NotTo(BeEmpty())
+			Expect(actualWebhook.Name).To(Equal(webhook.Name))
+			Expect(actualWebhook.Secret).To(BeEmpty())
+			Expect(actualWebhook.Created.IsValid()).To(BeTrue())
+			Expect(actualWebhook.Updated.IsValid()).To(BeTrue())
+		})
+
+		table.DescribeTable("validation", func(mutate func(*pb.Webhook)) {
+			invalidWebhook := randomWebhook()
+			mutate(invalidWebhook)
+
+			response, err := manager.CreateWebhook(ctx, invalidWebhook)
+
+			Expect(response).To(BeNil())
+			Expect(getGRPCStatusFromError(err).Code()).To(Equal(codes.InvalidArgument))
+		},
+			table.Entry("missing name", func(w *pb.Webhook) { w.Name = "" }),
+			table.Entry("missing url", func(w *pb.Webhook) { w.Url = "" }),
+			table.Entry("relative url", func(w *pb.Webhook) { w.Url = "/" + fake.Word() }),
+			table.Entry("unsupported scheme", func(w *pb.Webhook) { w.Url = "ftp://" + fake.DomainName() }),
+			table.Entry("unspecified event type", func(w *pb.Webhook) {
+				w.EventTypes = append(w.EventTypes, pb.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED)
+			}),
+			table.Entry("unknown event type", func(w *pb.Webhook) {
+				w.EventTypes = append(w.EventTypes, pb.WebhookEventType(1000))
+			}),
+		)
+
+		When("an error occurs creating the webhook", func() {
+			BeforeEach(func() {
+				createError = errors.New("create error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWebhook).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("GetWebhook", func() {
+		var (
+			webhook     *pb.Webhook
+			getResponse *esutil.EsGetResponse
+			getError    error
+
+			actualWebhook *pb.Webhook
+			actualError   error
+		)
+
+		BeforeEach(func() {
+			webhook = randomWebhook()
+			webhookJson, _ := protojson.Marshal(webhook)
+			getResponse = &esutil.EsGetResponse{
+				Id:     webhook.Id,
+				Found:  true,
+				Source: webhookJson,
+			}
+			getError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.GetReturns(getResponse, getError)
+
+			actualWebhook, actualError = manager.GetWebhook(ctx, &pb.GetWebhookRequest{Id: webhook.Id})
+		})
+
+		It("should fetch the webhook", func() {
+			Expect(esClient.GetCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.GetArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWebhooksAlias))
+			Expect(actualRequest.DocumentId).To(Equal(webhook.Id))
+		})
+
+		It("should return the webhook without its secret", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWebhook.Id).To(Equal(webhook.Id))
+			Expect(actualWebhook.Url).To(Equal(webhook.Url))
+			Expect(actualWebhook.Secret).To(BeEmpty())
+		})
+
+		When("the id is empty", func() {
+			BeforeEach(func() {
+				webhook.Id = ""
+			})
+
+			It("should return an error", func() {
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.GetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the webhook is not found", func() {
+			BeforeEach(func() {
+				getResponse.Found = false
+			})
+
+			It("should return an error", func() {
+				Expect(actualWebhook).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+			})
+		})
+
+		When("an error occurs fetching the webhook", func() {
+			BeforeEach(func() {
+				getError = errors.New("get error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWebhook).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+
+		When("the webhook document is invalid", func() {
+			BeforeEach(func() {
+				getResponse.Source = invalidJson
+			})
+
+			It("should return an error", func() {
+				Expect(actualWebhook).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("UpdateWebhook", func() {
+		var (
+			currentWebhook *pb.Webhook
+			webhook        *pb.Webhook
+			updateError    error
+
+			actualWebhook *pb.Webhook
+			actualError   error
+		)
+
+		BeforeEach(func() {
+			currentWebhook = randomWebhook()
+			currentWebhook.Created = timestamppb.New(fake.Date())
+			webhookJson, _ := protojson.Marshal(currentWebhook)
+			esClient.GetReturns(&esutil.EsGetResponse{
+				Id:     currentWebhook.Id,
+				Found:  true,
+				Source: webhookJson,
+			}, nil)
+
+			webhook = randomWebhook()
+			webhook.Id = currentWebhook.Id
+			webhook.Created = nil
+			updateError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.UpdateReturns(nil, updateError)
+
+			actualWebhook, actualError = manager.UpdateWebhook(ctx, proto.Clone(webhook).(*pb.Webhook))
+		})
+
+		It("should update the webhook", func() {
+			Expect(esClient.UpdateCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.UpdateArgsForCall(0)
+			actualMessage := actualRequest.Message.(*pb.Webhook)
+
+			Expect(actualRequest.Index).To(Equal(expectedWebhooksAlias))
+			Expect(actualRequest.DocumentId).To(Equal(webhook.Id))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(actualMessage.Url).To(Equal(webhook.Url))
+			Expect(actualMessage.Secret).To(Equal(webhook.Secret))
+			Expect(actualMessage.Created).To(Equal(currentWebhook.Created))
+		})
+
+		It("should return the updated webhook without its secret", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualWebhook.Url).To(Equal(webhook.Url))
+			Expect(actualWebhook.Secret).To(BeEmpty())
+			Expect(actualWebhook.Updated.IsValid()).To(BeTrue())
+		})
+
+		When("the secret is not set", func() {
+			BeforeEach(func() {
+				webhook.Secret = ""
+			})
+
+			It("should keep the existing secret", func() {
+				_, actualRequest := esClient.UpdateArgsForCall(0)
+
+				Expect(actualRequest.Message.(*pb.Webhook).Secret).To(Equal(currentWebhook.Secret))
+			})
+		})
+
+		When("the update is invalid", func() {
+			BeforeEach(func() {
+				webhook.Url = ""
+			})
+
+			It("should return an error", func() {
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.UpdateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an error occurs updating the webhook", func() {
+			BeforeEach(func() {
+				updateError = errors.New("update error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualWebhook).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("DeleteWebhook", func() {
+		var (
+			webhook     *pb.Webhook
+			found       bool
+			deleteError error
+
+			actualResponse *emptypb.Empty
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			webhook = randomWebhook()
+			found = true
+			deleteError = nil
+		})
+
+		JustBeforeEach(func() {
+			webhookJson, _ := protojson.Marshal(webhook)
+			esClient.GetReturns(&esutil.EsGetResponse{Found: found, Source: webhookJson}, nil)
+			esClient.DeleteReturns(deleteError)
+
+			actualResponse, actualError = manager.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Id: webhook.Id})
+		})
+
+		It("should delete the webhook", func() {
+			Expect(esClient.DeleteCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.DeleteArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWebhooksAlias))
+			Expect(actualRequest.Refresh).To(Equal(esConfig.Refresh.String()))
+			Expect(actualRequest.Search.Query.Term).To(Equal(&filtering.Term{"_id": webhook.Id}))
+		})
+
+		It("should not return an error", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse).To(Equal(&emptypb.Empty{}))
+		})
+
+		When("the webhook doesn't exist", func() {
+			BeforeEach(func() {
+				found = false
+			})
+
+			It("should return an error", func() {
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.NotFound))
+				Expect(esClient.DeleteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an error occurs deleting the webhook", func() {
+			BeforeEach(func() {
+				deleteError = errors.New("delete error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("ListWebhooks", func() {
+		var (
+			request        *pb.ListWebhooksRequest
+			webhook        *pb.Webhook
+			searchResponse *esutil.SearchResponse
+			searchError    error
+
+			actualResponse *pb.ListWebhooksResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			request = &pb.ListWebhooksRequest{}
+			webhook = randomWebhook()
+			webhookJson, _ := protojson.Marshal(webhook)
+			searchResponse = &esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Hits: []*esutil.EsSearchResponseHit{
+						{ID: webhook.Id, Source: webhookJson},
+					},
+				},
+			}
+			searchError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.SearchReturns(searchResponse, searchError)
+
+			actualResponse, actualError = manager.ListWebhooks(ctx, request)
+		})
+
+		It("should search for webhooks, newest first", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.SearchArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedWebhooksAlias))
+			Expect(*actualRequest.Search.Query.Bool.Must).To(BeEmpty())
+			Expect(actualRequest.Search.Sort["created"]).To(Equal(esutil.EsSortOrderDescending))
+			Expect(actualRequest.Pagination).To(BeNil())
+		})
+
+		It("should return the webhooks without their secrets", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.Webhooks).To(HaveLen(1))
+			Expect(actualResponse.Webhooks[0].Id).To(Equal(webhook.Id))
+			Expect(actualResponse.Webhooks[0].Secret).To(BeEmpty())
+		})
+
+		When("a filter is specified", func() {
+			var filterQuery *filtering.Query
+
+			BeforeEach(func() {
+				request.Filter = fake.Word()
+				filterQuery = &filtering.Query{Term: &filtering.Term{fake.Word(): fake.Word()}}
+				filterer.ParseExpressionReturns(filterQuery, nil)
+			})
+
+			It("should include the filter query", func() {
+				Expect(filterer.ParseExpressionArgsForCall(0)).To(Equal(request.Filter))
+
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(*actualRequest.Search.Query.Bool.Must).To(ConsistOf(filterQuery))
+			})
+
+			When("the filter is invalid", func() {
+				BeforeEach(func() {
+					filterer.ParseExpressionReturns(nil, errors.New("parse error"))
+				})
+
+				It("should return an error", func() {
+					Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+					Expect(esClient.SearchCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("pagination options are specified", func() {
+			BeforeEach(func() {
+				request.PageSize = int32(fake.Number(1, 10))
+				request.PageToken = fake.Word()
+				searchResponse.NextPageToken = fake.Word()
+			})
+
+			It("should paginate the search", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(actualRequest.Pagination.Size).To(BeEquivalentTo(request.PageSize))
+				Expect(actualRequest.Pagination.Token).To(Equal(request.PageToken))
+			})
+
+			It("should return the next page token", func() {
+				Expect(actualResponse.NextPageToken).To(Equal(searchResponse.NextPageToken))
+			})
+		})
+
+		When("the search fails", func() {
+			BeforeEach(func() {
+				searchError = errors.New("search error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+
+		When("a webhook document is invalid", func() {
+			BeforeEach(func() {
+				searchResponse.Hits.Hits[0].Source = invalidJson
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Context("ListWebhookDeliveries", func() {
+		var (
+			request        *pb.ListWebhookDeliveriesRequest
+			delivery       *pb.WebhookDelivery
+			searchResponse *esutil.SearchResponse
+			searchError    error
+
+			actualResponse *pb.ListWebhookDeliveriesResponse
+			actualError    error
+		)
+
+		BeforeEach(func() {
+			request = &pb.ListWebhookDeliveriesRequest{
+				WebhookId: fake.UUID(),
+			}
+			delivery = &pb.WebhookDelivery{
+				Id:        fake.UUID(),
+				WebhookId: request.WebhookId,
+				EventId:   fake.UUID(),
+				Success:   fake.Bool(),
+			}
+			deliveryJson, _ := protojson.Marshal(delivery)
+			searchResponse = &esutil.SearchResponse{
+				Hits: &esutil.EsSearchResponseHits{
+					Hits: []*esutil.EsSearchResponseHit{
+						{ID: delivery.Id, Source: deliveryJson},
+					},
+				},
+			}
+			searchError = nil
+		})
+
+		JustBeforeEach(func() {
+			esClient.SearchReturns(searchResponse, searchError)
+
+			actualResponse, actualError = manager.ListWebhookDeliveries(ctx, request)
+		})
+
+		It("should search for the webhook's deliveries", func() {
+			Expect(esClient.SearchCallCount()).To(Equal(1))
+
+			_, actualRequest := esClient.SearchArgsForCall(0)
+
+			Expect(actualRequest.Index).To(Equal(expectedDeliveriesAlias))
+			Expect(*actualRequest.Search.Query.Bool.Must).To(ConsistOf(
+				&filtering.Query{Term: &filtering.Term{"webhookId": request.WebhookId}},
+			))
+			Expect(actualRequest.Search.Sort["created"]).To(Equal(esutil.EsSortOrderDescending))
+		})
+
+		It("should return the deliveries", func() {
+			Expect(actualError).NotTo(HaveOccurred())
+			Expect(actualResponse.WebhookDeliveries).To(HaveLen(1))
+			Expect(proto.Equal(actualResponse.WebhookDeliveries[0], delivery)).To(BeTrue())
+		})
+
+		When("the webhook id is empty", func() {
+			BeforeEach(func() {
+				request.WebhookId = ""
+			})
+
+			It("should return an error", func() {
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.InvalidArgument))
+				Expect(esClient.SearchCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a filter is specified", func() {
+			var filterQuery *filtering.Query
+
+			BeforeEach(func() {
+				request.Filter = fake.Word()
+				filterQuery = &filtering.Query{Term: &filtering.Term{"success": "false"}}
+				filterer.ParseExpressionReturns(filterQuery, nil)
+			})
+
+			It("should include the filter query", func() {
+				_, actualRequest := esClient.SearchArgsForCall(0)
+
+				Expect(*actualRequest.Search.Query.Bool.Must).To(HaveLen(2))
+				Expect((*actualRequest.Search.Query.Bool.Must)[1]).To(Equal(filterQuery))
+			})
+		})
+
+		When("the search fails", func() {
+			BeforeEach(func() {
+				searchError = errors.New("search error")
+			})
+
+			It("should return an error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.Internal))
+			})
+		})
+	})
+})
+
+func randomWebhook() *pb.Webhook {
+	return &pb.Webhook{
+		Id:   fake.UUID(),
+		Name: fake.LetterN(10),
+		Url:  fake.URL(),
+		EventTypes: []pb.WebhookEventType{
+			pb.WebhookEventType_RESOURCE_EVALUATION_FAILED,
+		},
+		Secret:  fake.LetterN(32),
+		Created: timestamppb.Now(),
+		Updated: timestamppb.Now(),
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package webhookfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rode/rode/pkg/webhook"
+	"github.com/rode/rode/proto/v1alpha1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FakeManager struct {
+	CreateWebhookStub        func(context.Context, *v1alpha1.Webhook) (*v1alpha1.Webhook, error)
+	createWebhookMutex       sync.RWMutex
+	createWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+	}
+	createWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	createWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	DeleteWebhookStub        func(context.Context, *v1alpha1.DeleteWebhookRequest) (*emptypb.Empty, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWebhookRequest
+	}
+	deleteWebhookReturns struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	GetWebhookStub        func(context.Context, *v1alpha1.GetWebhookRequest) (*v1alpha1.Webhook, error)
+	getWebhookMutex       sync.RWMutex
+	getWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWebhookRequest
+	}
+	getWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	getWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	ListWebhookDeliveriesStub        func(context.Context, *v1alpha1.ListWebhookDeliveriesRequest) (*v1alpha1.ListWebhookDeliveriesResponse, error)
+	listWebhookDeliveriesMutex       sync.RWMutex
+	listWebhookDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhookDeliveriesRequest
+	}
+	listWebhookDeliveriesReturns struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}
+	listWebhookDeliveriesReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}
+	ListWebhooksStub        func(context.Context, *v1alpha1.ListWebhooksRequest) (*v1alpha1.ListWebhooksResponse, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhooksRequest
+	}
+	listWebhooksReturns struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}
+	NotifyStub        func(*v1alpha1.WebhookEvent)
+	notifyMutex       sync.RWMutex
+	notifyArgsForCall []struct {
+		arg1 *v1alpha1.WebhookEvent
+	}
+	UpdateWebhookStub        func(context.Context, *v1alpha1.Webhook) (*v1alpha1.Webhook, error)
+	updateWebhookMutex       sync.RWMutex
+	updateWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+	}
+	updateWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	updateWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) CreateWebhook(arg1 context.Context, arg2 *v1alpha1.Webhook) (*v1alpha1.Webhook, error) {
+	fake.createWebhookMutex.Lock()
+	ret, specificReturn := fake.createWebhookReturnsOnCall[len(fake.createWebhookArgsForCall)]
+	fake.createWebhookArgsForCall = append(fake.createWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+	}{arg1, arg2})
+	stub := fake.CreateWebhookStub
+	fakeReturns := fake.createWebhookReturns
+	fake.recordInvocation("CreateWebhook", []interface{}{arg1, arg2})
+	fake.createWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) CreateWebhookCallCount() int {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	return len(fake.createWebhookArgsForCall)
+}
+
+func (fake *FakeManager) CreateWebhookCalls(stub func(context.Context, *v1alpha1.Webhook) (*v1alpha1.Webhook, error)) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = stub
+}
+
+func (fake *FakeManager) CreateWebhookArgsForCall(i int) (context.Context, *v1alpha1.Webhook) {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	argsForCall := fake.createWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) CreateWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	fake.createWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) CreateWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	if fake.createWebhookReturnsOnCall == nil {
+		fake.createWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.createWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) DeleteWebhook(arg1 context.Context, arg2 *v1alpha1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWebhookRequest
+	}{arg1, arg2})
+	stub := fake.DeleteWebhookStub
+	fakeReturns := fake.deleteWebhookReturns
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1, arg2})
+	fake.deleteWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeManager) DeleteWebhookCalls(stub func(context.Context, *v1alpha1.DeleteWebhookRequest) (*emptypb.Empty, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeManager) DeleteWebhookArgsForCall(i int) (context.Context, *v1alpha1.DeleteWebhookRequest) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) DeleteWebhookReturns(result1 *emptypb.Empty, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) DeleteWebhookReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 *emptypb.Empty
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetWebhook(arg1 context.Context, arg2 *v1alpha1.GetWebhookRequest) (*v1alpha1.Webhook, error) {
+	fake.getWebhookMutex.Lock()
+	ret, specificReturn := fake.getWebhookReturnsOnCall[len(fake.getWebhookArgsForCall)]
+	fake.getWebhookArgsForCall = append(fake.getWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWebhookRequest
+	}{arg1, arg2})
+	stub := fake.GetWebhookStub
+	fakeReturns := fake.getWebhookReturns
+	fake.recordInvocation("GetWebhook", []interface{}{arg1, arg2})
+	fake.getWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetWebhookCallCount() int {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	return len(fake.getWebhookArgsForCall)
+}
+
+func (fake *FakeManager) GetWebhookCalls(stub func(context.Context, *v1alpha1.GetWebhookRequest) (*v1alpha1.Webhook, error)) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = stub
+}
+
+func (fake *FakeManager) GetWebhookArgsForCall(i int) (context.Context, *v1alpha1.GetWebhookRequest) {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	argsForCall := fake.getWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) GetWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	fake.getWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	if fake.getWebhookReturnsOnCall == nil {
+		fake.getWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.getWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListWebhookDeliveries(arg1 context.Context, arg2 *v1alpha1.ListWebhookDeliveriesRequest) (*v1alpha1.ListWebhookDeliveriesResponse, error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.listWebhookDeliveriesReturnsOnCall[len(fake.listWebhookDeliveriesArgsForCall)]
+	fake.listWebhookDeliveriesArgsForCall = append(fake.listWebhookDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhookDeliveriesRequest
+	}{arg1, arg2})
+	stub := fake.ListWebhookDeliveriesStub
+	fakeReturns := fake.listWebhookDeliveriesReturns
+	fake.recordInvocation("ListWebhookDeliveries", []interface{}{arg1, arg2})
+	fake.listWebhookDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ListWebhookDeliveriesCallCount() int {
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	return len(fake.listWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeManager) ListWebhookDeliveriesCalls(stub func(context.Context, *v1alpha1.ListWebhookDeliveriesRequest) (*v1alpha1.ListWebhookDeliveriesResponse, error)) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = stub
+}
+
+func (fake *FakeManager) ListWebhookDeliveriesArgsForCall(i int) (context.Context, *v1alpha1.ListWebhookDeliveriesRequest) {
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.listWebhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) ListWebhookDeliveriesReturns(result1 *v1alpha1.ListWebhookDeliveriesResponse, result2 error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = nil
+	fake.listWebhookDeliveriesReturns = struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListWebhookDeliveriesReturnsOnCall(i int, result1 *v1alpha1.ListWebhookDeliveriesResponse, result2 error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = nil
+	if fake.listWebhookDeliveriesReturnsOnCall == nil {
+		fake.listWebhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWebhookDeliveriesResponse
+			result2 error
+		})
+	}
+	fake.listWebhookDeliveriesReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListWebhooks(arg1 context.Context, arg2 *v1alpha1.ListWebhooksRequest) (*v1alpha1.ListWebhooksResponse, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhooksRequest
+	}{arg1, arg2})
+	stub := fake.ListWebhooksStub
+	fakeReturns := fake.listWebhooksReturns
+	fake.recordInvocation("ListWebhooks", []interface{}{arg1, arg2})
+	fake.listWebhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeManager) ListWebhooksCalls(stub func(context.Context, *v1alpha1.ListWebhooksRequest) (*v1alpha1.ListWebhooksResponse, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeManager) ListWebhooksArgsForCall(i int) (context.Context, *v1alpha1.ListWebhooksRequest) {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	argsForCall := fake.listWebhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) ListWebhooksReturns(result1 *v1alpha1.ListWebhooksResponse, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListWebhooksReturnsOnCall(i int, result1 *v1alpha1.ListWebhooksResponse, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWebhooksResponse
+			result2 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Notify(arg1 *v1alpha1.WebhookEvent) {
+	fake.notifyMutex.Lock()
+	fake.notifyArgsForCall = append(fake.notifyArgsForCall, struct {
+		arg1 *v1alpha1.WebhookEvent
+	}{arg1})
+	stub := fake.NotifyStub
+	fake.recordInvocation("Notify", []interface{}{arg1})
+	fake.notifyMutex.Unlock()
+	if stub != nil {
+		fake.NotifyStub(arg1)
+	}
+}
+
+func (fake *FakeManager) NotifyCallCount() int {
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	return len(fake.notifyArgsForCall)
+}
+
+func (fake *FakeManager) NotifyCalls(stub func(*v1alpha1.WebhookEvent)) {
+	fake.notifyMutex.Lock()
+	defer fake.notifyMutex.Unlock()
+	fake.NotifyStub = stub
+}
+
+func (fake *FakeManager) NotifyArgsForCall(i int) *v1alpha1.WebhookEvent {
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	argsForCall := fake.notifyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) UpdateWebhook(arg1 context.Context, arg2 *v1alpha1.Webhook) (*v1alpha1.Webhook, error) {
+	fake.updateWebhookMutex.Lock()
+	ret, specificReturn := fake.updateWebhookReturnsOnCall[len(fake.updateWebhookArgsForCall)]
+	fake.updateWebhookArgsForCall = append(fake.updateWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+	}{arg1, arg2})
+	stub := fake.UpdateWebhookStub
+	fakeReturns := fake.updateWebhookReturns
+	fake.recordInvocation("UpdateWebhook", []interface{}{arg1, arg2})
+	fake.updateWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) UpdateWebhookCallCount() int {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	return len(fake.updateWebhookArgsForCall)
+}
+
+func (fake *FakeManager) UpdateWebhookCalls(stub func(context.Context, *v1alpha1.Webhook) (*v1alpha1.Webhook, error)) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = stub
+}
+
+func (fake *FakeManager) UpdateWebhookArgsForCall(i int) (context.Context, *v1alpha1.Webhook) {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	argsForCall := fake.updateWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) UpdateWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	fake.updateWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) UpdateWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	if fake.updateWebhookReturnsOnCall == nil {
+		fake.updateWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.updateWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ webhook.Manager = new(FakeManager)
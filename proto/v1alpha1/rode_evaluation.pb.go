@@ -21,6 +21,7 @@
 package v1alpha1
 
 import (
+	intoto_go_proto "github.com/rode/rode/protodeps/grafeas/proto/v1beta1/intoto_go_proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -35,6 +36,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ResourceEvaluationState describes the progress of a resource evaluation.
+type ResourceEvaluationState int32
+
+const (
+	ResourceEvaluationState_RESOURCE_EVALUATION_STATE_UNSPECIFIED ResourceEvaluationState = 0
+	ResourceEvaluationState_PENDING                               ResourceEvaluationState = 1
+	ResourceEvaluationState_RUNNING                               ResourceEvaluationState = 2
+	ResourceEvaluationState_COMPLETE                              ResourceEvaluationState = 3
+	ResourceEvaluationState_FAILED                                ResourceEvaluationState = 4
+)
+
+// Enum value maps for ResourceEvaluationState.
+var (
+	ResourceEvaluationState_name = map[int32]string{
+		0: "RESOURCE_EVALUATION_STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "COMPLETE",
+		4: "FAILED",
+	}
+	ResourceEvaluationState_value = map[string]int32{
+		"RESOURCE_EVALUATION_STATE_UNSPECIFIED": 0,
+		"PENDING":                               1,
+		"RUNNING":                               2,
+		"COMPLETE":                              3,
+		"FAILED":                                4,
+	}
+)
+
+func (x ResourceEvaluationState) Enum() *ResourceEvaluationState {
+	p := new(ResourceEvaluationState)
+	*p = x
+	return p
+}
+
+func (x ResourceEvaluationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceEvaluationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1alpha1_rode_evaluation_proto_enumTypes[0].Descriptor()
+}
+
+func (ResourceEvaluationState) Type() protoreflect.EnumType {
+	return &file_proto_v1alpha1_rode_evaluation_proto_enumTypes[0]
+}
+
+func (x ResourceEvaluationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceEvaluationState.Descriptor instead.
+func (ResourceEvaluationState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+type EvaluatePolicyViolationChange int32
+
+const (
+	EvaluatePolicyViolationChange_EVALUATE_POLICY_VIOLATION_CHANGE_UNSPECIFIED EvaluatePolicyViolationChange = 0
+	EvaluatePolicyViolationChange_UNCHANGED                                    EvaluatePolicyViolationChange = 1
+	EvaluatePolicyViolationChange_NOW_PASSING                                  EvaluatePolicyViolationChange = 2
+	EvaluatePolicyViolationChange_NOW_FAILING                                  EvaluatePolicyViolationChange = 3
+	EvaluatePolicyViolationChange_ADDED                                        EvaluatePolicyViolationChange = 4
+	EvaluatePolicyViolationChange_REMOVED                                      EvaluatePolicyViolationChange = 5
+)
+
+// Enum value maps for EvaluatePolicyViolationChange.
+var (
+	EvaluatePolicyViolationChange_name = map[int32]string{
+		0: "EVALUATE_POLICY_VIOLATION_CHANGE_UNSPECIFIED",
+		1: "UNCHANGED",
+		2: "NOW_PASSING",
+		3: "NOW_FAILING",
+		4: "ADDED",
+		5: "REMOVED",
+	}
+	EvaluatePolicyViolationChange_value = map[string]int32{
+		"EVALUATE_POLICY_VIOLATION_CHANGE_UNSPECIFIED": 0,
+		"UNCHANGED":   1,
+		"NOW_PASSING": 2,
+		"NOW_FAILING": 3,
+		"ADDED":       4,
+		"REMOVED":     5,
+	}
+)
+
+func (x EvaluatePolicyViolationChange) Enum() *EvaluatePolicyViolationChange {
+	p := new(EvaluatePolicyViolationChange)
+	*p = x
+	return p
+}
+
+func (x EvaluatePolicyViolationChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluatePolicyViolationChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1alpha1_rode_evaluation_proto_enumTypes[1].Descriptor()
+}
+
+func (EvaluatePolicyViolationChange) Type() protoreflect.EnumType {
+	return &file_proto_v1alpha1_rode_evaluation_proto_enumTypes[1]
+}
+
+func (x EvaluatePolicyViolationChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluatePolicyViolationChange.Descriptor instead.
+func (EvaluatePolicyViolationChange) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
 // ResourceEvaluation describes the result of a request to evaluate a particular resource version against a group of policies.
 type ResourceEvaluation struct {
 	state         protoimpl.MessageState
@@ -55,6 +170,38 @@ type ResourceEvaluation struct {
 	ResourceVersion *ResourceVersion `protobuf:"bytes,5,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 	// PolicyGroup represents the name of the policy group that was evaluated in this request.
 	PolicyGroup string `protobuf:"bytes,6,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+	// State represents the progress of the evaluation. Evaluations requested synchronously are always COMPLETE, while
+	// asynchronous evaluations move from PENDING to RUNNING and then to either COMPLETE or FAILED.
+	State ResourceEvaluationState `protobuf:"varint,7,opt,name=state,proto3,enum=rode.v1alpha1.ResourceEvaluationState" json:"state,omitempty"`
+	// ErrorMessage describes why the evaluation could not be completed. It is only set when State is FAILED.
+	ErrorMessage string `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// BatchId is shared by every resource evaluation that was created by the same EvaluateResources request.
+	BatchId string `protobuf:"bytes,9,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// OccurrenceNames contains the name of each occurrence that was used as input to the policies in the policy group.
+	OccurrenceNames []string `protobuf:"bytes,10,rep,name=occurrence_names,json=occurrenceNames,proto3" json:"occurrence_names,omitempty"`
+	// InputHash is the hex-encoded SHA-256 digest of the canonical JSON encoding of the EvaluatePolicyInput that was
+	// evaluated: its protobuf JSON encoding with sorted object keys, no insignificant whitespace and no HTML escaping.
+	// The input itself can be retrieved with GetResourceEvaluationInput.
+	InputHash string `protobuf:"bytes,11,opt,name=input_hash,json=inputHash,proto3" json:"input_hash,omitempty"`
+	// Signature is Rode's signature over the evaluation, set when an evaluation signing key has been configured. It can be
+	// verified with the key returned by GetEvaluationPublicKey.
+	Signature *EvaluationSignature `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	// AuditFailures contains the policy version id of each policy assigned in AUDIT mode that did not pass. These failures
+	// are not reflected in Pass, which only considers enforced policies.
+	AuditFailures []string `protobuf:"bytes,13,rep,name=audit_failures,json=auditFailures,proto3" json:"audit_failures,omitempty"`
+	// SeverityThreshold is the severity threshold of the policy group at the time of evaluation.
+	SeverityThreshold ViolationSeverity `protobuf:"varint,14,opt,name=severity_threshold,json=severityThreshold,proto3,enum=rode.v1alpha1.ViolationSeverity" json:"severity_threshold,omitempty"`
+	// ViolationCounts is the number of failing violations across every policy evaluation, keyed by severity name.
+	// Violations without a severity are counted under VIOLATION_SEVERITY_UNSPECIFIED.
+	ViolationCounts map[string]int32 `protobuf:"bytes,15,rep,name=violation_counts,json=violationCounts,proto3" json:"violation_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// SkippedPolicies are the policy assignments in the policy group that weren't evaluated, because their selector
+	// didn't match the resource.
+	SkippedPolicies []*SkippedPolicy `protobuf:"bytes,16,rep,name=skipped_policies,json=skippedPolicies,proto3" json:"skipped_policies,omitempty"`
+	// ResourceType is the type of the evaluated resource, derived from the resource version uri.
+	ResourceType ResourceType `protobuf:"varint,17,opt,name=resource_type,json=resourceType,proto3,enum=rode.v1alpha1.ResourceType" json:"resource_type,omitempty"`
+	// PolicyEvaluationDigests identify the policy evaluations that were stored with the resource evaluation, so that its
+	// Signature covers them as well. They're only set when the evaluation is signed.
+	PolicyEvaluationDigests []*PolicyEvaluationDigest `protobuf:"bytes,18,rep,name=policy_evaluation_digests,json=policyEvaluationDigests,proto3" json:"policy_evaluation_digests,omitempty"`
 }
 
 func (x *ResourceEvaluation) Reset() {
@@ -131,17 +278,105 @@ func (x *ResourceEvaluation) GetPolicyGroup() string {
 	return ""
 }
 
-type ResourceEvaluationSource struct {
+func (x *ResourceEvaluation) GetState() ResourceEvaluationState {
+	if x != nil {
+		return x.State
+	}
+	return ResourceEvaluationState_RESOURCE_EVALUATION_STATE_UNSPECIFIED
+}
+
+func (x *ResourceEvaluation) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ResourceEvaluation) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ResourceEvaluation) GetOccurrenceNames() []string {
+	if x != nil {
+		return x.OccurrenceNames
+	}
+	return nil
+}
+
+func (x *ResourceEvaluation) GetInputHash() string {
+	if x != nil {
+		return x.InputHash
+	}
+	return ""
+}
+
+func (x *ResourceEvaluation) GetSignature() *EvaluationSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ResourceEvaluation) GetAuditFailures() []string {
+	if x != nil {
+		return x.AuditFailures
+	}
+	return nil
+}
+
+func (x *ResourceEvaluation) GetSeverityThreshold() ViolationSeverity {
+	if x != nil {
+		return x.SeverityThreshold
+	}
+	return ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *ResourceEvaluation) GetViolationCounts() map[string]int32 {
+	if x != nil {
+		return x.ViolationCounts
+	}
+	return nil
+}
+
+func (x *ResourceEvaluation) GetSkippedPolicies() []*SkippedPolicy {
+	if x != nil {
+		return x.SkippedPolicies
+	}
+	return nil
+}
+
+func (x *ResourceEvaluation) GetResourceType() ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ResourceEvaluation) GetPolicyEvaluationDigests() []*PolicyEvaluationDigest {
+	if x != nil {
+		return x.PolicyEvaluationDigests
+	}
+	return nil
+}
+
+type SkippedPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Url  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// PolicyAssignmentId is the id of the policy assignment that was skipped.
+	PolicyAssignmentId string `protobuf:"bytes,1,opt,name=policy_assignment_id,json=policyAssignmentId,proto3" json:"policy_assignment_id,omitempty"`
+	// PolicyVersionId is the policy version that would have been evaluated.
+	PolicyVersionId string `protobuf:"bytes,2,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
+	// Reason describes which part of the selector didn't match.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ResourceEvaluationSource) Reset() {
-	*x = ResourceEvaluationSource{}
+func (x *SkippedPolicy) Reset() {
+	*x = SkippedPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,13 +384,13 @@ func (x *ResourceEvaluationSource) Reset() {
 	}
 }
 
-func (x *ResourceEvaluationSource) String() string {
+func (x *SkippedPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceEvaluationSource) ProtoMessage() {}
+func (*SkippedPolicy) ProtoMessage() {}
 
-func (x *ResourceEvaluationSource) ProtoReflect() protoreflect.Message {
+func (x *SkippedPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,46 +402,46 @@ func (x *ResourceEvaluationSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceEvaluationSource.ProtoReflect.Descriptor instead.
-func (*ResourceEvaluationSource) Descriptor() ([]byte, []int) {
+// Deprecated: Use SkippedPolicy.ProtoReflect.Descriptor instead.
+func (*SkippedPolicy) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ResourceEvaluationSource) GetName() string {
+func (x *SkippedPolicy) GetPolicyAssignmentId() string {
 	if x != nil {
-		return x.Name
+		return x.PolicyAssignmentId
 	}
 	return ""
 }
 
-func (x *ResourceEvaluationSource) GetUrl() string {
+func (x *SkippedPolicy) GetPolicyVersionId() string {
 	if x != nil {
-		return x.Url
+		return x.PolicyVersionId
 	}
 	return ""
 }
 
-// PolicyEvaluation describes the result of a request to evaluate a particular resource version against a specific policy.
-// This is a child of ResourceEvaluation.
-type PolicyEvaluation struct {
+func (x *SkippedPolicy) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PolicyEvaluationDigest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Id represents the unique identifier (UUID) for this particular policy evaluation.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// ResourceEvaluationId represents the unique identifier (UUID) of the resource evaluation that triggered this policy evaluation.
-	ResourceEvaluationId string `protobuf:"bytes,2,opt,name=resource_evaluation_id,json=resourceEvaluationId,proto3" json:"resource_evaluation_id,omitempty"`
-	// Pass represents the overall status for this policy evaluation.
-	Pass bool `protobuf:"varint,3,opt,name=pass,proto3" json:"pass,omitempty"`
-	// PolicyVersionId represents the ID of the policy version that was evaluated.
-	PolicyVersionId string `protobuf:"bytes,4,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
-	// Violations is a list of rule results. Even if a rule passed, its output will be included in Violations.
-	Violations []*EvaluatePolicyViolation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+	// PolicyEvaluationId is the id of the policy evaluation.
+	PolicyEvaluationId string `protobuf:"bytes,1,opt,name=policy_evaluation_id,json=policyEvaluationId,proto3" json:"policy_evaluation_id,omitempty"`
+	// PayloadDigest is the hex-encoded SHA-256 digest of the policy evaluation's signing payload, in the payload version
+	// of the resource evaluation's signature.
+	PayloadDigest string `protobuf:"bytes,2,opt,name=payload_digest,json=payloadDigest,proto3" json:"payload_digest,omitempty"`
 }
 
-func (x *PolicyEvaluation) Reset() {
-	*x = PolicyEvaluation{}
+func (x *PolicyEvaluationDigest) Reset() {
+	*x = PolicyEvaluationDigest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -214,13 +449,13 @@ func (x *PolicyEvaluation) Reset() {
 	}
 }
 
-func (x *PolicyEvaluation) String() string {
+func (x *PolicyEvaluationDigest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyEvaluation) ProtoMessage() {}
+func (*PolicyEvaluationDigest) ProtoMessage() {}
 
-func (x *PolicyEvaluation) ProtoReflect() protoreflect.Message {
+func (x *PolicyEvaluationDigest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,62 +467,42 @@ func (x *PolicyEvaluation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyEvaluation.ProtoReflect.Descriptor instead.
-func (*PolicyEvaluation) Descriptor() ([]byte, []int) {
+// Deprecated: Use PolicyEvaluationDigest.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationDigest) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PolicyEvaluation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PolicyEvaluation) GetResourceEvaluationId() string {
+func (x *PolicyEvaluationDigest) GetPolicyEvaluationId() string {
 	if x != nil {
-		return x.ResourceEvaluationId
+		return x.PolicyEvaluationId
 	}
 	return ""
 }
 
-func (x *PolicyEvaluation) GetPass() bool {
-	if x != nil {
-		return x.Pass
-	}
-	return false
-}
-
-func (x *PolicyEvaluation) GetPolicyVersionId() string {
+func (x *PolicyEvaluationDigest) GetPayloadDigest() string {
 	if x != nil {
-		return x.PolicyVersionId
+		return x.PayloadDigest
 	}
 	return ""
 }
 
-func (x *PolicyEvaluation) GetViolations() []*EvaluatePolicyViolation {
-	if x != nil {
-		return x.Violations
-	}
-	return nil
-}
-
-type ResourceEvaluationRequest struct {
+// EvaluationSignature is a signature over a canonical JSON payload built from an evaluation, in the format named by
+// PayloadVersion. ed25519 keys sign the payload directly, while ECDSA keys produce an ASN.1 encoded signature over its
+// SHA-256 digest.
+type EvaluationSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ResourceUri represents the resource being evaluated in this request.
-	ResourceUri string `protobuf:"bytes,1,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
-	// PolicyGroup represents the name of the policy group used to evaluate this resource.
-	PolicyGroup string `protobuf:"bytes,2,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
-	// Source represents the source of the resource evaluation request. This should be set by the enforcer or entity performing
-	// the request.
-	Source *ResourceEvaluationSource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// KeyId is the hex-encoded SHA-256 digest of the DER encoded public key that verifies the signature.
+	KeyId     string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// PayloadVersion is the version of the payload format that was signed.
+	PayloadVersion string `protobuf:"bytes,3,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
 }
 
-func (x *ResourceEvaluationRequest) Reset() {
-	*x = ResourceEvaluationRequest{}
+func (x *EvaluationSignature) Reset() {
+	*x = EvaluationSignature{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -295,13 +510,13 @@ func (x *ResourceEvaluationRequest) Reset() {
 	}
 }
 
-func (x *ResourceEvaluationRequest) String() string {
+func (x *EvaluationSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceEvaluationRequest) ProtoMessage() {}
+func (*EvaluationSignature) ProtoMessage() {}
 
-func (x *ResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *EvaluationSignature) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -313,44 +528,46 @@ func (x *ResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*ResourceEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EvaluationSignature.ProtoReflect.Descriptor instead.
+func (*EvaluationSignature) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ResourceEvaluationRequest) GetResourceUri() string {
+func (x *EvaluationSignature) GetKeyId() string {
 	if x != nil {
-		return x.ResourceUri
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *ResourceEvaluationRequest) GetPolicyGroup() string {
+func (x *EvaluationSignature) GetSignature() []byte {
 	if x != nil {
-		return x.PolicyGroup
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-func (x *ResourceEvaluationRequest) GetSource() *ResourceEvaluationSource {
+func (x *EvaluationSignature) GetPayloadVersion() string {
 	if x != nil {
-		return x.Source
+		return x.PayloadVersion
 	}
-	return nil
+	return ""
 }
 
-// ResourceEvaluationResult is a struct containing a resource evaluation and all associated policy evaluations
-type ResourceEvaluationResult struct {
+type ResourceEvaluationSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceEvaluation *ResourceEvaluation `protobuf:"bytes,1,opt,name=resource_evaluation,json=resourceEvaluation,proto3" json:"resource_evaluation,omitempty"`
-	PolicyEvaluations  []*PolicyEvaluation `protobuf:"bytes,2,rep,name=policy_evaluations,json=policyEvaluations,proto3" json:"policy_evaluations,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// SystemTriggered is set when Rode started the evaluation itself, e.g., because the policy group has
+	// PolicyGroup.AutoEvaluate enabled and new occurrences were created for the resource version.
+	SystemTriggered bool `protobuf:"varint,3,opt,name=system_triggered,json=systemTriggered,proto3" json:"system_triggered,omitempty"`
 }
 
-func (x *ResourceEvaluationResult) Reset() {
-	*x = ResourceEvaluationResult{}
+func (x *ResourceEvaluationSource) Reset() {
+	*x = ResourceEvaluationSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -358,13 +575,13 @@ func (x *ResourceEvaluationResult) Reset() {
 	}
 }
 
-func (x *ResourceEvaluationResult) String() string {
+func (x *ResourceEvaluationSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceEvaluationResult) ProtoMessage() {}
+func (*ResourceEvaluationSource) ProtoMessage() {}
 
-func (x *ResourceEvaluationResult) ProtoReflect() protoreflect.Message {
+func (x *ResourceEvaluationSource) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,35 +593,62 @@ func (x *ResourceEvaluationResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceEvaluationResult.ProtoReflect.Descriptor instead.
-func (*ResourceEvaluationResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceEvaluationSource.ProtoReflect.Descriptor instead.
+func (*ResourceEvaluationSource) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ResourceEvaluationResult) GetResourceEvaluation() *ResourceEvaluation {
+func (x *ResourceEvaluationSource) GetName() string {
 	if x != nil {
-		return x.ResourceEvaluation
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceEvaluationResult) GetPolicyEvaluations() []*PolicyEvaluation {
+func (x *ResourceEvaluationSource) GetUrl() string {
 	if x != nil {
-		return x.PolicyEvaluations
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type GetResourceEvaluationRequest struct {
+func (x *ResourceEvaluationSource) GetSystemTriggered() bool {
+	if x != nil {
+		return x.SystemTriggered
+	}
+	return false
+}
+
+// PolicyEvaluation describes the result of a request to evaluate a particular resource version against a specific policy.
+// This is a child of ResourceEvaluation.
+type PolicyEvaluation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Id represents the unique identifier (UUID) for this particular policy evaluation.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ResourceEvaluationId represents the unique identifier (UUID) of the resource evaluation that triggered this policy evaluation.
+	ResourceEvaluationId string `protobuf:"bytes,2,opt,name=resource_evaluation_id,json=resourceEvaluationId,proto3" json:"resource_evaluation_id,omitempty"`
+	// Pass represents the overall status for this policy evaluation.
+	Pass bool `protobuf:"varint,3,opt,name=pass,proto3" json:"pass,omitempty"`
+	// PolicyVersionId represents the ID of the policy version that was evaluated.
+	PolicyVersionId string `protobuf:"bytes,4,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
+	// Violations is a list of rule results. Even if a rule passed, its output will be included in Violations.
+	Violations []*EvaluatePolicyViolation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+	// Signature is Rode's signature over the policy evaluation. Policy evaluations are only signed when Rode is configured to do so.
+	Signature *EvaluationSignature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// EnforcementMode is the enforcement mode of the policy assignment at the time of evaluation.
+	EnforcementMode PolicyEnforcementMode `protobuf:"varint,7,opt,name=enforcement_mode,json=enforcementMode,proto3,enum=rode.v1alpha1.PolicyEnforcementMode" json:"enforcement_mode,omitempty"`
+	// Waived is true when the policy failed, but every failed violation was covered by a Waiver. Waived policy evaluations
+	// don't fail the resource evaluation.
+	Waived bool `protobuf:"varint,8,opt,name=waived,proto3" json:"waived,omitempty"`
+	// WaiverIds are the ids of the waivers that applied to this policy evaluation.
+	WaiverIds []string `protobuf:"bytes,9,rep,name=waiver_ids,json=waiverIds,proto3" json:"waiver_ids,omitempty"`
 }
 
-func (x *GetResourceEvaluationRequest) Reset() {
-	*x = GetResourceEvaluationRequest{}
+func (x *PolicyEvaluation) Reset() {
+	*x = PolicyEvaluation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,13 +656,13 @@ func (x *GetResourceEvaluationRequest) Reset() {
 	}
 }
 
-func (x *GetResourceEvaluationRequest) String() string {
+func (x *PolicyEvaluation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceEvaluationRequest) ProtoMessage() {}
+func (*PolicyEvaluation) ProtoMessage() {}
 
-func (x *GetResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *PolicyEvaluation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,31 +674,90 @@ func (x *GetResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*GetResourceEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PolicyEvaluation.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluation) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetResourceEvaluationRequest) GetId() string {
+func (x *PolicyEvaluation) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListResourceEvaluationsRequest struct {
+func (x *PolicyEvaluation) GetResourceEvaluationId() string {
+	if x != nil {
+		return x.ResourceEvaluationId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluation) GetPass() bool {
+	if x != nil {
+		return x.Pass
+	}
+	return false
+}
+
+func (x *PolicyEvaluation) GetPolicyVersionId() string {
+	if x != nil {
+		return x.PolicyVersionId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluation) GetViolations() []*EvaluatePolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *PolicyEvaluation) GetSignature() *EvaluationSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PolicyEvaluation) GetEnforcementMode() PolicyEnforcementMode {
+	if x != nil {
+		return x.EnforcementMode
+	}
+	return PolicyEnforcementMode_POLICY_ENFORCEMENT_MODE_UNSPECIFIED
+}
+
+func (x *PolicyEvaluation) GetWaived() bool {
+	if x != nil {
+		return x.Waived
+	}
+	return false
+}
+
+func (x *PolicyEvaluation) GetWaiverIds() []string {
+	if x != nil {
+		return x.WaiverIds
+	}
+	return nil
+}
+
+type ResourceEvaluationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// ResourceUri represents the resource being evaluated in this request.
 	ResourceUri string `protobuf:"bytes,1,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
-	Filter      string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageSize    int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken   string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// PolicyGroup represents the name of the policy group used to evaluate this resource.
+	PolicyGroup string `protobuf:"bytes,2,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+	// Source represents the source of the resource evaluation request. This should be set by the enforcer or entity performing
+	// the request.
+	Source *ResourceEvaluationSource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *ListResourceEvaluationsRequest) Reset() {
-	*x = ListResourceEvaluationsRequest{}
+func (x *ResourceEvaluationRequest) Reset() {
+	*x = ResourceEvaluationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +765,13 @@ func (x *ListResourceEvaluationsRequest) Reset() {
 	}
 }
 
-func (x *ListResourceEvaluationsRequest) String() string {
+func (x *ResourceEvaluationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceEvaluationsRequest) ProtoMessage() {}
+func (*ResourceEvaluationRequest) ProtoMessage() {}
 
-func (x *ListResourceEvaluationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,50 +783,44 @@ func (x *ListResourceEvaluationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceEvaluationsRequest.ProtoReflect.Descriptor instead.
-func (*ListResourceEvaluationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*ResourceEvaluationRequest) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListResourceEvaluationsRequest) GetResourceUri() string {
+func (x *ResourceEvaluationRequest) GetResourceUri() string {
 	if x != nil {
 		return x.ResourceUri
 	}
 	return ""
 }
 
-func (x *ListResourceEvaluationsRequest) GetFilter() string {
+func (x *ResourceEvaluationRequest) GetPolicyGroup() string {
 	if x != nil {
-		return x.Filter
+		return x.PolicyGroup
 	}
 	return ""
 }
 
-func (x *ListResourceEvaluationsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListResourceEvaluationsRequest) GetPageToken() string {
+func (x *ResourceEvaluationRequest) GetSource() *ResourceEvaluationSource {
 	if x != nil {
-		return x.PageToken
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-type ListResourceEvaluationsResponse struct {
+// ResourceEvaluationResult is a struct containing a resource evaluation and all associated policy evaluations
+type ResourceEvaluationResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResourceEvaluations []*ResourceEvaluationResult `protobuf:"bytes,1,rep,name=resource_evaluations,json=resourceEvaluations,proto3" json:"resource_evaluations,omitempty"`
-	NextPageToken       string                      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ResourceEvaluation *ResourceEvaluation `protobuf:"bytes,1,opt,name=resource_evaluation,json=resourceEvaluation,proto3" json:"resource_evaluation,omitempty"`
+	PolicyEvaluations  []*PolicyEvaluation `protobuf:"bytes,2,rep,name=policy_evaluations,json=policyEvaluations,proto3" json:"policy_evaluations,omitempty"`
 }
 
-func (x *ListResourceEvaluationsResponse) Reset() {
-	*x = ListResourceEvaluationsResponse{}
+func (x *ResourceEvaluationResult) Reset() {
+	*x = ResourceEvaluationResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,13 +828,13 @@ func (x *ListResourceEvaluationsResponse) Reset() {
 	}
 }
 
-func (x *ListResourceEvaluationsResponse) String() string {
+func (x *ResourceEvaluationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourceEvaluationsResponse) ProtoMessage() {}
+func (*ResourceEvaluationResult) ProtoMessage() {}
 
-func (x *ListResourceEvaluationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ResourceEvaluationResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,212 +846,2221 @@ func (x *ListResourceEvaluationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourceEvaluationsResponse.ProtoReflect.Descriptor instead.
-func (*ListResourceEvaluationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceEvaluationResult.ProtoReflect.Descriptor instead.
+func (*ResourceEvaluationResult) Descriptor() ([]byte, []int) {
 	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListResourceEvaluationsResponse) GetResourceEvaluations() []*ResourceEvaluationResult {
+func (x *ResourceEvaluationResult) GetResourceEvaluation() *ResourceEvaluation {
 	if x != nil {
-		return x.ResourceEvaluations
+		return x.ResourceEvaluation
 	}
 	return nil
 }
 
-func (x *ListResourceEvaluationsResponse) GetNextPageToken() string {
+func (x *ResourceEvaluationResult) GetPolicyEvaluations() []*PolicyEvaluation {
 	if x != nil {
-		return x.NextPageToken
+		return x.PolicyEvaluations
 	}
-	return ""
+	return nil
 }
 
-var File_proto_v1alpha1_rode_evaluation_proto protoreflect.FileDescriptor
+type BatchEvaluateResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_v1alpha1_rode_evaluation_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x02, 0x0a,
-	0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x49,
-	0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x40, 0x0a, 0x18,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xe0,
-	0x01, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2a, 0x0a,
-	0x11, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x69, 0x6f,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
-	0x72, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c,
-	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c,
-	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x13, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x6f, 0x64,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// ResourceUri represents the resource being evaluated in this request.
+	ResourceUri string `protobuf:"bytes,1,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	// PolicyGroups contains the names of each policy group used to evaluate this resource. A separate resource evaluation
+	// is stored for each policy group.
+	PolicyGroups []string `protobuf:"bytes,2,rep,name=policy_groups,json=policyGroups,proto3" json:"policy_groups,omitempty"`
+	// Source represents the source of the resource evaluation request. This should be set by the enforcer or entity performing
+	// the request.
+	Source *ResourceEvaluationSource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-var (
-	file_proto_v1alpha1_rode_evaluation_proto_rawDescOnce sync.Once
-	file_proto_v1alpha1_rode_evaluation_proto_rawDescData = file_proto_v1alpha1_rode_evaluation_proto_rawDesc
-)
+func (x *BatchEvaluateResourceRequest) Reset() {
+	*x = BatchEvaluateResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP() []byte {
-	file_proto_v1alpha1_rode_evaluation_proto_rawDescOnce.Do(func() {
-		file_proto_v1alpha1_rode_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1alpha1_rode_evaluation_proto_rawDescData)
-	})
-	return file_proto_v1alpha1_rode_evaluation_proto_rawDescData
+func (x *BatchEvaluateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_v1alpha1_rode_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_proto_v1alpha1_rode_evaluation_proto_goTypes = []interface{}{
-	(*ResourceEvaluation)(nil),              // 0: rode.v1alpha1.ResourceEvaluation
-	(*ResourceEvaluationSource)(nil),        // 1: rode.v1alpha1.ResourceEvaluationSource
-	(*PolicyEvaluation)(nil),                // 2: rode.v1alpha1.PolicyEvaluation
-	(*ResourceEvaluationRequest)(nil),       // 3: rode.v1alpha1.ResourceEvaluationRequest
-	(*ResourceEvaluationResult)(nil),        // 4: rode.v1alpha1.ResourceEvaluationResult
-	(*GetResourceEvaluationRequest)(nil),    // 5: rode.v1alpha1.GetResourceEvaluationRequest
-	(*ListResourceEvaluationsRequest)(nil),  // 6: rode.v1alpha1.ListResourceEvaluationsRequest
-	(*ListResourceEvaluationsResponse)(nil), // 7: rode.v1alpha1.ListResourceEvaluationsResponse
-	(*timestamppb.Timestamp)(nil),           // 8: google.protobuf.Timestamp
-	(*ResourceVersion)(nil),                 // 9: rode.v1alpha1.ResourceVersion
-	(*EvaluatePolicyViolation)(nil),         // 10: rode.v1alpha1.EvaluatePolicyViolation
+func (*BatchEvaluateResourceRequest) ProtoMessage() {}
+
+func (x *BatchEvaluateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_v1alpha1_rode_evaluation_proto_depIdxs = []int32{
-	1,  // 0: rode.v1alpha1.ResourceEvaluation.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
-	8,  // 1: rode.v1alpha1.ResourceEvaluation.created:type_name -> google.protobuf.Timestamp
-	9,  // 2: rode.v1alpha1.ResourceEvaluation.resource_version:type_name -> rode.v1alpha1.ResourceVersion
-	10, // 3: rode.v1alpha1.PolicyEvaluation.violations:type_name -> rode.v1alpha1.EvaluatePolicyViolation
-	1,  // 4: rode.v1alpha1.ResourceEvaluationRequest.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
-	0,  // 5: rode.v1alpha1.ResourceEvaluationResult.resource_evaluation:type_name -> rode.v1alpha1.ResourceEvaluation
-	2,  // 6: rode.v1alpha1.ResourceEvaluationResult.policy_evaluations:type_name -> rode.v1alpha1.PolicyEvaluation
-	4,  // 7: rode.v1alpha1.ListResourceEvaluationsResponse.resource_evaluations:type_name -> rode.v1alpha1.ResourceEvaluationResult
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+
+// Deprecated: Use BatchEvaluateResourceRequest.ProtoReflect.Descriptor instead.
+func (*BatchEvaluateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{8}
 }
 
-func init() { file_proto_v1alpha1_rode_evaluation_proto_init() }
-func file_proto_v1alpha1_rode_evaluation_proto_init() {
-	if File_proto_v1alpha1_rode_evaluation_proto != nil {
-		return
+func (x *BatchEvaluateResourceRequest) GetResourceUri() string {
+	if x != nil {
+		return x.ResourceUri
 	}
-	file_proto_v1alpha1_rode_resource_proto_init()
-	file_proto_v1alpha1_rode_policy_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceEvaluation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceEvaluationSource); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *BatchEvaluateResourceRequest) GetPolicyGroups() []string {
+	if x != nil {
+		return x.PolicyGroups
+	}
+	return nil
+}
+
+func (x *BatchEvaluateResourceRequest) GetSource() *ResourceEvaluationSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type BatchEvaluateResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pass is true only when the resource version passed the evaluation for every requested policy group.
+	Pass bool `protobuf:"varint,1,opt,name=pass,proto3" json:"pass,omitempty"`
+	// Results contains the result of each policy group evaluation, in the same order as the requested policy groups.
+	Results []*ResourceEvaluationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchEvaluateResourceResponse) Reset() {
+	*x = BatchEvaluateResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEvaluateResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEvaluateResourceResponse) ProtoMessage() {}
+
+func (x *BatchEvaluateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyEvaluation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEvaluateResourceResponse.ProtoReflect.Descriptor instead.
+func (*BatchEvaluateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchEvaluateResourceResponse) GetPass() bool {
+	if x != nil {
+		return x.Pass
+	}
+	return false
+}
+
+func (x *BatchEvaluateResourceResponse) GetResults() []*ResourceEvaluationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type EvaluateResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceUris contains each resource version that should be evaluated.
+	ResourceUris []string `protobuf:"bytes,1,rep,name=resource_uris,json=resourceUris,proto3" json:"resource_uris,omitempty"`
+	// PolicyGroup represents the name of the policy group used to evaluate each resource.
+	PolicyGroup string `protobuf:"bytes,2,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+	// Source represents the source of the resource evaluation request. This should be set by the enforcer or entity performing
+	// the request.
+	Source *ResourceEvaluationSource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *EvaluateResourcesRequest) Reset() {
+	*x = EvaluateResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResourcesRequest) ProtoMessage() {}
+
+func (x *EvaluateResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResourcesRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EvaluateResourcesRequest) GetResourceUris() []string {
+	if x != nil {
+		return x.ResourceUris
+	}
+	return nil
+}
+
+func (x *EvaluateResourcesRequest) GetPolicyGroup() string {
+	if x != nil {
+		return x.PolicyGroup
+	}
+	return ""
+}
+
+func (x *EvaluateResourcesRequest) GetSource() *ResourceEvaluationSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type EvaluateResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BatchId is the identifier that was assigned to each resource evaluation created by this request.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Pass is true only when every resource was evaluated successfully and passed.
+	Pass bool `protobuf:"varint,2,opt,name=pass,proto3" json:"pass,omitempty"`
+	// Results contains an entry for each requested resource uri, in the same order as the request.
+	Results []*EvaluateResourcesResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *EvaluateResourcesResponse) Reset() {
+	*x = EvaluateResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResourcesResponse) ProtoMessage() {}
+
+func (x *EvaluateResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResourcesResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EvaluateResourcesResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *EvaluateResourcesResponse) GetPass() bool {
+	if x != nil {
+		return x.Pass
+	}
+	return false
+}
+
+func (x *EvaluateResourcesResponse) GetResults() []*EvaluateResourcesResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type EvaluateResourcesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceUri string `protobuf:"bytes,1,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	// Result is the stored resource evaluation. It is not set if the resource couldn't be evaluated.
+	Result *ResourceEvaluationResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// Error describes why the resource couldn't be evaluated, such as an unknown resource version.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EvaluateResourcesResult) Reset() {
+	*x = EvaluateResourcesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateResourcesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResourcesResult) ProtoMessage() {}
+
+func (x *EvaluateResourcesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResourcesResult.ProtoReflect.Descriptor instead.
+func (*EvaluateResourcesResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EvaluateResourcesResult) GetResourceUri() string {
+	if x != nil {
+		return x.ResourceUri
+	}
+	return ""
+}
+
+func (x *EvaluateResourcesResult) GetResult() *ResourceEvaluationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *EvaluateResourcesResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ResourceEvaluationInput is the exact input document that was given to each policy during a resource evaluation.
+type ResourceEvaluationInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceEvaluationId string                 `protobuf:"bytes,1,opt,name=resource_evaluation_id,json=resourceEvaluationId,proto3" json:"resource_evaluation_id,omitempty"`
+	InputHash            string                 `protobuf:"bytes,2,opt,name=input_hash,json=inputHash,proto3" json:"input_hash,omitempty"`
+	Input                *EvaluatePolicyInput   `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Created              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *ResourceEvaluationInput) Reset() {
+	*x = ResourceEvaluationInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceEvaluationInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceEvaluationInput) ProtoMessage() {}
+
+func (x *ResourceEvaluationInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceEvaluationInput.ProtoReflect.Descriptor instead.
+func (*ResourceEvaluationInput) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResourceEvaluationInput) GetResourceEvaluationId() string {
+	if x != nil {
+		return x.ResourceEvaluationId
+	}
+	return ""
+}
+
+func (x *ResourceEvaluationInput) GetInputHash() string {
+	if x != nil {
+		return x.InputHash
+	}
+	return ""
+}
+
+func (x *ResourceEvaluationInput) GetInput() *EvaluatePolicyInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ResourceEvaluationInput) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type GetResourceEvaluationInputRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the id of the resource evaluation.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetResourceEvaluationInputRequest) Reset() {
+	*x = GetResourceEvaluationInputRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceEvaluationInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceEvaluationInputRequest) ProtoMessage() {}
+
+func (x *GetResourceEvaluationInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceEvaluationInputRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceEvaluationInputRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetResourceEvaluationInputRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayResourceEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the id of the resource evaluation to replay.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PolicyVersionIds are the policy versions to evaluate. If empty, the policy versions from the original evaluation
+	// are used.
+	PolicyVersionIds []string `protobuf:"bytes,2,rep,name=policy_version_ids,json=policyVersionIds,proto3" json:"policy_version_ids,omitempty"`
+}
+
+func (x *ReplayResourceEvaluationRequest) Reset() {
+	*x = ReplayResourceEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayResourceEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResourceEvaluationRequest) ProtoMessage() {}
+
+func (x *ReplayResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResourceEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*ReplayResourceEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReplayResourceEvaluationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplayResourceEvaluationRequest) GetPolicyVersionIds() []string {
+	if x != nil {
+		return x.PolicyVersionIds
+	}
+	return nil
+}
+
+type ReplayResourceEvaluationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResourceEvaluation is the original resource evaluation.
+	ResourceEvaluation *ResourceEvaluation `protobuf:"bytes,1,opt,name=resource_evaluation,json=resourceEvaluation,proto3" json:"resource_evaluation,omitempty"`
+	// OccurrenceNames contains the name of each occurrence that was used as input for the replay.
+	OccurrenceNames []string `protobuf:"bytes,2,rep,name=occurrence_names,json=occurrenceNames,proto3" json:"occurrence_names,omitempty"`
+	// PolicyEvaluations contains the replayed result of each policy version, in the order they were requested.
+	PolicyEvaluations []*PolicyEvaluationReplay `protobuf:"bytes,3,rep,name=policy_evaluations,json=policyEvaluations,proto3" json:"policy_evaluations,omitempty"`
+}
+
+func (x *ReplayResourceEvaluationResponse) Reset() {
+	*x = ReplayResourceEvaluationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayResourceEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResourceEvaluationResponse) ProtoMessage() {}
+
+func (x *ReplayResourceEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResourceEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*ReplayResourceEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReplayResourceEvaluationResponse) GetResourceEvaluation() *ResourceEvaluation {
+	if x != nil {
+		return x.ResourceEvaluation
+	}
+	return nil
+}
+
+func (x *ReplayResourceEvaluationResponse) GetOccurrenceNames() []string {
+	if x != nil {
+		return x.OccurrenceNames
+	}
+	return nil
+}
+
+func (x *ReplayResourceEvaluationResponse) GetPolicyEvaluations() []*PolicyEvaluationReplay {
+	if x != nil {
+		return x.PolicyEvaluations
+	}
+	return nil
+}
+
+// PolicyEvaluationReplay compares the replayed result of a policy version with the original evaluation of the same policy.
+type PolicyEvaluationReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyVersionId string `protobuf:"bytes,1,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
+	// OriginalPolicyVersionId is the version of the same policy that was part of the original resource evaluation. It's
+	// empty if the policy wasn't evaluated originally.
+	OriginalPolicyVersionId string `protobuf:"bytes,2,opt,name=original_policy_version_id,json=originalPolicyVersionId,proto3" json:"original_policy_version_id,omitempty"`
+	OriginalPass            bool   `protobuf:"varint,3,opt,name=original_pass,json=originalPass,proto3" json:"original_pass,omitempty"`
+	Pass                    bool   `protobuf:"varint,4,opt,name=pass,proto3" json:"pass,omitempty"`
+	// Violations contains the change in outcome for each rule, matched by the violation id.
+	Violations []*EvaluatePolicyViolationDiff `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+	// PolicyGroup is the policy group that the resource version was evaluated in. This is either the assignment's policy
+	// group, or a descendant that inherits the assignment.
+	PolicyGroup string `protobuf:"bytes,6,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+}
+
+func (x *PolicyEvaluationReplay) Reset() {
+	*x = PolicyEvaluationReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyEvaluationReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationReplay) ProtoMessage() {}
+
+func (x *PolicyEvaluationReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationReplay.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationReplay) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PolicyEvaluationReplay) GetPolicyVersionId() string {
+	if x != nil {
+		return x.PolicyVersionId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationReplay) GetOriginalPolicyVersionId() string {
+	if x != nil {
+		return x.OriginalPolicyVersionId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationReplay) GetOriginalPass() bool {
+	if x != nil {
+		return x.OriginalPass
+	}
+	return false
+}
+
+func (x *PolicyEvaluationReplay) GetPass() bool {
+	if x != nil {
+		return x.Pass
+	}
+	return false
+}
+
+func (x *PolicyEvaluationReplay) GetViolations() []*EvaluatePolicyViolationDiff {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationReplay) GetPolicyGroup() string {
+	if x != nil {
+		return x.PolicyGroup
+	}
+	return ""
+}
+
+type EvaluatePolicyViolationDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Change EvaluatePolicyViolationChange `protobuf:"varint,2,opt,name=change,proto3,enum=rode.v1alpha1.EvaluatePolicyViolationChange" json:"change,omitempty"`
+	// Original is the rule outcome from the original evaluation. It's not set if the rule is new.
+	Original *EvaluatePolicyViolation `protobuf:"bytes,3,opt,name=original,proto3" json:"original,omitempty"`
+	// Replayed is the rule outcome from the replay. It's not set if the rule no longer exists.
+	Replayed *EvaluatePolicyViolation `protobuf:"bytes,4,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *EvaluatePolicyViolationDiff) Reset() {
+	*x = EvaluatePolicyViolationDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePolicyViolationDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePolicyViolationDiff) ProtoMessage() {}
+
+func (x *EvaluatePolicyViolationDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePolicyViolationDiff.ProtoReflect.Descriptor instead.
+func (*EvaluatePolicyViolationDiff) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EvaluatePolicyViolationDiff) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EvaluatePolicyViolationDiff) GetChange() EvaluatePolicyViolationChange {
+	if x != nil {
+		return x.Change
+	}
+	return EvaluatePolicyViolationChange_EVALUATE_POLICY_VIOLATION_CHANGE_UNSPECIFIED
+}
+
+func (x *EvaluatePolicyViolationDiff) GetOriginal() *EvaluatePolicyViolation {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *EvaluatePolicyViolationDiff) GetReplayed() *EvaluatePolicyViolation {
+	if x != nil {
+		return x.Replayed
+	}
+	return nil
+}
+
+type AnalyzePolicyAssignmentImpactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the id of the policy assignment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PolicyVersionId is the candidate policy version that would replace the assigned version. It must be a version of the
+	// assigned policy.
+	PolicyVersionId string `protobuf:"bytes,2,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
+	// Limit is the number of recently evaluated resource versions to analyze. Only resource versions that match the
+	// policy assignment's selector are analyzed. Defaults to 10, and cannot be more than 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) Reset() {
+	*x = AnalyzePolicyAssignmentImpactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzePolicyAssignmentImpactRequest) ProtoMessage() {}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzePolicyAssignmentImpactRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzePolicyAssignmentImpactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) GetPolicyVersionId() string {
+	if x != nil {
+		return x.PolicyVersionId
+	}
+	return ""
+}
+
+func (x *AnalyzePolicyAssignmentImpactRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AnalyzePolicyAssignmentImpactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyAssignment *PolicyAssignment `protobuf:"bytes,1,opt,name=policy_assignment,json=policyAssignment,proto3" json:"policy_assignment,omitempty"`
+	PolicyVersionId  string            `protobuf:"bytes,2,opt,name=policy_version_id,json=policyVersionId,proto3" json:"policy_version_id,omitempty"`
+	// Impacts contains the comparison for each resource version, starting with the most recently evaluated.
+	Impacts []*PolicyVersionImpact `protobuf:"bytes,3,rep,name=impacts,proto3" json:"impacts,omitempty"`
+	// NewlyFailing is the number of resource versions that pass the assigned policy version but fail the candidate.
+	NewlyFailing int32 `protobuf:"varint,4,opt,name=newly_failing,json=newlyFailing,proto3" json:"newly_failing,omitempty"`
+	// NewlyPassing is the number of resource versions that fail the assigned policy version but pass the candidate.
+	NewlyPassing int32 `protobuf:"varint,5,opt,name=newly_passing,json=newlyPassing,proto3" json:"newly_passing,omitempty"`
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) Reset() {
+	*x = AnalyzePolicyAssignmentImpactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzePolicyAssignmentImpactResponse) ProtoMessage() {}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzePolicyAssignmentImpactResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzePolicyAssignmentImpactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) GetPolicyAssignment() *PolicyAssignment {
+	if x != nil {
+		return x.PolicyAssignment
+	}
+	return nil
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) GetPolicyVersionId() string {
+	if x != nil {
+		return x.PolicyVersionId
+	}
+	return ""
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) GetImpacts() []*PolicyVersionImpact {
+	if x != nil {
+		return x.Impacts
+	}
+	return nil
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) GetNewlyFailing() int32 {
+	if x != nil {
+		return x.NewlyFailing
+	}
+	return 0
+}
+
+func (x *AnalyzePolicyAssignmentImpactResponse) GetNewlyPassing() int32 {
+	if x != nil {
+		return x.NewlyPassing
+	}
+	return 0
+}
+
+// PolicyVersionImpact compares the assigned and candidate policy versions for a single resource version, using the
+// input from its latest resource evaluation in the policy group.
+type PolicyVersionImpact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceVersion      *ResourceVersion               `protobuf:"bytes,1,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	ResourceEvaluationId string                         `protobuf:"bytes,2,opt,name=resource_evaluation_id,json=resourceEvaluationId,proto3" json:"resource_evaluation_id,omitempty"`
+	CurrentPass          bool                           `protobuf:"varint,3,opt,name=current_pass,json=currentPass,proto3" json:"current_pass,omitempty"`
+	CandidatePass        bool                           `protobuf:"varint,4,opt,name=candidate_pass,json=candidatePass,proto3" json:"candidate_pass,omitempty"`
+	Violations           []*EvaluatePolicyViolationDiff `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+	// PolicyGroup is the policy group that the resource version was evaluated in. This is either the assignment's policy
+	// group, or a descendant that inherits the assignment.
+	PolicyGroup string `protobuf:"bytes,6,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+}
+
+func (x *PolicyVersionImpact) Reset() {
+	*x = PolicyVersionImpact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyVersionImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyVersionImpact) ProtoMessage() {}
+
+func (x *PolicyVersionImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyVersionImpact.ProtoReflect.Descriptor instead.
+func (*PolicyVersionImpact) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PolicyVersionImpact) GetResourceVersion() *ResourceVersion {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return nil
+}
+
+func (x *PolicyVersionImpact) GetResourceEvaluationId() string {
+	if x != nil {
+		return x.ResourceEvaluationId
+	}
+	return ""
+}
+
+func (x *PolicyVersionImpact) GetCurrentPass() bool {
+	if x != nil {
+		return x.CurrentPass
+	}
+	return false
+}
+
+func (x *PolicyVersionImpact) GetCandidatePass() bool {
+	if x != nil {
+		return x.CandidatePass
+	}
+	return false
+}
+
+func (x *PolicyVersionImpact) GetViolations() []*EvaluatePolicyViolationDiff {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *PolicyVersionImpact) GetPolicyGroup() string {
+	if x != nil {
+		return x.PolicyGroup
+	}
+	return ""
+}
+
+type WatchResourceEvaluationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Filter is a CEL expression matched against each new resource evaluation, for example
+	// `policyGroup == "prod" && pass == "false" && resourceType == "DOCKER"`. Every result is sent when it's empty.
+	// The filter can only refer to ResourceEvaluation fields by their JSON names, and is rejected with INVALID_ARGUMENT
+	// otherwise.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *WatchResourceEvaluationsRequest) Reset() {
+	*x = WatchResourceEvaluationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchResourceEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResourceEvaluationsRequest) ProtoMessage() {}
+
+func (x *WatchResourceEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResourceEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchResourceEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchResourceEvaluationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListStaleResourceEvaluationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PolicyGroup is the name of the policy group whose resource evaluations are checked.
+	PolicyGroup string `protobuf:"bytes,1,opt,name=policy_group,json=policyGroup,proto3" json:"policy_group,omitempty"`
+	// Limit is the number of recently evaluated resource versions to check. Defaults to 100, and cannot be more than 1000.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListStaleResourceEvaluationsRequest) Reset() {
+	*x = ListStaleResourceEvaluationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStaleResourceEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleResourceEvaluationsRequest) ProtoMessage() {}
+
+func (x *ListStaleResourceEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleResourceEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaleResourceEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListStaleResourceEvaluationsRequest) GetPolicyGroup() string {
+	if x != nil {
+		return x.PolicyGroup
+	}
+	return ""
+}
+
+func (x *ListStaleResourceEvaluationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListStaleResourceEvaluationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StaleResourceEvaluations are the latest resource evaluations in the policy group that didn't use the policy versions
+	// that are currently assigned, starting with the most recently evaluated.
+	StaleResourceEvaluations []*StaleResourceEvaluation `protobuf:"bytes,1,rep,name=stale_resource_evaluations,json=staleResourceEvaluations,proto3" json:"stale_resource_evaluations,omitempty"`
+}
+
+func (x *ListStaleResourceEvaluationsResponse) Reset() {
+	*x = ListStaleResourceEvaluationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStaleResourceEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleResourceEvaluationsResponse) ProtoMessage() {}
+
+func (x *ListStaleResourceEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleResourceEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaleResourceEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListStaleResourceEvaluationsResponse) GetStaleResourceEvaluations() []*StaleResourceEvaluation {
+	if x != nil {
+		return x.StaleResourceEvaluations
+	}
+	return nil
+}
+
+// StaleResourceEvaluation describes how a resource evaluation differs from the current policy assignments of its policy group.
+type StaleResourceEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceEvaluation *ResourceEvaluation `protobuf:"bytes,1,opt,name=resource_evaluation,json=resourceEvaluation,proto3" json:"resource_evaluation,omitempty"`
+	// AddedPolicyVersionIds are assigned to the policy group, but weren't part of the resource evaluation.
+	AddedPolicyVersionIds []string `protobuf:"bytes,2,rep,name=added_policy_version_ids,json=addedPolicyVersionIds,proto3" json:"added_policy_version_ids,omitempty"`
+	// RemovedPolicyVersionIds were part of the resource evaluation, but are no longer assigned to the policy group.
+	RemovedPolicyVersionIds []string `protobuf:"bytes,3,rep,name=removed_policy_version_ids,json=removedPolicyVersionIds,proto3" json:"removed_policy_version_ids,omitempty"`
+}
+
+func (x *StaleResourceEvaluation) Reset() {
+	*x = StaleResourceEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaleResourceEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleResourceEvaluation) ProtoMessage() {}
+
+func (x *StaleResourceEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleResourceEvaluation.ProtoReflect.Descriptor instead.
+func (*StaleResourceEvaluation) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StaleResourceEvaluation) GetResourceEvaluation() *ResourceEvaluation {
+	if x != nil {
+		return x.ResourceEvaluation
+	}
+	return nil
+}
+
+func (x *StaleResourceEvaluation) GetAddedPolicyVersionIds() []string {
+	if x != nil {
+		return x.AddedPolicyVersionIds
+	}
+	return nil
+}
+
+func (x *StaleResourceEvaluation) GetRemovedPolicyVersionIds() []string {
+	if x != nil {
+		return x.RemovedPolicyVersionIds
+	}
+	return nil
+}
+
+type GetResourceEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetResourceEvaluationRequest) Reset() {
+	*x = GetResourceEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceEvaluationRequest) ProtoMessage() {}
+
+func (x *GetResourceEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetResourceEvaluationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListResourceEvaluationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceUri string `protobuf:"bytes,1,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	Filter      string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize    int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListResourceEvaluationsRequest) Reset() {
+	*x = ListResourceEvaluationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourceEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceEvaluationsRequest) ProtoMessage() {}
+
+func (x *ListResourceEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListResourceEvaluationsRequest) GetResourceUri() string {
+	if x != nil {
+		return x.ResourceUri
+	}
+	return ""
+}
+
+func (x *ListResourceEvaluationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListResourceEvaluationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourceEvaluationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListResourceEvaluationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceEvaluations []*ResourceEvaluationResult `protobuf:"bytes,1,rep,name=resource_evaluations,json=resourceEvaluations,proto3" json:"resource_evaluations,omitempty"`
+	NextPageToken       string                      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListResourceEvaluationsResponse) Reset() {
+	*x = ListResourceEvaluationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResourceEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceEvaluationsResponse) ProtoMessage() {}
+
+func (x *ListResourceEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListResourceEvaluationsResponse) GetResourceEvaluations() []*ResourceEvaluationResult {
+	if x != nil {
+		return x.ResourceEvaluations
+	}
+	return nil
+}
+
+func (x *ListResourceEvaluationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetEvaluationPublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEvaluationPublicKeyRequest) Reset() {
+	*x = GetEvaluationPublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvaluationPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvaluationPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetEvaluationPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvaluationPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetEvaluationPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{29}
+}
+
+type EvaluationPublicKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// KeyId is the hex-encoded SHA-256 digest of the DER encoded public key, and matches EvaluationSignature.key_id.
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// PublicKey is the PEM encoded PKIX public key used to verify evaluation signatures.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *EvaluationPublicKey) Reset() {
+	*x = EvaluationPublicKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationPublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationPublicKey) ProtoMessage() {}
+
+func (x *EvaluationPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationPublicKey.ProtoReflect.Descriptor instead.
+func (*EvaluationPublicKey) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EvaluationPublicKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EvaluationPublicKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type GetResourceEvaluationStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of the resource evaluation to export.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetResourceEvaluationStatementRequest) Reset() {
+	*x = GetResourceEvaluationStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceEvaluationStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceEvaluationStatementRequest) ProtoMessage() {}
+
+func (x *GetResourceEvaluationStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceEvaluationStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceEvaluationStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetResourceEvaluationStatementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DsseEnvelope is a Dead Simple Signing Envelope (https://github.com/secure-systems-lab/dsse). The signatures cover the
+// DSSE pre-authentication encoding of the payload type and payload, rather than the payload alone.
+type DsseEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payload is the serialized in-toto Statement.
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// PayloadType is always application/vnd.in-toto+json.
+	PayloadType string `protobuf:"bytes,2,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	// Signatures use the same key as evaluation signatures, and can be verified with the key returned by GetEvaluationPublicKey.
+	// Each signature is base64 encoded.
+	Signatures []*intoto_go_proto.Signature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *DsseEnvelope) Reset() {
+	*x = DsseEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DsseEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DsseEnvelope) ProtoMessage() {}
+
+func (x *DsseEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1alpha1_rode_evaluation_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DsseEnvelope.ProtoReflect.Descriptor instead.
+func (*DsseEnvelope) Descriptor() ([]byte, []int) {
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DsseEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DsseEnvelope) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *DsseEnvelope) GetSignatures() []*intoto_go_proto.Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+var File_proto_v1alpha1_rode_evaluation_proto protoreflect.FileDescriptor
+
+var file_proto_v1alpha1_rode_evaluation_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x08, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70,
+	0x61, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x12, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x11, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x61, 0x0a, 0x10, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a,
+	0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x61, 0x0a, 0x19, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x17, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x42, 0x0a, 0x14, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x85, 0x01, 0x0a, 0x0d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x16, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x13, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x6b, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x22, 0xaa, 0x03, 0x0a,
+	0x10, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x40, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x4f, 0x0a, 0x10, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x77, 0x61, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x61, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x19, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3f, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xbe,
+	0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x13, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4e, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x1d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x41,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55,
+	0x72, 0x69, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x70, 0x61, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xde, 0x01, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72,
+	0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a,
+	0x21, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x5f, 0x0a, 0x1f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x11, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa9, 0x02,
+	0x0a, 0x16, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xfb, 0x01, 0x0a, 0x1b, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x72, 0x6f, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x42, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0x78, 0x0a, 0x24, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xa9, 0x02, 0x0a, 0x25, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x07, 0x69, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x6c, 0x79, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x6c,
+	0x79, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x6c,
+	0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6e, 0x65, 0x77, 0x6c, 0x79, 0x50, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0xcf, 0x02,
+	0x0a, 0x13, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x12, 0x4a, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x39, 0x0a, 0x1f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x23, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x24, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1a, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x18, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0x2e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x97, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x1f, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
+	0x37, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x44, 0x73, 0x73,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61,
+	0x66, 0x65, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x69, 0x6e, 0x74,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2a, 0x78, 0x0a, 0x17, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0x9a, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x2c, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x57, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x57, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05,
+	0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x6f, 0x64, 0x65, 0x2f, 0x72, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1alpha1_rode_evaluation_proto_rawDescOnce sync.Once
+	file_proto_v1alpha1_rode_evaluation_proto_rawDescData = file_proto_v1alpha1_rode_evaluation_proto_rawDesc
+)
+
+func file_proto_v1alpha1_rode_evaluation_proto_rawDescGZIP() []byte {
+	file_proto_v1alpha1_rode_evaluation_proto_rawDescOnce.Do(func() {
+		file_proto_v1alpha1_rode_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1alpha1_rode_evaluation_proto_rawDescData)
+	})
+	return file_proto_v1alpha1_rode_evaluation_proto_rawDescData
+}
+
+var file_proto_v1alpha1_rode_evaluation_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_v1alpha1_rode_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_v1alpha1_rode_evaluation_proto_goTypes = []interface{}{
+	(ResourceEvaluationState)(0),                  // 0: rode.v1alpha1.ResourceEvaluationState
+	(EvaluatePolicyViolationChange)(0),            // 1: rode.v1alpha1.EvaluatePolicyViolationChange
+	(*ResourceEvaluation)(nil),                    // 2: rode.v1alpha1.ResourceEvaluation
+	(*SkippedPolicy)(nil),                         // 3: rode.v1alpha1.SkippedPolicy
+	(*PolicyEvaluationDigest)(nil),                // 4: rode.v1alpha1.PolicyEvaluationDigest
+	(*EvaluationSignature)(nil),                   // 5: rode.v1alpha1.EvaluationSignature
+	(*ResourceEvaluationSource)(nil),              // 6: rode.v1alpha1.ResourceEvaluationSource
+	(*PolicyEvaluation)(nil),                      // 7: rode.v1alpha1.PolicyEvaluation
+	(*ResourceEvaluationRequest)(nil),             // 8: rode.v1alpha1.ResourceEvaluationRequest
+	(*ResourceEvaluationResult)(nil),              // 9: rode.v1alpha1.ResourceEvaluationResult
+	(*BatchEvaluateResourceRequest)(nil),          // 10: rode.v1alpha1.BatchEvaluateResourceRequest
+	(*BatchEvaluateResourceResponse)(nil),         // 11: rode.v1alpha1.BatchEvaluateResourceResponse
+	(*EvaluateResourcesRequest)(nil),              // 12: rode.v1alpha1.EvaluateResourcesRequest
+	(*EvaluateResourcesResponse)(nil),             // 13: rode.v1alpha1.EvaluateResourcesResponse
+	(*EvaluateResourcesResult)(nil),               // 14: rode.v1alpha1.EvaluateResourcesResult
+	(*ResourceEvaluationInput)(nil),               // 15: rode.v1alpha1.ResourceEvaluationInput
+	(*GetResourceEvaluationInputRequest)(nil),     // 16: rode.v1alpha1.GetResourceEvaluationInputRequest
+	(*ReplayResourceEvaluationRequest)(nil),       // 17: rode.v1alpha1.ReplayResourceEvaluationRequest
+	(*ReplayResourceEvaluationResponse)(nil),      // 18: rode.v1alpha1.ReplayResourceEvaluationResponse
+	(*PolicyEvaluationReplay)(nil),                // 19: rode.v1alpha1.PolicyEvaluationReplay
+	(*EvaluatePolicyViolationDiff)(nil),           // 20: rode.v1alpha1.EvaluatePolicyViolationDiff
+	(*AnalyzePolicyAssignmentImpactRequest)(nil),  // 21: rode.v1alpha1.AnalyzePolicyAssignmentImpactRequest
+	(*AnalyzePolicyAssignmentImpactResponse)(nil), // 22: rode.v1alpha1.AnalyzePolicyAssignmentImpactResponse
+	(*PolicyVersionImpact)(nil),                   // 23: rode.v1alpha1.PolicyVersionImpact
+	(*WatchResourceEvaluationsRequest)(nil),       // 24: rode.v1alpha1.WatchResourceEvaluationsRequest
+	(*ListStaleResourceEvaluationsRequest)(nil),   // 25: rode.v1alpha1.ListStaleResourceEvaluationsRequest
+	(*ListStaleResourceEvaluationsResponse)(nil),  // 26: rode.v1alpha1.ListStaleResourceEvaluationsResponse
+	(*StaleResourceEvaluation)(nil),               // 27: rode.v1alpha1.StaleResourceEvaluation
+	(*GetResourceEvaluationRequest)(nil),          // 28: rode.v1alpha1.GetResourceEvaluationRequest
+	(*ListResourceEvaluationsRequest)(nil),        // 29: rode.v1alpha1.ListResourceEvaluationsRequest
+	(*ListResourceEvaluationsResponse)(nil),       // 30: rode.v1alpha1.ListResourceEvaluationsResponse
+	(*GetEvaluationPublicKeyRequest)(nil),         // 31: rode.v1alpha1.GetEvaluationPublicKeyRequest
+	(*EvaluationPublicKey)(nil),                   // 32: rode.v1alpha1.EvaluationPublicKey
+	(*GetResourceEvaluationStatementRequest)(nil), // 33: rode.v1alpha1.GetResourceEvaluationStatementRequest
+	(*DsseEnvelope)(nil),                          // 34: rode.v1alpha1.DsseEnvelope
+	nil,                                           // 35: rode.v1alpha1.ResourceEvaluation.ViolationCountsEntry
+	(*timestamppb.Timestamp)(nil),                 // 36: google.protobuf.Timestamp
+	(*ResourceVersion)(nil),                       // 37: rode.v1alpha1.ResourceVersion
+	(ViolationSeverity)(0),                        // 38: rode.v1alpha1.ViolationSeverity
+	(ResourceType)(0),                             // 39: rode.v1alpha1.ResourceType
+	(*EvaluatePolicyViolation)(nil),               // 40: rode.v1alpha1.EvaluatePolicyViolation
+	(PolicyEnforcementMode)(0),                    // 41: rode.v1alpha1.PolicyEnforcementMode
+	(*EvaluatePolicyInput)(nil),                   // 42: rode.v1alpha1.EvaluatePolicyInput
+	(*PolicyAssignment)(nil),                      // 43: rode.v1alpha1.PolicyAssignment
+	(*intoto_go_proto.Signature)(nil),             // 44: grafeas.v1beta1.intoto.Signature
+}
+var file_proto_v1alpha1_rode_evaluation_proto_depIdxs = []int32{
+	6,  // 0: rode.v1alpha1.ResourceEvaluation.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
+	36, // 1: rode.v1alpha1.ResourceEvaluation.created:type_name -> google.protobuf.Timestamp
+	37, // 2: rode.v1alpha1.ResourceEvaluation.resource_version:type_name -> rode.v1alpha1.ResourceVersion
+	0,  // 3: rode.v1alpha1.ResourceEvaluation.state:type_name -> rode.v1alpha1.ResourceEvaluationState
+	5,  // 4: rode.v1alpha1.ResourceEvaluation.signature:type_name -> rode.v1alpha1.EvaluationSignature
+	38, // 5: rode.v1alpha1.ResourceEvaluation.severity_threshold:type_name -> rode.v1alpha1.ViolationSeverity
+	35, // 6: rode.v1alpha1.ResourceEvaluation.violation_counts:type_name -> rode.v1alpha1.ResourceEvaluation.ViolationCountsEntry
+	3,  // 7: rode.v1alpha1.ResourceEvaluation.skipped_policies:type_name -> rode.v1alpha1.SkippedPolicy
+	39, // 8: rode.v1alpha1.ResourceEvaluation.resource_type:type_name -> rode.v1alpha1.ResourceType
+	4,  // 9: rode.v1alpha1.ResourceEvaluation.policy_evaluation_digests:type_name -> rode.v1alpha1.PolicyEvaluationDigest
+	40, // 10: rode.v1alpha1.PolicyEvaluation.violations:type_name -> rode.v1alpha1.EvaluatePolicyViolation
+	5,  // 11: rode.v1alpha1.PolicyEvaluation.signature:type_name -> rode.v1alpha1.EvaluationSignature
+	41, // 12: rode.v1alpha1.PolicyEvaluation.enforcement_mode:type_name -> rode.v1alpha1.PolicyEnforcementMode
+	6,  // 13: rode.v1alpha1.ResourceEvaluationRequest.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
+	2,  // 14: rode.v1alpha1.ResourceEvaluationResult.resource_evaluation:type_name -> rode.v1alpha1.ResourceEvaluation
+	7,  // 15: rode.v1alpha1.ResourceEvaluationResult.policy_evaluations:type_name -> rode.v1alpha1.PolicyEvaluation
+	6,  // 16: rode.v1alpha1.BatchEvaluateResourceRequest.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
+	9,  // 17: rode.v1alpha1.BatchEvaluateResourceResponse.results:type_name -> rode.v1alpha1.ResourceEvaluationResult
+	6,  // 18: rode.v1alpha1.EvaluateResourcesRequest.source:type_name -> rode.v1alpha1.ResourceEvaluationSource
+	14, // 19: rode.v1alpha1.EvaluateResourcesResponse.results:type_name -> rode.v1alpha1.EvaluateResourcesResult
+	9,  // 20: rode.v1alpha1.EvaluateResourcesResult.result:type_name -> rode.v1alpha1.ResourceEvaluationResult
+	42, // 21: rode.v1alpha1.ResourceEvaluationInput.input:type_name -> rode.v1alpha1.EvaluatePolicyInput
+	36, // 22: rode.v1alpha1.ResourceEvaluationInput.created:type_name -> google.protobuf.Timestamp
+	2,  // 23: rode.v1alpha1.ReplayResourceEvaluationResponse.resource_evaluation:type_name -> rode.v1alpha1.ResourceEvaluation
+	19, // 24: rode.v1alpha1.ReplayResourceEvaluationResponse.policy_evaluations:type_name -> rode.v1alpha1.PolicyEvaluationReplay
+	20, // 25: rode.v1alpha1.PolicyEvaluationReplay.violations:type_name -> rode.v1alpha1.EvaluatePolicyViolationDiff
+	1,  // 26: rode.v1alpha1.EvaluatePolicyViolationDiff.change:type_name -> rode.v1alpha1.EvaluatePolicyViolationChange
+	40, // 27: rode.v1alpha1.EvaluatePolicyViolationDiff.original:type_name -> rode.v1alpha1.EvaluatePolicyViolation
+	40, // 28: rode.v1alpha1.EvaluatePolicyViolationDiff.replayed:type_name -> rode.v1alpha1.EvaluatePolicyViolation
+	43, // 29: rode.v1alpha1.AnalyzePolicyAssignmentImpactResponse.policy_assignment:type_name -> rode.v1alpha1.PolicyAssignment
+	23, // 30: rode.v1alpha1.AnalyzePolicyAssignmentImpactResponse.impacts:type_name -> rode.v1alpha1.PolicyVersionImpact
+	37, // 31: rode.v1alpha1.PolicyVersionImpact.resource_version:type_name -> rode.v1alpha1.ResourceVersion
+	20, // 32: rode.v1alpha1.PolicyVersionImpact.violations:type_name -> rode.v1alpha1.EvaluatePolicyViolationDiff
+	27, // 33: rode.v1alpha1.ListStaleResourceEvaluationsResponse.stale_resource_evaluations:type_name -> rode.v1alpha1.StaleResourceEvaluation
+	2,  // 34: rode.v1alpha1.StaleResourceEvaluation.resource_evaluation:type_name -> rode.v1alpha1.ResourceEvaluation
+	9,  // 35: rode.v1alpha1.ListResourceEvaluationsResponse.resource_evaluations:type_name -> rode.v1alpha1.ResourceEvaluationResult
+	44, // 36: rode.v1alpha1.DsseEnvelope.signatures:type_name -> grafeas.v1beta1.intoto.Signature
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1alpha1_rode_evaluation_proto_init() }
+func file_proto_v1alpha1_rode_evaluation_proto_init() {
+	if File_proto_v1alpha1_rode_evaluation_proto != nil {
+		return
+	}
+	file_proto_v1alpha1_rode_resource_proto_init()
+	file_proto_v1alpha1_rode_policy_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkippedPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyEvaluationDigest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceEvaluationSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourceEvaluationRequest); i {
 			case 0:
 				return &v.state
@@ -766,7 +3072,7 @@ func file_proto_v1alpha1_rode_evaluation_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourceEvaluationResult); i {
 			case 0:
 				return &v.state
@@ -778,7 +3084,223 @@ func file_proto_v1alpha1_rode_evaluation_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchEvaluateResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchEvaluateResourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateResourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateResourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateResourcesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceEvaluationInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceEvaluationInputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayResourceEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayResourceEvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyEvaluationReplay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePolicyViolationDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzePolicyAssignmentImpactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzePolicyAssignmentImpactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyVersionImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchResourceEvaluationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStaleResourceEvaluationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStaleResourceEvaluationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaleResourceEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetResourceEvaluationRequest); i {
 			case 0:
 				return &v.state
@@ -790,7 +3312,7 @@ func file_proto_v1alpha1_rode_evaluation_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListResourceEvaluationsRequest); i {
 			case 0:
 				return &v.state
@@ -802,7 +3324,7 @@ func file_proto_v1alpha1_rode_evaluation_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListResourceEvaluationsResponse); i {
 			case 0:
 				return &v.state
@@ -814,19 +3336,68 @@ func file_proto_v1alpha1_rode_evaluation_proto_init() {
 				return nil
 			}
 		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvaluationPublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationPublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceEvaluationStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1alpha1_rode_evaluation_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DsseEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_v1alpha1_rode_evaluation_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_v1alpha1_rode_evaluation_proto_goTypes,
 		DependencyIndexes: file_proto_v1alpha1_rode_evaluation_proto_depIdxs,
+		EnumInfos:         file_proto_v1alpha1_rode_evaluation_proto_enumTypes,
 		MessageInfos:      file_proto_v1alpha1_rode_evaluation_proto_msgTypes,
 	}.Build()
 	File_proto_v1alpha1_rode_evaluation_proto = out.File
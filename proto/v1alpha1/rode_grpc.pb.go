@@ -50,15 +50,64 @@ type RodeClient interface {
 	ListPolicyGroups(ctx context.Context, in *ListPolicyGroupsRequest, opts ...grpc.CallOption) (*ListPolicyGroupsResponse, error)
 	GetPolicyGroup(ctx context.Context, in *GetPolicyGroupRequest, opts ...grpc.CallOption) (*PolicyGroup, error)
 	UpdatePolicyGroup(ctx context.Context, in *PolicyGroup, opts ...grpc.CallOption) (*PolicyGroup, error)
+	// ResolvePolicyGroupAssignments returns the effective policy assignments for a PolicyGroup, after applying the assignments
+	// inherited from its parents.
+	ResolvePolicyGroupAssignments(ctx context.Context, in *ResolvePolicyGroupAssignmentsRequest, opts ...grpc.CallOption) (*ResolvePolicyGroupAssignmentsResponse, error)
 	DeletePolicyGroup(ctx context.Context, in *DeletePolicyGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreatePolicyAssignment(ctx context.Context, in *PolicyAssignment, opts ...grpc.CallOption) (*PolicyAssignment, error)
 	GetPolicyAssignment(ctx context.Context, in *GetPolicyAssignmentRequest, opts ...grpc.CallOption) (*PolicyAssignment, error)
 	UpdatePolicyAssignment(ctx context.Context, in *PolicyAssignment, opts ...grpc.CallOption) (*PolicyAssignment, error)
 	DeletePolicyAssignment(ctx context.Context, in *DeletePolicyAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPolicyAssignments(ctx context.Context, in *ListPolicyAssignmentsRequest, opts ...grpc.CallOption) (*ListPolicyAssignmentsResponse, error)
+	CreateWaiver(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Waiver, error)
+	GetWaiver(ctx context.Context, in *GetWaiverRequest, opts ...grpc.CallOption) (*Waiver, error)
+	UpdateWaiver(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Waiver, error)
+	DeleteWaiver(ctx context.Context, in *DeleteWaiverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error)
+	CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// ListWebhookDeliveries returns the delivery log of a Webhook.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// ListAuditEvents returns the audit log of calls that changed state in Rode, starting with the most recent.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 	EvaluateResource(ctx context.Context, in *ResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error)
+	// EvaluateResourceAsync returns as soon as the evaluation has been queued. The returned resource evaluation is PENDING,
+	// and GetResourceEvaluation can be polled until its state is either COMPLETE or FAILED.
+	EvaluateResourceAsync(ctx context.Context, in *ResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error)
+	// BatchEvaluateResource evaluates a single resource version against several policy groups, only fetching the resource's
+	// occurrences once.
+	BatchEvaluateResource(ctx context.Context, in *BatchEvaluateResourceRequest, opts ...grpc.CallOption) (*BatchEvaluateResourceResponse, error)
+	// EvaluateResources evaluates several resource versions against a single policy group. A resource that can't be evaluated
+	// is reported in its result without failing the rest of the request.
+	EvaluateResources(ctx context.Context, in *EvaluateResourcesRequest, opts ...grpc.CallOption) (*EvaluateResourcesResponse, error)
+	// GetResourceEvaluationStatement exports a completed resource evaluation as an in-toto Statement wrapped in a signed DSSE envelope.
+	// The subject is the digest of the evaluated resource version, and the predicate describes the policy group, the policy
+	// versions that were evaluated, and their results.
+	GetResourceEvaluationStatement(ctx context.Context, in *GetResourceEvaluationStatementRequest, opts ...grpc.CallOption) (*DsseEnvelope, error)
+	// GetEvaluationPublicKey returns the public key that verifies the signatures on resource and policy evaluations.
+	GetEvaluationPublicKey(ctx context.Context, in *GetEvaluationPublicKeyRequest, opts ...grpc.CallOption) (*EvaluationPublicKey, error)
 	GetResourceEvaluation(ctx context.Context, in *GetResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error)
+	// GetResourceEvaluationInput returns the input document that was evaluated by each policy in a resource evaluation,
+	// so that it can be audited or replayed after the underlying occurrences have changed.
+	GetResourceEvaluationInput(ctx context.Context, in *GetResourceEvaluationInputRequest, opts ...grpc.CallOption) (*ResourceEvaluationInput, error)
+	// ReplayResourceEvaluation re-runs policy versions against the input of a past resource evaluation and compares the
+	// outcome of each rule with the original. Nothing is stored.
+	ReplayResourceEvaluation(ctx context.Context, in *ReplayResourceEvaluationRequest, opts ...grpc.CallOption) (*ReplayResourceEvaluationResponse, error)
+	// AnalyzePolicyAssignmentImpact evaluates a candidate policy version against the resource versions that were recently
+	// evaluated in the assignment's policy group, or in a descendant policy group that inherits the assignment, and reports
+	// which results would change if the assignment were updated. Nothing is stored.
+	AnalyzePolicyAssignmentImpact(ctx context.Context, in *AnalyzePolicyAssignmentImpactRequest, opts ...grpc.CallOption) (*AnalyzePolicyAssignmentImpactResponse, error)
 	ListResourceEvaluations(ctx context.Context, in *ListResourceEvaluationsRequest, opts ...grpc.CallOption) (*ListResourceEvaluationsResponse, error)
+	// WatchResourceEvaluations streams resource evaluation results as they're completed or fail. The filter uses the same
+	// syntax as ListResourceEvaluations and is applied to the stored resource evaluation, so policy group, pass, state,
+	// and resource type can be matched. Results that were stored before the stream was opened are not sent.
+	WatchResourceEvaluations(ctx context.Context, in *WatchResourceEvaluationsRequest, opts ...grpc.CallOption) (Rode_WatchResourceEvaluationsClient, error)
+	// ListStaleResourceEvaluations returns the latest resource evaluations in a policy group that were made against a
+	// different set of policy versions than the ones currently assigned to the group.
+	ListStaleResourceEvaluations(ctx context.Context, in *ListStaleResourceEvaluationsRequest, opts ...grpc.CallOption) (*ListStaleResourceEvaluationsResponse, error)
 }
 
 type rodeClient struct {
@@ -249,6 +298,15 @@ func (c *rodeClient) UpdatePolicyGroup(ctx context.Context, in *PolicyGroup, opt
 	return out, nil
 }
 
+func (c *rodeClient) ResolvePolicyGroupAssignments(ctx context.Context, in *ResolvePolicyGroupAssignmentsRequest, opts ...grpc.CallOption) (*ResolvePolicyGroupAssignmentsResponse, error) {
+	out := new(ResolvePolicyGroupAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ResolvePolicyGroupAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rodeClient) DeletePolicyGroup(ctx context.Context, in *DeletePolicyGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/DeletePolicyGroup", in, out, opts...)
@@ -303,6 +361,114 @@ func (c *rodeClient) ListPolicyAssignments(ctx context.Context, in *ListPolicyAs
 	return out, nil
 }
 
+func (c *rodeClient) CreateWaiver(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/CreateWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) GetWaiver(ctx context.Context, in *GetWaiverRequest, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) UpdateWaiver(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/UpdateWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) DeleteWaiver(ctx context.Context, in *DeleteWaiverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/DeleteWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error) {
+	out := new(ListWaiversResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListWaivers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rodeClient) EvaluateResource(ctx context.Context, in *ResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error) {
 	out := new(ResourceEvaluationResult)
 	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/EvaluateResource", in, out, opts...)
@@ -312,6 +478,51 @@ func (c *rodeClient) EvaluateResource(ctx context.Context, in *ResourceEvaluatio
 	return out, nil
 }
 
+func (c *rodeClient) EvaluateResourceAsync(ctx context.Context, in *ResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error) {
+	out := new(ResourceEvaluationResult)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/EvaluateResourceAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) BatchEvaluateResource(ctx context.Context, in *BatchEvaluateResourceRequest, opts ...grpc.CallOption) (*BatchEvaluateResourceResponse, error) {
+	out := new(BatchEvaluateResourceResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/BatchEvaluateResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) EvaluateResources(ctx context.Context, in *EvaluateResourcesRequest, opts ...grpc.CallOption) (*EvaluateResourcesResponse, error) {
+	out := new(EvaluateResourcesResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/EvaluateResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) GetResourceEvaluationStatement(ctx context.Context, in *GetResourceEvaluationStatementRequest, opts ...grpc.CallOption) (*DsseEnvelope, error) {
+	out := new(DsseEnvelope)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetResourceEvaluationStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) GetEvaluationPublicKey(ctx context.Context, in *GetEvaluationPublicKeyRequest, opts ...grpc.CallOption) (*EvaluationPublicKey, error) {
+	out := new(EvaluationPublicKey)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetEvaluationPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rodeClient) GetResourceEvaluation(ctx context.Context, in *GetResourceEvaluationRequest, opts ...grpc.CallOption) (*ResourceEvaluationResult, error) {
 	out := new(ResourceEvaluationResult)
 	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetResourceEvaluation", in, out, opts...)
@@ -321,6 +532,33 @@ func (c *rodeClient) GetResourceEvaluation(ctx context.Context, in *GetResourceE
 	return out, nil
 }
 
+func (c *rodeClient) GetResourceEvaluationInput(ctx context.Context, in *GetResourceEvaluationInputRequest, opts ...grpc.CallOption) (*ResourceEvaluationInput, error) {
+	out := new(ResourceEvaluationInput)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/GetResourceEvaluationInput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) ReplayResourceEvaluation(ctx context.Context, in *ReplayResourceEvaluationRequest, opts ...grpc.CallOption) (*ReplayResourceEvaluationResponse, error) {
+	out := new(ReplayResourceEvaluationResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ReplayResourceEvaluation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rodeClient) AnalyzePolicyAssignmentImpact(ctx context.Context, in *AnalyzePolicyAssignmentImpactRequest, opts ...grpc.CallOption) (*AnalyzePolicyAssignmentImpactResponse, error) {
+	out := new(AnalyzePolicyAssignmentImpactResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/AnalyzePolicyAssignmentImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rodeClient) ListResourceEvaluations(ctx context.Context, in *ListResourceEvaluationsRequest, opts ...grpc.CallOption) (*ListResourceEvaluationsResponse, error) {
 	out := new(ListResourceEvaluationsResponse)
 	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListResourceEvaluations", in, out, opts...)
@@ -330,6 +568,47 @@ func (c *rodeClient) ListResourceEvaluations(ctx context.Context, in *ListResour
 	return out, nil
 }
 
+func (c *rodeClient) WatchResourceEvaluations(ctx context.Context, in *WatchResourceEvaluationsRequest, opts ...grpc.CallOption) (Rode_WatchResourceEvaluationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Rode_ServiceDesc.Streams[0], "/rode.v1alpha1.Rode/WatchResourceEvaluations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rodeWatchResourceEvaluationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Rode_WatchResourceEvaluationsClient interface {
+	Recv() (*ResourceEvaluationResult, error)
+	grpc.ClientStream
+}
+
+type rodeWatchResourceEvaluationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rodeWatchResourceEvaluationsClient) Recv() (*ResourceEvaluationResult, error) {
+	m := new(ResourceEvaluationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rodeClient) ListStaleResourceEvaluations(ctx context.Context, in *ListStaleResourceEvaluationsRequest, opts ...grpc.CallOption) (*ListStaleResourceEvaluationsResponse, error) {
+	out := new(ListStaleResourceEvaluationsResponse)
+	err := c.cc.Invoke(ctx, "/rode.v1alpha1.Rode/ListStaleResourceEvaluations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RodeServer is the server API for Rode service.
 // All implementations should embed UnimplementedRodeServer
 // for forward compatibility
@@ -364,15 +643,64 @@ type RodeServer interface {
 	ListPolicyGroups(context.Context, *ListPolicyGroupsRequest) (*ListPolicyGroupsResponse, error)
 	GetPolicyGroup(context.Context, *GetPolicyGroupRequest) (*PolicyGroup, error)
 	UpdatePolicyGroup(context.Context, *PolicyGroup) (*PolicyGroup, error)
+	// ResolvePolicyGroupAssignments returns the effective policy assignments for a PolicyGroup, after applying the assignments
+	// inherited from its parents.
+	ResolvePolicyGroupAssignments(context.Context, *ResolvePolicyGroupAssignmentsRequest) (*ResolvePolicyGroupAssignmentsResponse, error)
 	DeletePolicyGroup(context.Context, *DeletePolicyGroupRequest) (*emptypb.Empty, error)
 	CreatePolicyAssignment(context.Context, *PolicyAssignment) (*PolicyAssignment, error)
 	GetPolicyAssignment(context.Context, *GetPolicyAssignmentRequest) (*PolicyAssignment, error)
 	UpdatePolicyAssignment(context.Context, *PolicyAssignment) (*PolicyAssignment, error)
 	DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*emptypb.Empty, error)
 	ListPolicyAssignments(context.Context, *ListPolicyAssignmentsRequest) (*ListPolicyAssignmentsResponse, error)
+	CreateWaiver(context.Context, *Waiver) (*Waiver, error)
+	GetWaiver(context.Context, *GetWaiverRequest) (*Waiver, error)
+	UpdateWaiver(context.Context, *Waiver) (*Waiver, error)
+	DeleteWaiver(context.Context, *DeleteWaiverRequest) (*emptypb.Empty, error)
+	ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error)
+	CreateWebhook(context.Context, *Webhook) (*Webhook, error)
+	GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error)
+	UpdateWebhook(context.Context, *Webhook) (*Webhook, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// ListWebhookDeliveries returns the delivery log of a Webhook.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// ListAuditEvents returns the audit log of calls that changed state in Rode, starting with the most recent.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	EvaluateResource(context.Context, *ResourceEvaluationRequest) (*ResourceEvaluationResult, error)
+	// EvaluateResourceAsync returns as soon as the evaluation has been queued. The returned resource evaluation is PENDING,
+	// and GetResourceEvaluation can be polled until its state is either COMPLETE or FAILED.
+	EvaluateResourceAsync(context.Context, *ResourceEvaluationRequest) (*ResourceEvaluationResult, error)
+	// BatchEvaluateResource evaluates a single resource version against several policy groups, only fetching the resource's
+	// occurrences once.
+	BatchEvaluateResource(context.Context, *BatchEvaluateResourceRequest) (*BatchEvaluateResourceResponse, error)
+	// EvaluateResources evaluates several resource versions against a single policy group. A resource that can't be evaluated
+	// is reported in its result without failing the rest of the request.
+	EvaluateResources(context.Context, *EvaluateResourcesRequest) (*EvaluateResourcesResponse, error)
+	// GetResourceEvaluationStatement exports a completed resource evaluation as an in-toto Statement wrapped in a signed DSSE envelope.
+	// The subject is the digest of the evaluated resource version, and the predicate describes the policy group, the policy
+	// versions that were evaluated, and their results.
+	GetResourceEvaluationStatement(context.Context, *GetResourceEvaluationStatementRequest) (*DsseEnvelope, error)
+	// GetEvaluationPublicKey returns the public key that verifies the signatures on resource and policy evaluations.
+	GetEvaluationPublicKey(context.Context, *GetEvaluationPublicKeyRequest) (*EvaluationPublicKey, error)
 	GetResourceEvaluation(context.Context, *GetResourceEvaluationRequest) (*ResourceEvaluationResult, error)
+	// GetResourceEvaluationInput returns the input document that was evaluated by each policy in a resource evaluation,
+	// so that it can be audited or replayed after the underlying occurrences have changed.
+	GetResourceEvaluationInput(context.Context, *GetResourceEvaluationInputRequest) (*ResourceEvaluationInput, error)
+	// ReplayResourceEvaluation re-runs policy versions against the input of a past resource evaluation and compares the
+	// outcome of each rule with the original. Nothing is stored.
+	ReplayResourceEvaluation(context.Context, *ReplayResourceEvaluationRequest) (*ReplayResourceEvaluationResponse, error)
+	// AnalyzePolicyAssignmentImpact evaluates a candidate policy version against the resource versions that were recently
+	// evaluated in the assignment's policy group, or in a descendant policy group that inherits the assignment, and reports
+	// which results would change if the assignment were updated. Nothing is stored.
+	AnalyzePolicyAssignmentImpact(context.Context, *AnalyzePolicyAssignmentImpactRequest) (*AnalyzePolicyAssignmentImpactResponse, error)
 	ListResourceEvaluations(context.Context, *ListResourceEvaluationsRequest) (*ListResourceEvaluationsResponse, error)
+	// WatchResourceEvaluations streams resource evaluation results as they're completed or fail. The filter uses the same
+	// syntax as ListResourceEvaluations and is applied to the stored resource evaluation, so policy group, pass, state,
+	// and resource type can be matched. Results that were stored before the stream was opened are not sent.
+	WatchResourceEvaluations(*WatchResourceEvaluationsRequest, Rode_WatchResourceEvaluationsServer) error
+	// ListStaleResourceEvaluations returns the latest resource evaluations in a policy group that were made against a
+	// different set of policy versions than the ones currently assigned to the group.
+	ListStaleResourceEvaluations(context.Context, *ListStaleResourceEvaluationsRequest) (*ListStaleResourceEvaluationsResponse, error)
 }
 
 // UnimplementedRodeServer should be embedded to have forward compatible implementations.
@@ -439,6 +767,9 @@ func (UnimplementedRodeServer) GetPolicyGroup(context.Context, *GetPolicyGroupRe
 func (UnimplementedRodeServer) UpdatePolicyGroup(context.Context, *PolicyGroup) (*PolicyGroup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicyGroup not implemented")
 }
+func (UnimplementedRodeServer) ResolvePolicyGroupAssignments(context.Context, *ResolvePolicyGroupAssignmentsRequest) (*ResolvePolicyGroupAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePolicyGroupAssignments not implemented")
+}
 func (UnimplementedRodeServer) DeletePolicyGroup(context.Context, *DeletePolicyGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicyGroup not implemented")
 }
@@ -457,15 +788,81 @@ func (UnimplementedRodeServer) DeletePolicyAssignment(context.Context, *DeletePo
 func (UnimplementedRodeServer) ListPolicyAssignments(context.Context, *ListPolicyAssignmentsRequest) (*ListPolicyAssignmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyAssignments not implemented")
 }
+func (UnimplementedRodeServer) CreateWaiver(context.Context, *Waiver) (*Waiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWaiver not implemented")
+}
+func (UnimplementedRodeServer) GetWaiver(context.Context, *GetWaiverRequest) (*Waiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaiver not implemented")
+}
+func (UnimplementedRodeServer) UpdateWaiver(context.Context, *Waiver) (*Waiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWaiver not implemented")
+}
+func (UnimplementedRodeServer) DeleteWaiver(context.Context, *DeleteWaiverRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWaiver not implemented")
+}
+func (UnimplementedRodeServer) ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaivers not implemented")
+}
+func (UnimplementedRodeServer) CreateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedRodeServer) GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedRodeServer) UpdateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedRodeServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedRodeServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedRodeServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedRodeServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedRodeServer) EvaluateResource(context.Context, *ResourceEvaluationRequest) (*ResourceEvaluationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvaluateResource not implemented")
 }
+func (UnimplementedRodeServer) EvaluateResourceAsync(context.Context, *ResourceEvaluationRequest) (*ResourceEvaluationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateResourceAsync not implemented")
+}
+func (UnimplementedRodeServer) BatchEvaluateResource(context.Context, *BatchEvaluateResourceRequest) (*BatchEvaluateResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEvaluateResource not implemented")
+}
+func (UnimplementedRodeServer) EvaluateResources(context.Context, *EvaluateResourcesRequest) (*EvaluateResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateResources not implemented")
+}
+func (UnimplementedRodeServer) GetResourceEvaluationStatement(context.Context, *GetResourceEvaluationStatementRequest) (*DsseEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceEvaluationStatement not implemented")
+}
+func (UnimplementedRodeServer) GetEvaluationPublicKey(context.Context, *GetEvaluationPublicKeyRequest) (*EvaluationPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvaluationPublicKey not implemented")
+}
 func (UnimplementedRodeServer) GetResourceEvaluation(context.Context, *GetResourceEvaluationRequest) (*ResourceEvaluationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourceEvaluation not implemented")
 }
+func (UnimplementedRodeServer) GetResourceEvaluationInput(context.Context, *GetResourceEvaluationInputRequest) (*ResourceEvaluationInput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceEvaluationInput not implemented")
+}
+func (UnimplementedRodeServer) ReplayResourceEvaluation(context.Context, *ReplayResourceEvaluationRequest) (*ReplayResourceEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayResourceEvaluation not implemented")
+}
+func (UnimplementedRodeServer) AnalyzePolicyAssignmentImpact(context.Context, *AnalyzePolicyAssignmentImpactRequest) (*AnalyzePolicyAssignmentImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzePolicyAssignmentImpact not implemented")
+}
 func (UnimplementedRodeServer) ListResourceEvaluations(context.Context, *ListResourceEvaluationsRequest) (*ListResourceEvaluationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvaluations not implemented")
 }
+func (UnimplementedRodeServer) WatchResourceEvaluations(*WatchResourceEvaluationsRequest, Rode_WatchResourceEvaluationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResourceEvaluations not implemented")
+}
+func (UnimplementedRodeServer) ListStaleResourceEvaluations(context.Context, *ListStaleResourceEvaluationsRequest) (*ListStaleResourceEvaluationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleResourceEvaluations not implemented")
+}
 
 // UnsafeRodeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RodeServer will
@@ -838,6 +1235,24 @@ func _Rode_UpdatePolicyGroup_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rode_ResolvePolicyGroupAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePolicyGroupAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ResolvePolicyGroupAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ResolvePolicyGroupAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ResolvePolicyGroupAssignments(ctx, req.(*ResolvePolicyGroupAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rode_DeletePolicyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePolicyGroupRequest)
 	if err := dec(in); err != nil {
@@ -946,113 +1361,512 @@ func _Rode_ListPolicyAssignments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Rode_EvaluateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceEvaluationRequest)
+func _Rode_CreateWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RodeServer).EvaluateResource(ctx, in)
+		return srv.(RodeServer).CreateWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rode.v1alpha1.Rode/EvaluateResource",
+		FullMethod: "/rode.v1alpha1.Rode/CreateWaiver",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RodeServer).EvaluateResource(ctx, req.(*ResourceEvaluationRequest))
+		return srv.(RodeServer).CreateWaiver(ctx, req.(*Waiver))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Rode_GetResourceEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetResourceEvaluationRequest)
+func _Rode_GetWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaiverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RodeServer).GetResourceEvaluation(ctx, in)
+		return srv.(RodeServer).GetWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rode.v1alpha1.Rode/GetResourceEvaluation",
+		FullMethod: "/rode.v1alpha1.Rode/GetWaiver",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RodeServer).GetResourceEvaluation(ctx, req.(*GetResourceEvaluationRequest))
+		return srv.(RodeServer).GetWaiver(ctx, req.(*GetWaiverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Rode_ListResourceEvaluations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListResourceEvaluationsRequest)
+func _Rode_UpdateWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RodeServer).ListResourceEvaluations(ctx, in)
+		return srv.(RodeServer).UpdateWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rode.v1alpha1.Rode/ListResourceEvaluations",
+		FullMethod: "/rode.v1alpha1.Rode/UpdateWaiver",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RodeServer).ListResourceEvaluations(ctx, req.(*ListResourceEvaluationsRequest))
+		return srv.(RodeServer).UpdateWaiver(ctx, req.(*Waiver))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Rode_ServiceDesc is the grpc.ServiceDesc for Rode service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Rode_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "rode.v1alpha1.Rode",
-	HandlerType: (*RodeServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BatchCreateOccurrences",
-			Handler:    _Rode_BatchCreateOccurrences_Handler,
-		},
-		{
-			MethodName: "EvaluatePolicy",
-			Handler:    _Rode_EvaluatePolicy_Handler,
-		},
-		{
-			MethodName: "ListResources",
-			Handler:    _Rode_ListResources_Handler,
-		},
-		{
-			MethodName: "ListResourceVersions",
-			Handler:    _Rode_ListResourceVersions_Handler,
-		},
-		{
-			MethodName: "ListVersionedResourceOccurrences",
-			Handler:    _Rode_ListVersionedResourceOccurrences_Handler,
-		},
-		{
-			MethodName: "ListOccurrences",
-			Handler:    _Rode_ListOccurrences_Handler,
-		},
-		{
-			MethodName: "UpdateOccurrence",
-			Handler:    _Rode_UpdateOccurrence_Handler,
-		},
-		{
-			MethodName: "CreatePolicy",
-			Handler:    _Rode_CreatePolicy_Handler,
-		},
-		{
-			MethodName: "GetPolicy",
-			Handler:    _Rode_GetPolicy_Handler,
-		},
-		{
-			MethodName: "DeletePolicy",
-			Handler:    _Rode_DeletePolicy_Handler,
-		},
-		{
-			MethodName: "ListPolicies",
-			Handler:    _Rode_ListPolicies_Handler,
-		},
-		{
-			MethodName: "ListPolicyVersions",
+func _Rode_DeleteWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).DeleteWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/DeleteWaiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).DeleteWaiver(ctx, req.(*DeleteWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ListWaivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaiversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListWaivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListWaivers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListWaivers(ctx, req.(*ListWaiversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).CreateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/GetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/UpdateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).UpdateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_EvaluateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).EvaluateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/EvaluateResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).EvaluateResource(ctx, req.(*ResourceEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_EvaluateResourceAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).EvaluateResourceAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/EvaluateResourceAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).EvaluateResourceAsync(ctx, req.(*ResourceEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_BatchEvaluateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEvaluateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).BatchEvaluateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/BatchEvaluateResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).BatchEvaluateResource(ctx, req.(*BatchEvaluateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_EvaluateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).EvaluateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/EvaluateResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).EvaluateResources(ctx, req.(*EvaluateResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_GetResourceEvaluationStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceEvaluationStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).GetResourceEvaluationStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/GetResourceEvaluationStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).GetResourceEvaluationStatement(ctx, req.(*GetResourceEvaluationStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_GetEvaluationPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvaluationPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).GetEvaluationPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/GetEvaluationPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).GetEvaluationPublicKey(ctx, req.(*GetEvaluationPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_GetResourceEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).GetResourceEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/GetResourceEvaluation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).GetResourceEvaluation(ctx, req.(*GetResourceEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_GetResourceEvaluationInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceEvaluationInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).GetResourceEvaluationInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/GetResourceEvaluationInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).GetResourceEvaluationInput(ctx, req.(*GetResourceEvaluationInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ReplayResourceEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayResourceEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ReplayResourceEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ReplayResourceEvaluation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ReplayResourceEvaluation(ctx, req.(*ReplayResourceEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_AnalyzePolicyAssignmentImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzePolicyAssignmentImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).AnalyzePolicyAssignmentImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/AnalyzePolicyAssignmentImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).AnalyzePolicyAssignmentImpact(ctx, req.(*AnalyzePolicyAssignmentImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_ListResourceEvaluations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceEvaluationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListResourceEvaluations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListResourceEvaluations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListResourceEvaluations(ctx, req.(*ListResourceEvaluationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rode_WatchResourceEvaluations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourceEvaluationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RodeServer).WatchResourceEvaluations(m, &rodeWatchResourceEvaluationsServer{stream})
+}
+
+type Rode_WatchResourceEvaluationsServer interface {
+	Send(*ResourceEvaluationResult) error
+	grpc.ServerStream
+}
+
+type rodeWatchResourceEvaluationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rodeWatchResourceEvaluationsServer) Send(m *ResourceEvaluationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Rode_ListStaleResourceEvaluations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleResourceEvaluationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RodeServer).ListStaleResourceEvaluations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rode.v1alpha1.Rode/ListStaleResourceEvaluations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RodeServer).ListStaleResourceEvaluations(ctx, req.(*ListStaleResourceEvaluationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Rode_ServiceDesc is the grpc.ServiceDesc for Rode service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Rode_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rode.v1alpha1.Rode",
+	HandlerType: (*RodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchCreateOccurrences",
+			Handler:    _Rode_BatchCreateOccurrences_Handler,
+		},
+		{
+			MethodName: "EvaluatePolicy",
+			Handler:    _Rode_EvaluatePolicy_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _Rode_ListResources_Handler,
+		},
+		{
+			MethodName: "ListResourceVersions",
+			Handler:    _Rode_ListResourceVersions_Handler,
+		},
+		{
+			MethodName: "ListVersionedResourceOccurrences",
+			Handler:    _Rode_ListVersionedResourceOccurrences_Handler,
+		},
+		{
+			MethodName: "ListOccurrences",
+			Handler:    _Rode_ListOccurrences_Handler,
+		},
+		{
+			MethodName: "UpdateOccurrence",
+			Handler:    _Rode_UpdateOccurrence_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _Rode_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "GetPolicy",
+			Handler:    _Rode_GetPolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _Rode_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _Rode_ListPolicies_Handler,
+		},
+		{
+			MethodName: "ListPolicyVersions",
 			Handler:    _Rode_ListPolicyVersions_Handler,
 		},
 		{
@@ -1087,6 +1901,10 @@ var Rode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePolicyGroup",
 			Handler:    _Rode_UpdatePolicyGroup_Handler,
 		},
+		{
+			MethodName: "ResolvePolicyGroupAssignments",
+			Handler:    _Rode_ResolvePolicyGroupAssignments_Handler,
+		},
 		{
 			MethodName: "DeletePolicyGroup",
 			Handler:    _Rode_DeletePolicyGroup_Handler,
@@ -1111,19 +1929,109 @@ var Rode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPolicyAssignments",
 			Handler:    _Rode_ListPolicyAssignments_Handler,
 		},
+		{
+			MethodName: "CreateWaiver",
+			Handler:    _Rode_CreateWaiver_Handler,
+		},
+		{
+			MethodName: "GetWaiver",
+			Handler:    _Rode_GetWaiver_Handler,
+		},
+		{
+			MethodName: "UpdateWaiver",
+			Handler:    _Rode_UpdateWaiver_Handler,
+		},
+		{
+			MethodName: "DeleteWaiver",
+			Handler:    _Rode_DeleteWaiver_Handler,
+		},
+		{
+			MethodName: "ListWaivers",
+			Handler:    _Rode_ListWaivers_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Rode_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _Rode_GetWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Rode_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Rode_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Rode_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Rode_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Rode_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "EvaluateResource",
 			Handler:    _Rode_EvaluateResource_Handler,
 		},
+		{
+			MethodName: "EvaluateResourceAsync",
+			Handler:    _Rode_EvaluateResourceAsync_Handler,
+		},
+		{
+			MethodName: "BatchEvaluateResource",
+			Handler:    _Rode_BatchEvaluateResource_Handler,
+		},
+		{
+			MethodName: "EvaluateResources",
+			Handler:    _Rode_EvaluateResources_Handler,
+		},
+		{
+			MethodName: "GetResourceEvaluationStatement",
+			Handler:    _Rode_GetResourceEvaluationStatement_Handler,
+		},
+		{
+			MethodName: "GetEvaluationPublicKey",
+			Handler:    _Rode_GetEvaluationPublicKey_Handler,
+		},
 		{
 			MethodName: "GetResourceEvaluation",
 			Handler:    _Rode_GetResourceEvaluation_Handler,
 		},
+		{
+			MethodName: "GetResourceEvaluationInput",
+			Handler:    _Rode_GetResourceEvaluationInput_Handler,
+		},
+		{
+			MethodName: "ReplayResourceEvaluation",
+			Handler:    _Rode_ReplayResourceEvaluation_Handler,
+		},
+		{
+			MethodName: "AnalyzePolicyAssignmentImpact",
+			Handler:    _Rode_AnalyzePolicyAssignmentImpact_Handler,
+		},
 		{
 			MethodName: "ListResourceEvaluations",
 			Handler:    _Rode_ListResourceEvaluations_Handler,
 		},
+		{
+			MethodName: "ListStaleResourceEvaluations",
+			Handler:    _Rode_ListStaleResourceEvaluations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResourceEvaluations",
+			Handler:       _Rode_WatchResourceEvaluations_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1alpha1/rode.proto",
 }
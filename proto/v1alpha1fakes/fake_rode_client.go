@@ -12,6 +12,21 @@ import (
 )
 
 type FakeRodeClient struct {
+	AnalyzePolicyAssignmentImpactStub        func(context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest, ...grpc.CallOption) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error)
+	analyzePolicyAssignmentImpactMutex       sync.RWMutex
+	analyzePolicyAssignmentImpactArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest
+		arg3 []grpc.CallOption
+	}
+	analyzePolicyAssignmentImpactReturns struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}
+	analyzePolicyAssignmentImpactReturnsOnCall map[int]struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}
 	BatchCreateOccurrencesStub        func(context.Context, *v1alpha1.BatchCreateOccurrencesRequest, ...grpc.CallOption) (*v1alpha1.BatchCreateOccurrencesResponse, error)
 	batchCreateOccurrencesMutex       sync.RWMutex
 	batchCreateOccurrencesArgsForCall []struct {
@@ -27,6 +42,21 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.BatchCreateOccurrencesResponse
 		result2 error
 	}
+	BatchEvaluateResourceStub        func(context.Context, *v1alpha1.BatchEvaluateResourceRequest, ...grpc.CallOption) (*v1alpha1.BatchEvaluateResourceResponse, error)
+	batchEvaluateResourceMutex       sync.RWMutex
+	batchEvaluateResourceArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.BatchEvaluateResourceRequest
+		arg3 []grpc.CallOption
+	}
+	batchEvaluateResourceReturns struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}
+	batchEvaluateResourceReturnsOnCall map[int]struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}
 	CreateNoteStub        func(context.Context, *v1alpha1.CreateNoteRequest, ...grpc.CallOption) (*grafeas_go_proto.Note, error)
 	createNoteMutex       sync.RWMutex
 	createNoteArgsForCall []struct {
@@ -87,6 +117,36 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.PolicyGroup
 		result2 error
 	}
+	CreateWaiverStub        func(context.Context, *v1alpha1.Waiver, ...grpc.CallOption) (*v1alpha1.Waiver, error)
+	createWaiverMutex       sync.RWMutex
+	createWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+		arg3 []grpc.CallOption
+	}
+	createWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	createWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	CreateWebhookStub        func(context.Context, *v1alpha1.Webhook, ...grpc.CallOption) (*v1alpha1.Webhook, error)
+	createWebhookMutex       sync.RWMutex
+	createWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+		arg3 []grpc.CallOption
+	}
+	createWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	createWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
 	DeletePolicyStub        func(context.Context, *v1alpha1.DeletePolicyRequest, ...grpc.CallOption) (*emptypb.Empty, error)
 	deletePolicyMutex       sync.RWMutex
 	deletePolicyArgsForCall []struct {
@@ -132,6 +192,36 @@ type FakeRodeClient struct {
 		result1 *emptypb.Empty
 		result2 error
 	}
+	DeleteWaiverStub        func(context.Context, *v1alpha1.DeleteWaiverRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+	deleteWaiverMutex       sync.RWMutex
+	deleteWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWaiverRequest
+		arg3 []grpc.CallOption
+	}
+	deleteWaiverReturns struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	deleteWaiverReturnsOnCall map[int]struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	DeleteWebhookStub        func(context.Context, *v1alpha1.DeleteWebhookRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWebhookRequest
+		arg3 []grpc.CallOption
+	}
+	deleteWebhookReturns struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 *emptypb.Empty
+		result2 error
+	}
 	EvaluatePolicyStub        func(context.Context, *v1alpha1.EvaluatePolicyRequest, ...grpc.CallOption) (*v1alpha1.EvaluatePolicyResponse, error)
 	evaluatePolicyMutex       sync.RWMutex
 	evaluatePolicyArgsForCall []struct {
@@ -162,6 +252,51 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.ResourceEvaluationResult
 		result2 error
 	}
+	EvaluateResourceAsyncStub        func(context.Context, *v1alpha1.ResourceEvaluationRequest, ...grpc.CallOption) (*v1alpha1.ResourceEvaluationResult, error)
+	evaluateResourceAsyncMutex       sync.RWMutex
+	evaluateResourceAsyncArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResourceEvaluationRequest
+		arg3 []grpc.CallOption
+	}
+	evaluateResourceAsyncReturns struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}
+	evaluateResourceAsyncReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}
+	EvaluateResourcesStub        func(context.Context, *v1alpha1.EvaluateResourcesRequest, ...grpc.CallOption) (*v1alpha1.EvaluateResourcesResponse, error)
+	evaluateResourcesMutex       sync.RWMutex
+	evaluateResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.EvaluateResourcesRequest
+		arg3 []grpc.CallOption
+	}
+	evaluateResourcesReturns struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}
+	evaluateResourcesReturnsOnCall map[int]struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}
+	GetEvaluationPublicKeyStub        func(context.Context, *v1alpha1.GetEvaluationPublicKeyRequest, ...grpc.CallOption) (*v1alpha1.EvaluationPublicKey, error)
+	getEvaluationPublicKeyMutex       sync.RWMutex
+	getEvaluationPublicKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetEvaluationPublicKeyRequest
+		arg3 []grpc.CallOption
+	}
+	getEvaluationPublicKeyReturns struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}
+	getEvaluationPublicKeyReturnsOnCall map[int]struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}
 	GetPolicyStub        func(context.Context, *v1alpha1.GetPolicyRequest, ...grpc.CallOption) (*v1alpha1.Policy, error)
 	getPolicyMutex       sync.RWMutex
 	getPolicyArgsForCall []struct {
@@ -222,6 +357,81 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.ResourceEvaluationResult
 		result2 error
 	}
+	GetResourceEvaluationInputStub        func(context.Context, *v1alpha1.GetResourceEvaluationInputRequest, ...grpc.CallOption) (*v1alpha1.ResourceEvaluationInput, error)
+	getResourceEvaluationInputMutex       sync.RWMutex
+	getResourceEvaluationInputArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationInputRequest
+		arg3 []grpc.CallOption
+	}
+	getResourceEvaluationInputReturns struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}
+	getResourceEvaluationInputReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}
+	GetResourceEvaluationStatementStub        func(context.Context, *v1alpha1.GetResourceEvaluationStatementRequest, ...grpc.CallOption) (*v1alpha1.DsseEnvelope, error)
+	getResourceEvaluationStatementMutex       sync.RWMutex
+	getResourceEvaluationStatementArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationStatementRequest
+		arg3 []grpc.CallOption
+	}
+	getResourceEvaluationStatementReturns struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}
+	getResourceEvaluationStatementReturnsOnCall map[int]struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}
+	GetWaiverStub        func(context.Context, *v1alpha1.GetWaiverRequest, ...grpc.CallOption) (*v1alpha1.Waiver, error)
+	getWaiverMutex       sync.RWMutex
+	getWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWaiverRequest
+		arg3 []grpc.CallOption
+	}
+	getWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	getWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	GetWebhookStub        func(context.Context, *v1alpha1.GetWebhookRequest, ...grpc.CallOption) (*v1alpha1.Webhook, error)
+	getWebhookMutex       sync.RWMutex
+	getWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWebhookRequest
+		arg3 []grpc.CallOption
+	}
+	getWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	getWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	ListAuditEventsStub        func(context.Context, *v1alpha1.ListAuditEventsRequest, ...grpc.CallOption) (*v1alpha1.ListAuditEventsResponse, error)
+	listAuditEventsMutex       sync.RWMutex
+	listAuditEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListAuditEventsRequest
+		arg3 []grpc.CallOption
+	}
+	listAuditEventsReturns struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}
+	listAuditEventsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}
 	ListOccurrencesStub        func(context.Context, *v1alpha1.ListOccurrencesRequest, ...grpc.CallOption) (*v1alpha1.ListOccurrencesResponse, error)
 	listOccurrencesMutex       sync.RWMutex
 	listOccurrencesArgsForCall []struct {
@@ -342,6 +552,21 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.ListResourcesResponse
 		result2 error
 	}
+	ListStaleResourceEvaluationsStub        func(context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest, ...grpc.CallOption) (*v1alpha1.ListStaleResourceEvaluationsResponse, error)
+	listStaleResourceEvaluationsMutex       sync.RWMutex
+	listStaleResourceEvaluationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListStaleResourceEvaluationsRequest
+		arg3 []grpc.CallOption
+	}
+	listStaleResourceEvaluationsReturns struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}
+	listStaleResourceEvaluationsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}
 	ListVersionedResourceOccurrencesStub        func(context.Context, *v1alpha1.ListVersionedResourceOccurrencesRequest, ...grpc.CallOption) (*v1alpha1.ListVersionedResourceOccurrencesResponse, error)
 	listVersionedResourceOccurrencesMutex       sync.RWMutex
 	listVersionedResourceOccurrencesArgsForCall []struct {
@@ -357,6 +582,51 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.ListVersionedResourceOccurrencesResponse
 		result2 error
 	}
+	ListWaiversStub        func(context.Context, *v1alpha1.ListWaiversRequest, ...grpc.CallOption) (*v1alpha1.ListWaiversResponse, error)
+	listWaiversMutex       sync.RWMutex
+	listWaiversArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWaiversRequest
+		arg3 []grpc.CallOption
+	}
+	listWaiversReturns struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}
+	listWaiversReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}
+	ListWebhookDeliveriesStub        func(context.Context, *v1alpha1.ListWebhookDeliveriesRequest, ...grpc.CallOption) (*v1alpha1.ListWebhookDeliveriesResponse, error)
+	listWebhookDeliveriesMutex       sync.RWMutex
+	listWebhookDeliveriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhookDeliveriesRequest
+		arg3 []grpc.CallOption
+	}
+	listWebhookDeliveriesReturns struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}
+	listWebhookDeliveriesReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}
+	ListWebhooksStub        func(context.Context, *v1alpha1.ListWebhooksRequest, ...grpc.CallOption) (*v1alpha1.ListWebhooksResponse, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhooksRequest
+		arg3 []grpc.CallOption
+	}
+	listWebhooksReturns struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}
 	RegisterCollectorStub        func(context.Context, *v1alpha1.RegisterCollectorRequest, ...grpc.CallOption) (*v1alpha1.RegisterCollectorResponse, error)
 	registerCollectorMutex       sync.RWMutex
 	registerCollectorArgsForCall []struct {
@@ -372,6 +642,36 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.RegisterCollectorResponse
 		result2 error
 	}
+	ReplayResourceEvaluationStub        func(context.Context, *v1alpha1.ReplayResourceEvaluationRequest, ...grpc.CallOption) (*v1alpha1.ReplayResourceEvaluationResponse, error)
+	replayResourceEvaluationMutex       sync.RWMutex
+	replayResourceEvaluationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ReplayResourceEvaluationRequest
+		arg3 []grpc.CallOption
+	}
+	replayResourceEvaluationReturns struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}
+	replayResourceEvaluationReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}
+	ResolvePolicyGroupAssignmentsStub        func(context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest, ...grpc.CallOption) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error)
+	resolvePolicyGroupAssignmentsMutex       sync.RWMutex
+	resolvePolicyGroupAssignmentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest
+		arg3 []grpc.CallOption
+	}
+	resolvePolicyGroupAssignmentsReturns struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}
+	resolvePolicyGroupAssignmentsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}
 	UpdateOccurrenceStub        func(context.Context, *v1alpha1.UpdateOccurrenceRequest, ...grpc.CallOption) (*grafeas_go_proto.Occurrence, error)
 	updateOccurrenceMutex       sync.RWMutex
 	updateOccurrenceArgsForCall []struct {
@@ -432,6 +732,36 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.PolicyGroup
 		result2 error
 	}
+	UpdateWaiverStub        func(context.Context, *v1alpha1.Waiver, ...grpc.CallOption) (*v1alpha1.Waiver, error)
+	updateWaiverMutex       sync.RWMutex
+	updateWaiverArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+		arg3 []grpc.CallOption
+	}
+	updateWaiverReturns struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	updateWaiverReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}
+	UpdateWebhookStub        func(context.Context, *v1alpha1.Webhook, ...grpc.CallOption) (*v1alpha1.Webhook, error)
+	updateWebhookMutex       sync.RWMutex
+	updateWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+		arg3 []grpc.CallOption
+	}
+	updateWebhookReturns struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
+	updateWebhookReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}
 	ValidatePolicyStub        func(context.Context, *v1alpha1.ValidatePolicyRequest, ...grpc.CallOption) (*v1alpha1.ValidatePolicyResponse, error)
 	validatePolicyMutex       sync.RWMutex
 	validatePolicyArgsForCall []struct {
@@ -447,10 +777,91 @@ type FakeRodeClient struct {
 		result1 *v1alpha1.ValidatePolicyResponse
 		result2 error
 	}
+	WatchResourceEvaluationsStub        func(context.Context, *v1alpha1.WatchResourceEvaluationsRequest, ...grpc.CallOption) (v1alpha1.Rode_WatchResourceEvaluationsClient, error)
+	watchResourceEvaluationsMutex       sync.RWMutex
+	watchResourceEvaluationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.WatchResourceEvaluationsRequest
+		arg3 []grpc.CallOption
+	}
+	watchResourceEvaluationsReturns struct {
+		result1 v1alpha1.Rode_WatchResourceEvaluationsClient
+		result2 error
+	}
+	watchResourceEvaluationsReturnsOnCall map[int]struct {
+		result1 v1alpha1.Rode_WatchResourceEvaluationsClient
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpact(arg1 context.Context, arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest, arg3 ...grpc.CallOption) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	ret, specificReturn := fake.analyzePolicyAssignmentImpactReturnsOnCall[len(fake.analyzePolicyAssignmentImpactArgsForCall)]
+	fake.analyzePolicyAssignmentImpactArgsForCall = append(fake.analyzePolicyAssignmentImpactArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.AnalyzePolicyAssignmentImpactRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.AnalyzePolicyAssignmentImpactStub
+	fakeReturns := fake.analyzePolicyAssignmentImpactReturns
+	fake.recordInvocation("AnalyzePolicyAssignmentImpact", []interface{}{arg1, arg2, arg3})
+	fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpactCallCount() int {
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
+	return len(fake.analyzePolicyAssignmentImpactArgsForCall)
+}
+
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpactCalls(stub func(context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest, ...grpc.CallOption) (*v1alpha1.AnalyzePolicyAssignmentImpactResponse, error)) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = stub
+}
+
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpactArgsForCall(i int) (context.Context, *v1alpha1.AnalyzePolicyAssignmentImpactRequest, []grpc.CallOption) {
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
+	argsForCall := fake.analyzePolicyAssignmentImpactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpactReturns(result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse, result2 error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = nil
+	fake.analyzePolicyAssignmentImpactReturns = struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) AnalyzePolicyAssignmentImpactReturnsOnCall(i int, result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse, result2 error) {
+	fake.analyzePolicyAssignmentImpactMutex.Lock()
+	defer fake.analyzePolicyAssignmentImpactMutex.Unlock()
+	fake.AnalyzePolicyAssignmentImpactStub = nil
+	if fake.analyzePolicyAssignmentImpactReturnsOnCall == nil {
+		fake.analyzePolicyAssignmentImpactReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+			result2 error
+		})
+	}
+	fake.analyzePolicyAssignmentImpactReturnsOnCall[i] = struct {
+		result1 *v1alpha1.AnalyzePolicyAssignmentImpactResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) BatchCreateOccurrences(arg1 context.Context, arg2 *v1alpha1.BatchCreateOccurrencesRequest, arg3 ...grpc.CallOption) (*v1alpha1.BatchCreateOccurrencesResponse, error) {
 	fake.batchCreateOccurrencesMutex.Lock()
 	ret, specificReturn := fake.batchCreateOccurrencesReturnsOnCall[len(fake.batchCreateOccurrencesArgsForCall)]
@@ -517,6 +928,72 @@ func (fake *FakeRodeClient) BatchCreateOccurrencesReturnsOnCall(i int, result1 *
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) BatchEvaluateResource(arg1 context.Context, arg2 *v1alpha1.BatchEvaluateResourceRequest, arg3 ...grpc.CallOption) (*v1alpha1.BatchEvaluateResourceResponse, error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	ret, specificReturn := fake.batchEvaluateResourceReturnsOnCall[len(fake.batchEvaluateResourceArgsForCall)]
+	fake.batchEvaluateResourceArgsForCall = append(fake.batchEvaluateResourceArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.BatchEvaluateResourceRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.BatchEvaluateResourceStub
+	fakeReturns := fake.batchEvaluateResourceReturns
+	fake.recordInvocation("BatchEvaluateResource", []interface{}{arg1, arg2, arg3})
+	fake.batchEvaluateResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) BatchEvaluateResourceCallCount() int {
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
+	return len(fake.batchEvaluateResourceArgsForCall)
+}
+
+func (fake *FakeRodeClient) BatchEvaluateResourceCalls(stub func(context.Context, *v1alpha1.BatchEvaluateResourceRequest, ...grpc.CallOption) (*v1alpha1.BatchEvaluateResourceResponse, error)) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = stub
+}
+
+func (fake *FakeRodeClient) BatchEvaluateResourceArgsForCall(i int) (context.Context, *v1alpha1.BatchEvaluateResourceRequest, []grpc.CallOption) {
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
+	argsForCall := fake.batchEvaluateResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) BatchEvaluateResourceReturns(result1 *v1alpha1.BatchEvaluateResourceResponse, result2 error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = nil
+	fake.batchEvaluateResourceReturns = struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) BatchEvaluateResourceReturnsOnCall(i int, result1 *v1alpha1.BatchEvaluateResourceResponse, result2 error) {
+	fake.batchEvaluateResourceMutex.Lock()
+	defer fake.batchEvaluateResourceMutex.Unlock()
+	fake.BatchEvaluateResourceStub = nil
+	if fake.batchEvaluateResourceReturnsOnCall == nil {
+		fake.batchEvaluateResourceReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.BatchEvaluateResourceResponse
+			result2 error
+		})
+	}
+	fake.batchEvaluateResourceReturnsOnCall[i] = struct {
+		result1 *v1alpha1.BatchEvaluateResourceResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) CreateNote(arg1 context.Context, arg2 *v1alpha1.CreateNoteRequest, arg3 ...grpc.CallOption) (*grafeas_go_proto.Note, error) {
 	fake.createNoteMutex.Lock()
 	ret, specificReturn := fake.createNoteReturnsOnCall[len(fake.createNoteArgsForCall)]
@@ -781,18 +1258,18 @@ func (fake *FakeRodeClient) CreatePolicyGroupReturnsOnCall(i int, result1 *v1alp
 	}{result1, result2}
 }
 
-func (fake *FakeRodeClient) DeletePolicy(arg1 context.Context, arg2 *v1alpha1.DeletePolicyRequest, arg3 ...grpc.CallOption) (*emptypb.Empty, error) {
-	fake.deletePolicyMutex.Lock()
-	ret, specificReturn := fake.deletePolicyReturnsOnCall[len(fake.deletePolicyArgsForCall)]
-	fake.deletePolicyArgsForCall = append(fake.deletePolicyArgsForCall, struct {
+func (fake *FakeRodeClient) CreateWaiver(arg1 context.Context, arg2 *v1alpha1.Waiver, arg3 ...grpc.CallOption) (*v1alpha1.Waiver, error) {
+	fake.createWaiverMutex.Lock()
+	ret, specificReturn := fake.createWaiverReturnsOnCall[len(fake.createWaiverArgsForCall)]
+	fake.createWaiverArgsForCall = append(fake.createWaiverArgsForCall, struct {
 		arg1 context.Context
-		arg2 *v1alpha1.DeletePolicyRequest
+		arg2 *v1alpha1.Waiver
 		arg3 []grpc.CallOption
 	}{arg1, arg2, arg3})
-	stub := fake.DeletePolicyStub
-	fakeReturns := fake.deletePolicyReturns
-	fake.recordInvocation("DeletePolicy", []interface{}{arg1, arg2, arg3})
-	fake.deletePolicyMutex.Unlock()
+	stub := fake.CreateWaiverStub
+	fakeReturns := fake.createWaiverReturns
+	fake.recordInvocation("CreateWaiver", []interface{}{arg1, arg2, arg3})
+	fake.createWaiverMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -802,36 +1279,168 @@ func (fake *FakeRodeClient) DeletePolicy(arg1 context.Context, arg2 *v1alpha1.De
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRodeClient) DeletePolicyCallCount() int {
-	fake.deletePolicyMutex.RLock()
-	defer fake.deletePolicyMutex.RUnlock()
-	return len(fake.deletePolicyArgsForCall)
+func (fake *FakeRodeClient) CreateWaiverCallCount() int {
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	return len(fake.createWaiverArgsForCall)
 }
 
-func (fake *FakeRodeClient) DeletePolicyCalls(stub func(context.Context, *v1alpha1.DeletePolicyRequest, ...grpc.CallOption) (*emptypb.Empty, error)) {
-	fake.deletePolicyMutex.Lock()
-	defer fake.deletePolicyMutex.Unlock()
-	fake.DeletePolicyStub = stub
+func (fake *FakeRodeClient) CreateWaiverCalls(stub func(context.Context, *v1alpha1.Waiver, ...grpc.CallOption) (*v1alpha1.Waiver, error)) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = stub
 }
 
-func (fake *FakeRodeClient) DeletePolicyArgsForCall(i int) (context.Context, *v1alpha1.DeletePolicyRequest, []grpc.CallOption) {
-	fake.deletePolicyMutex.RLock()
-	defer fake.deletePolicyMutex.RUnlock()
-	argsForCall := fake.deletePolicyArgsForCall[i]
+func (fake *FakeRodeClient) CreateWaiverArgsForCall(i int) (context.Context, *v1alpha1.Waiver, []grpc.CallOption) {
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	argsForCall := fake.createWaiverArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRodeClient) DeletePolicyReturns(result1 *emptypb.Empty, result2 error) {
-	fake.deletePolicyMutex.Lock()
-	defer fake.deletePolicyMutex.Unlock()
-	fake.DeletePolicyStub = nil
-	fake.deletePolicyReturns = struct {
-		result1 *emptypb.Empty
+func (fake *FakeRodeClient) CreateWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = nil
+	fake.createWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRodeClient) DeletePolicyReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
+func (fake *FakeRodeClient) CreateWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.createWaiverMutex.Lock()
+	defer fake.createWaiverMutex.Unlock()
+	fake.CreateWaiverStub = nil
+	if fake.createWaiverReturnsOnCall == nil {
+		fake.createWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.createWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) CreateWebhook(arg1 context.Context, arg2 *v1alpha1.Webhook, arg3 ...grpc.CallOption) (*v1alpha1.Webhook, error) {
+	fake.createWebhookMutex.Lock()
+	ret, specificReturn := fake.createWebhookReturnsOnCall[len(fake.createWebhookArgsForCall)]
+	fake.createWebhookArgsForCall = append(fake.createWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.CreateWebhookStub
+	fakeReturns := fake.createWebhookReturns
+	fake.recordInvocation("CreateWebhook", []interface{}{arg1, arg2, arg3})
+	fake.createWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) CreateWebhookCallCount() int {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	return len(fake.createWebhookArgsForCall)
+}
+
+func (fake *FakeRodeClient) CreateWebhookCalls(stub func(context.Context, *v1alpha1.Webhook, ...grpc.CallOption) (*v1alpha1.Webhook, error)) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = stub
+}
+
+func (fake *FakeRodeClient) CreateWebhookArgsForCall(i int) (context.Context, *v1alpha1.Webhook, []grpc.CallOption) {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	argsForCall := fake.createWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) CreateWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	fake.createWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) CreateWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.createWebhookMutex.Lock()
+	defer fake.createWebhookMutex.Unlock()
+	fake.CreateWebhookStub = nil
+	if fake.createWebhookReturnsOnCall == nil {
+		fake.createWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.createWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) DeletePolicy(arg1 context.Context, arg2 *v1alpha1.DeletePolicyRequest, arg3 ...grpc.CallOption) (*emptypb.Empty, error) {
+	fake.deletePolicyMutex.Lock()
+	ret, specificReturn := fake.deletePolicyReturnsOnCall[len(fake.deletePolicyArgsForCall)]
+	fake.deletePolicyArgsForCall = append(fake.deletePolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeletePolicyRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.DeletePolicyStub
+	fakeReturns := fake.deletePolicyReturns
+	fake.recordInvocation("DeletePolicy", []interface{}{arg1, arg2, arg3})
+	fake.deletePolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) DeletePolicyCallCount() int {
+	fake.deletePolicyMutex.RLock()
+	defer fake.deletePolicyMutex.RUnlock()
+	return len(fake.deletePolicyArgsForCall)
+}
+
+func (fake *FakeRodeClient) DeletePolicyCalls(stub func(context.Context, *v1alpha1.DeletePolicyRequest, ...grpc.CallOption) (*emptypb.Empty, error)) {
+	fake.deletePolicyMutex.Lock()
+	defer fake.deletePolicyMutex.Unlock()
+	fake.DeletePolicyStub = stub
+}
+
+func (fake *FakeRodeClient) DeletePolicyArgsForCall(i int) (context.Context, *v1alpha1.DeletePolicyRequest, []grpc.CallOption) {
+	fake.deletePolicyMutex.RLock()
+	defer fake.deletePolicyMutex.RUnlock()
+	argsForCall := fake.deletePolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) DeletePolicyReturns(result1 *emptypb.Empty, result2 error) {
+	fake.deletePolicyMutex.Lock()
+	defer fake.deletePolicyMutex.Unlock()
+	fake.DeletePolicyStub = nil
+	fake.deletePolicyReturns = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) DeletePolicyReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
 	fake.deletePolicyMutex.Lock()
 	defer fake.deletePolicyMutex.Unlock()
 	fake.DeletePolicyStub = nil
@@ -979,6 +1588,138 @@ func (fake *FakeRodeClient) DeletePolicyGroupReturnsOnCall(i int, result1 *empty
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) DeleteWaiver(arg1 context.Context, arg2 *v1alpha1.DeleteWaiverRequest, arg3 ...grpc.CallOption) (*emptypb.Empty, error) {
+	fake.deleteWaiverMutex.Lock()
+	ret, specificReturn := fake.deleteWaiverReturnsOnCall[len(fake.deleteWaiverArgsForCall)]
+	fake.deleteWaiverArgsForCall = append(fake.deleteWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWaiverRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteWaiverStub
+	fakeReturns := fake.deleteWaiverReturns
+	fake.recordInvocation("DeleteWaiver", []interface{}{arg1, arg2, arg3})
+	fake.deleteWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) DeleteWaiverCallCount() int {
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	return len(fake.deleteWaiverArgsForCall)
+}
+
+func (fake *FakeRodeClient) DeleteWaiverCalls(stub func(context.Context, *v1alpha1.DeleteWaiverRequest, ...grpc.CallOption) (*emptypb.Empty, error)) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = stub
+}
+
+func (fake *FakeRodeClient) DeleteWaiverArgsForCall(i int) (context.Context, *v1alpha1.DeleteWaiverRequest, []grpc.CallOption) {
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	argsForCall := fake.deleteWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) DeleteWaiverReturns(result1 *emptypb.Empty, result2 error) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = nil
+	fake.deleteWaiverReturns = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) DeleteWaiverReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
+	fake.deleteWaiverMutex.Lock()
+	defer fake.deleteWaiverMutex.Unlock()
+	fake.DeleteWaiverStub = nil
+	if fake.deleteWaiverReturnsOnCall == nil {
+		fake.deleteWaiverReturnsOnCall = make(map[int]struct {
+			result1 *emptypb.Empty
+			result2 error
+		})
+	}
+	fake.deleteWaiverReturnsOnCall[i] = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) DeleteWebhook(arg1 context.Context, arg2 *v1alpha1.DeleteWebhookRequest, arg3 ...grpc.CallOption) (*emptypb.Empty, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.DeleteWebhookRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteWebhookStub
+	fakeReturns := fake.deleteWebhookReturns
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1, arg2, arg3})
+	fake.deleteWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeRodeClient) DeleteWebhookCalls(stub func(context.Context, *v1alpha1.DeleteWebhookRequest, ...grpc.CallOption) (*emptypb.Empty, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeRodeClient) DeleteWebhookArgsForCall(i int) (context.Context, *v1alpha1.DeleteWebhookRequest, []grpc.CallOption) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) DeleteWebhookReturns(result1 *emptypb.Empty, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) DeleteWebhookReturnsOnCall(i int, result1 *emptypb.Empty, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 *emptypb.Empty
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 *emptypb.Empty
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) EvaluatePolicy(arg1 context.Context, arg2 *v1alpha1.EvaluatePolicyRequest, arg3 ...grpc.CallOption) (*v1alpha1.EvaluatePolicyResponse, error) {
 	fake.evaluatePolicyMutex.Lock()
 	ret, specificReturn := fake.evaluatePolicyReturnsOnCall[len(fake.evaluatePolicyArgsForCall)]
@@ -1111,6 +1852,204 @@ func (fake *FakeRodeClient) EvaluateResourceReturnsOnCall(i int, result1 *v1alph
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) EvaluateResourceAsync(arg1 context.Context, arg2 *v1alpha1.ResourceEvaluationRequest, arg3 ...grpc.CallOption) (*v1alpha1.ResourceEvaluationResult, error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	ret, specificReturn := fake.evaluateResourceAsyncReturnsOnCall[len(fake.evaluateResourceAsyncArgsForCall)]
+	fake.evaluateResourceAsyncArgsForCall = append(fake.evaluateResourceAsyncArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResourceEvaluationRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.EvaluateResourceAsyncStub
+	fakeReturns := fake.evaluateResourceAsyncReturns
+	fake.recordInvocation("EvaluateResourceAsync", []interface{}{arg1, arg2, arg3})
+	fake.evaluateResourceAsyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) EvaluateResourceAsyncCallCount() int {
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	return len(fake.evaluateResourceAsyncArgsForCall)
+}
+
+func (fake *FakeRodeClient) EvaluateResourceAsyncCalls(stub func(context.Context, *v1alpha1.ResourceEvaluationRequest, ...grpc.CallOption) (*v1alpha1.ResourceEvaluationResult, error)) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = stub
+}
+
+func (fake *FakeRodeClient) EvaluateResourceAsyncArgsForCall(i int) (context.Context, *v1alpha1.ResourceEvaluationRequest, []grpc.CallOption) {
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	argsForCall := fake.evaluateResourceAsyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) EvaluateResourceAsyncReturns(result1 *v1alpha1.ResourceEvaluationResult, result2 error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = nil
+	fake.evaluateResourceAsyncReturns = struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) EvaluateResourceAsyncReturnsOnCall(i int, result1 *v1alpha1.ResourceEvaluationResult, result2 error) {
+	fake.evaluateResourceAsyncMutex.Lock()
+	defer fake.evaluateResourceAsyncMutex.Unlock()
+	fake.EvaluateResourceAsyncStub = nil
+	if fake.evaluateResourceAsyncReturnsOnCall == nil {
+		fake.evaluateResourceAsyncReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResourceEvaluationResult
+			result2 error
+		})
+	}
+	fake.evaluateResourceAsyncReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResourceEvaluationResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) EvaluateResources(arg1 context.Context, arg2 *v1alpha1.EvaluateResourcesRequest, arg3 ...grpc.CallOption) (*v1alpha1.EvaluateResourcesResponse, error) {
+	fake.evaluateResourcesMutex.Lock()
+	ret, specificReturn := fake.evaluateResourcesReturnsOnCall[len(fake.evaluateResourcesArgsForCall)]
+	fake.evaluateResourcesArgsForCall = append(fake.evaluateResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.EvaluateResourcesRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.EvaluateResourcesStub
+	fakeReturns := fake.evaluateResourcesReturns
+	fake.recordInvocation("EvaluateResources", []interface{}{arg1, arg2, arg3})
+	fake.evaluateResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) EvaluateResourcesCallCount() int {
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	return len(fake.evaluateResourcesArgsForCall)
+}
+
+func (fake *FakeRodeClient) EvaluateResourcesCalls(stub func(context.Context, *v1alpha1.EvaluateResourcesRequest, ...grpc.CallOption) (*v1alpha1.EvaluateResourcesResponse, error)) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = stub
+}
+
+func (fake *FakeRodeClient) EvaluateResourcesArgsForCall(i int) (context.Context, *v1alpha1.EvaluateResourcesRequest, []grpc.CallOption) {
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	argsForCall := fake.evaluateResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) EvaluateResourcesReturns(result1 *v1alpha1.EvaluateResourcesResponse, result2 error) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = nil
+	fake.evaluateResourcesReturns = struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) EvaluateResourcesReturnsOnCall(i int, result1 *v1alpha1.EvaluateResourcesResponse, result2 error) {
+	fake.evaluateResourcesMutex.Lock()
+	defer fake.evaluateResourcesMutex.Unlock()
+	fake.EvaluateResourcesStub = nil
+	if fake.evaluateResourcesReturnsOnCall == nil {
+		fake.evaluateResourcesReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.EvaluateResourcesResponse
+			result2 error
+		})
+	}
+	fake.evaluateResourcesReturnsOnCall[i] = struct {
+		result1 *v1alpha1.EvaluateResourcesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKey(arg1 context.Context, arg2 *v1alpha1.GetEvaluationPublicKeyRequest, arg3 ...grpc.CallOption) (*v1alpha1.EvaluationPublicKey, error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	ret, specificReturn := fake.getEvaluationPublicKeyReturnsOnCall[len(fake.getEvaluationPublicKeyArgsForCall)]
+	fake.getEvaluationPublicKeyArgsForCall = append(fake.getEvaluationPublicKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetEvaluationPublicKeyRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.GetEvaluationPublicKeyStub
+	fakeReturns := fake.getEvaluationPublicKeyReturns
+	fake.recordInvocation("GetEvaluationPublicKey", []interface{}{arg1, arg2, arg3})
+	fake.getEvaluationPublicKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKeyCallCount() int {
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
+	return len(fake.getEvaluationPublicKeyArgsForCall)
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKeyCalls(stub func(context.Context, *v1alpha1.GetEvaluationPublicKeyRequest, ...grpc.CallOption) (*v1alpha1.EvaluationPublicKey, error)) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = stub
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKeyArgsForCall(i int) (context.Context, *v1alpha1.GetEvaluationPublicKeyRequest, []grpc.CallOption) {
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
+	argsForCall := fake.getEvaluationPublicKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKeyReturns(result1 *v1alpha1.EvaluationPublicKey, result2 error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = nil
+	fake.getEvaluationPublicKeyReturns = struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetEvaluationPublicKeyReturnsOnCall(i int, result1 *v1alpha1.EvaluationPublicKey, result2 error) {
+	fake.getEvaluationPublicKeyMutex.Lock()
+	defer fake.getEvaluationPublicKeyMutex.Unlock()
+	fake.GetEvaluationPublicKeyStub = nil
+	if fake.getEvaluationPublicKeyReturnsOnCall == nil {
+		fake.getEvaluationPublicKeyReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.EvaluationPublicKey
+			result2 error
+		})
+	}
+	fake.getEvaluationPublicKeyReturnsOnCall[i] = struct {
+		result1 *v1alpha1.EvaluationPublicKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) GetPolicy(arg1 context.Context, arg2 *v1alpha1.GetPolicyRequest, arg3 ...grpc.CallOption) (*v1alpha1.Policy, error) {
 	fake.getPolicyMutex.Lock()
 	ret, specificReturn := fake.getPolicyReturnsOnCall[len(fake.getPolicyArgsForCall)]
@@ -1375,17 +2314,347 @@ func (fake *FakeRodeClient) GetResourceEvaluationReturnsOnCall(i int, result1 *v
 	}{result1, result2}
 }
 
-func (fake *FakeRodeClient) ListOccurrences(arg1 context.Context, arg2 *v1alpha1.ListOccurrencesRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListOccurrencesResponse, error) {
-	fake.listOccurrencesMutex.Lock()
-	ret, specificReturn := fake.listOccurrencesReturnsOnCall[len(fake.listOccurrencesArgsForCall)]
-	fake.listOccurrencesArgsForCall = append(fake.listOccurrencesArgsForCall, struct {
+func (fake *FakeRodeClient) GetResourceEvaluationInput(arg1 context.Context, arg2 *v1alpha1.GetResourceEvaluationInputRequest, arg3 ...grpc.CallOption) (*v1alpha1.ResourceEvaluationInput, error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	ret, specificReturn := fake.getResourceEvaluationInputReturnsOnCall[len(fake.getResourceEvaluationInputArgsForCall)]
+	fake.getResourceEvaluationInputArgsForCall = append(fake.getResourceEvaluationInputArgsForCall, struct {
 		arg1 context.Context
-		arg2 *v1alpha1.ListOccurrencesRequest
+		arg2 *v1alpha1.GetResourceEvaluationInputRequest
 		arg3 []grpc.CallOption
 	}{arg1, arg2, arg3})
-	stub := fake.ListOccurrencesStub
-	fakeReturns := fake.listOccurrencesReturns
-	fake.recordInvocation("ListOccurrences", []interface{}{arg1, arg2, arg3})
+	stub := fake.GetResourceEvaluationInputStub
+	fakeReturns := fake.getResourceEvaluationInputReturns
+	fake.recordInvocation("GetResourceEvaluationInput", []interface{}{arg1, arg2, arg3})
+	fake.getResourceEvaluationInputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationInputCallCount() int {
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	return len(fake.getResourceEvaluationInputArgsForCall)
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationInputCalls(stub func(context.Context, *v1alpha1.GetResourceEvaluationInputRequest, ...grpc.CallOption) (*v1alpha1.ResourceEvaluationInput, error)) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = stub
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationInputArgsForCall(i int) (context.Context, *v1alpha1.GetResourceEvaluationInputRequest, []grpc.CallOption) {
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	argsForCall := fake.getResourceEvaluationInputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationInputReturns(result1 *v1alpha1.ResourceEvaluationInput, result2 error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = nil
+	fake.getResourceEvaluationInputReturns = struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationInputReturnsOnCall(i int, result1 *v1alpha1.ResourceEvaluationInput, result2 error) {
+	fake.getResourceEvaluationInputMutex.Lock()
+	defer fake.getResourceEvaluationInputMutex.Unlock()
+	fake.GetResourceEvaluationInputStub = nil
+	if fake.getResourceEvaluationInputReturnsOnCall == nil {
+		fake.getResourceEvaluationInputReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResourceEvaluationInput
+			result2 error
+		})
+	}
+	fake.getResourceEvaluationInputReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResourceEvaluationInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatement(arg1 context.Context, arg2 *v1alpha1.GetResourceEvaluationStatementRequest, arg3 ...grpc.CallOption) (*v1alpha1.DsseEnvelope, error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	ret, specificReturn := fake.getResourceEvaluationStatementReturnsOnCall[len(fake.getResourceEvaluationStatementArgsForCall)]
+	fake.getResourceEvaluationStatementArgsForCall = append(fake.getResourceEvaluationStatementArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetResourceEvaluationStatementRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.GetResourceEvaluationStatementStub
+	fakeReturns := fake.getResourceEvaluationStatementReturns
+	fake.recordInvocation("GetResourceEvaluationStatement", []interface{}{arg1, arg2, arg3})
+	fake.getResourceEvaluationStatementMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatementCallCount() int {
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
+	return len(fake.getResourceEvaluationStatementArgsForCall)
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatementCalls(stub func(context.Context, *v1alpha1.GetResourceEvaluationStatementRequest, ...grpc.CallOption) (*v1alpha1.DsseEnvelope, error)) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = stub
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatementArgsForCall(i int) (context.Context, *v1alpha1.GetResourceEvaluationStatementRequest, []grpc.CallOption) {
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
+	argsForCall := fake.getResourceEvaluationStatementArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatementReturns(result1 *v1alpha1.DsseEnvelope, result2 error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = nil
+	fake.getResourceEvaluationStatementReturns = struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetResourceEvaluationStatementReturnsOnCall(i int, result1 *v1alpha1.DsseEnvelope, result2 error) {
+	fake.getResourceEvaluationStatementMutex.Lock()
+	defer fake.getResourceEvaluationStatementMutex.Unlock()
+	fake.GetResourceEvaluationStatementStub = nil
+	if fake.getResourceEvaluationStatementReturnsOnCall == nil {
+		fake.getResourceEvaluationStatementReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.DsseEnvelope
+			result2 error
+		})
+	}
+	fake.getResourceEvaluationStatementReturnsOnCall[i] = struct {
+		result1 *v1alpha1.DsseEnvelope
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetWaiver(arg1 context.Context, arg2 *v1alpha1.GetWaiverRequest, arg3 ...grpc.CallOption) (*v1alpha1.Waiver, error) {
+	fake.getWaiverMutex.Lock()
+	ret, specificReturn := fake.getWaiverReturnsOnCall[len(fake.getWaiverArgsForCall)]
+	fake.getWaiverArgsForCall = append(fake.getWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWaiverRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.GetWaiverStub
+	fakeReturns := fake.getWaiverReturns
+	fake.recordInvocation("GetWaiver", []interface{}{arg1, arg2, arg3})
+	fake.getWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) GetWaiverCallCount() int {
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	return len(fake.getWaiverArgsForCall)
+}
+
+func (fake *FakeRodeClient) GetWaiverCalls(stub func(context.Context, *v1alpha1.GetWaiverRequest, ...grpc.CallOption) (*v1alpha1.Waiver, error)) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = stub
+}
+
+func (fake *FakeRodeClient) GetWaiverArgsForCall(i int) (context.Context, *v1alpha1.GetWaiverRequest, []grpc.CallOption) {
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	argsForCall := fake.getWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) GetWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = nil
+	fake.getWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.getWaiverMutex.Lock()
+	defer fake.getWaiverMutex.Unlock()
+	fake.GetWaiverStub = nil
+	if fake.getWaiverReturnsOnCall == nil {
+		fake.getWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.getWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetWebhook(arg1 context.Context, arg2 *v1alpha1.GetWebhookRequest, arg3 ...grpc.CallOption) (*v1alpha1.Webhook, error) {
+	fake.getWebhookMutex.Lock()
+	ret, specificReturn := fake.getWebhookReturnsOnCall[len(fake.getWebhookArgsForCall)]
+	fake.getWebhookArgsForCall = append(fake.getWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.GetWebhookRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.GetWebhookStub
+	fakeReturns := fake.getWebhookReturns
+	fake.recordInvocation("GetWebhook", []interface{}{arg1, arg2, arg3})
+	fake.getWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) GetWebhookCallCount() int {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	return len(fake.getWebhookArgsForCall)
+}
+
+func (fake *FakeRodeClient) GetWebhookCalls(stub func(context.Context, *v1alpha1.GetWebhookRequest, ...grpc.CallOption) (*v1alpha1.Webhook, error)) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = stub
+}
+
+func (fake *FakeRodeClient) GetWebhookArgsForCall(i int) (context.Context, *v1alpha1.GetWebhookRequest, []grpc.CallOption) {
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	argsForCall := fake.getWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) GetWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	fake.getWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) GetWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.getWebhookMutex.Lock()
+	defer fake.getWebhookMutex.Unlock()
+	fake.GetWebhookStub = nil
+	if fake.getWebhookReturnsOnCall == nil {
+		fake.getWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.getWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListAuditEvents(arg1 context.Context, arg2 *v1alpha1.ListAuditEventsRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListAuditEventsResponse, error) {
+	fake.listAuditEventsMutex.Lock()
+	ret, specificReturn := fake.listAuditEventsReturnsOnCall[len(fake.listAuditEventsArgsForCall)]
+	fake.listAuditEventsArgsForCall = append(fake.listAuditEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListAuditEventsRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListAuditEventsStub
+	fakeReturns := fake.listAuditEventsReturns
+	fake.recordInvocation("ListAuditEvents", []interface{}{arg1, arg2, arg3})
+	fake.listAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ListAuditEventsCallCount() int {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	return len(fake.listAuditEventsArgsForCall)
+}
+
+func (fake *FakeRodeClient) ListAuditEventsCalls(stub func(context.Context, *v1alpha1.ListAuditEventsRequest, ...grpc.CallOption) (*v1alpha1.ListAuditEventsResponse, error)) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = stub
+}
+
+func (fake *FakeRodeClient) ListAuditEventsArgsForCall(i int) (context.Context, *v1alpha1.ListAuditEventsRequest, []grpc.CallOption) {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	argsForCall := fake.listAuditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ListAuditEventsReturns(result1 *v1alpha1.ListAuditEventsResponse, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	fake.listAuditEventsReturns = struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListAuditEventsReturnsOnCall(i int, result1 *v1alpha1.ListAuditEventsResponse, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	if fake.listAuditEventsReturnsOnCall == nil {
+		fake.listAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListAuditEventsResponse
+			result2 error
+		})
+	}
+	fake.listAuditEventsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListAuditEventsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListOccurrences(arg1 context.Context, arg2 *v1alpha1.ListOccurrencesRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListOccurrencesResponse, error) {
+	fake.listOccurrencesMutex.Lock()
+	ret, specificReturn := fake.listOccurrencesReturnsOnCall[len(fake.listOccurrencesArgsForCall)]
+	fake.listOccurrencesArgsForCall = append(fake.listOccurrencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListOccurrencesRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListOccurrencesStub
+	fakeReturns := fake.listOccurrencesReturns
+	fake.recordInvocation("ListOccurrences", []interface{}{arg1, arg2, arg3})
 	fake.listOccurrencesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
@@ -1903,6 +3172,72 @@ func (fake *FakeRodeClient) ListResourcesReturnsOnCall(i int, result1 *v1alpha1.
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) ListStaleResourceEvaluations(arg1 context.Context, arg2 *v1alpha1.ListStaleResourceEvaluationsRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListStaleResourceEvaluationsResponse, error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	ret, specificReturn := fake.listStaleResourceEvaluationsReturnsOnCall[len(fake.listStaleResourceEvaluationsArgsForCall)]
+	fake.listStaleResourceEvaluationsArgsForCall = append(fake.listStaleResourceEvaluationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListStaleResourceEvaluationsRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListStaleResourceEvaluationsStub
+	fakeReturns := fake.listStaleResourceEvaluationsReturns
+	fake.recordInvocation("ListStaleResourceEvaluations", []interface{}{arg1, arg2, arg3})
+	fake.listStaleResourceEvaluationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ListStaleResourceEvaluationsCallCount() int {
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
+	return len(fake.listStaleResourceEvaluationsArgsForCall)
+}
+
+func (fake *FakeRodeClient) ListStaleResourceEvaluationsCalls(stub func(context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest, ...grpc.CallOption) (*v1alpha1.ListStaleResourceEvaluationsResponse, error)) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = stub
+}
+
+func (fake *FakeRodeClient) ListStaleResourceEvaluationsArgsForCall(i int) (context.Context, *v1alpha1.ListStaleResourceEvaluationsRequest, []grpc.CallOption) {
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
+	argsForCall := fake.listStaleResourceEvaluationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ListStaleResourceEvaluationsReturns(result1 *v1alpha1.ListStaleResourceEvaluationsResponse, result2 error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = nil
+	fake.listStaleResourceEvaluationsReturns = struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListStaleResourceEvaluationsReturnsOnCall(i int, result1 *v1alpha1.ListStaleResourceEvaluationsResponse, result2 error) {
+	fake.listStaleResourceEvaluationsMutex.Lock()
+	defer fake.listStaleResourceEvaluationsMutex.Unlock()
+	fake.ListStaleResourceEvaluationsStub = nil
+	if fake.listStaleResourceEvaluationsReturnsOnCall == nil {
+		fake.listStaleResourceEvaluationsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+			result2 error
+		})
+	}
+	fake.listStaleResourceEvaluationsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListStaleResourceEvaluationsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) ListVersionedResourceOccurrences(arg1 context.Context, arg2 *v1alpha1.ListVersionedResourceOccurrencesRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListVersionedResourceOccurrencesResponse, error) {
 	fake.listVersionedResourceOccurrencesMutex.Lock()
 	ret, specificReturn := fake.listVersionedResourceOccurrencesReturnsOnCall[len(fake.listVersionedResourceOccurrencesArgsForCall)]
@@ -1969,6 +3304,204 @@ func (fake *FakeRodeClient) ListVersionedResourceOccurrencesReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) ListWaivers(arg1 context.Context, arg2 *v1alpha1.ListWaiversRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListWaiversResponse, error) {
+	fake.listWaiversMutex.Lock()
+	ret, specificReturn := fake.listWaiversReturnsOnCall[len(fake.listWaiversArgsForCall)]
+	fake.listWaiversArgsForCall = append(fake.listWaiversArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWaiversRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListWaiversStub
+	fakeReturns := fake.listWaiversReturns
+	fake.recordInvocation("ListWaivers", []interface{}{arg1, arg2, arg3})
+	fake.listWaiversMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ListWaiversCallCount() int {
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	return len(fake.listWaiversArgsForCall)
+}
+
+func (fake *FakeRodeClient) ListWaiversCalls(stub func(context.Context, *v1alpha1.ListWaiversRequest, ...grpc.CallOption) (*v1alpha1.ListWaiversResponse, error)) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = stub
+}
+
+func (fake *FakeRodeClient) ListWaiversArgsForCall(i int) (context.Context, *v1alpha1.ListWaiversRequest, []grpc.CallOption) {
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	argsForCall := fake.listWaiversArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ListWaiversReturns(result1 *v1alpha1.ListWaiversResponse, result2 error) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = nil
+	fake.listWaiversReturns = struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListWaiversReturnsOnCall(i int, result1 *v1alpha1.ListWaiversResponse, result2 error) {
+	fake.listWaiversMutex.Lock()
+	defer fake.listWaiversMutex.Unlock()
+	fake.ListWaiversStub = nil
+	if fake.listWaiversReturnsOnCall == nil {
+		fake.listWaiversReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWaiversResponse
+			result2 error
+		})
+	}
+	fake.listWaiversReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWaiversResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveries(arg1 context.Context, arg2 *v1alpha1.ListWebhookDeliveriesRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListWebhookDeliveriesResponse, error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.listWebhookDeliveriesReturnsOnCall[len(fake.listWebhookDeliveriesArgsForCall)]
+	fake.listWebhookDeliveriesArgsForCall = append(fake.listWebhookDeliveriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhookDeliveriesRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListWebhookDeliveriesStub
+	fakeReturns := fake.listWebhookDeliveriesReturns
+	fake.recordInvocation("ListWebhookDeliveries", []interface{}{arg1, arg2, arg3})
+	fake.listWebhookDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveriesCallCount() int {
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	return len(fake.listWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveriesCalls(stub func(context.Context, *v1alpha1.ListWebhookDeliveriesRequest, ...grpc.CallOption) (*v1alpha1.ListWebhookDeliveriesResponse, error)) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = stub
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveriesArgsForCall(i int) (context.Context, *v1alpha1.ListWebhookDeliveriesRequest, []grpc.CallOption) {
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.listWebhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveriesReturns(result1 *v1alpha1.ListWebhookDeliveriesResponse, result2 error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = nil
+	fake.listWebhookDeliveriesReturns = struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListWebhookDeliveriesReturnsOnCall(i int, result1 *v1alpha1.ListWebhookDeliveriesResponse, result2 error) {
+	fake.listWebhookDeliveriesMutex.Lock()
+	defer fake.listWebhookDeliveriesMutex.Unlock()
+	fake.ListWebhookDeliveriesStub = nil
+	if fake.listWebhookDeliveriesReturnsOnCall == nil {
+		fake.listWebhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWebhookDeliveriesResponse
+			result2 error
+		})
+	}
+	fake.listWebhookDeliveriesReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWebhookDeliveriesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListWebhooks(arg1 context.Context, arg2 *v1alpha1.ListWebhooksRequest, arg3 ...grpc.CallOption) (*v1alpha1.ListWebhooksResponse, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ListWebhooksRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListWebhooksStub
+	fakeReturns := fake.listWebhooksReturns
+	fake.recordInvocation("ListWebhooks", []interface{}{arg1, arg2, arg3})
+	fake.listWebhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeRodeClient) ListWebhooksCalls(stub func(context.Context, *v1alpha1.ListWebhooksRequest, ...grpc.CallOption) (*v1alpha1.ListWebhooksResponse, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeRodeClient) ListWebhooksArgsForCall(i int) (context.Context, *v1alpha1.ListWebhooksRequest, []grpc.CallOption) {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	argsForCall := fake.listWebhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ListWebhooksReturns(result1 *v1alpha1.ListWebhooksResponse, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ListWebhooksReturnsOnCall(i int, result1 *v1alpha1.ListWebhooksResponse, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ListWebhooksResponse
+			result2 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ListWebhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) RegisterCollector(arg1 context.Context, arg2 *v1alpha1.RegisterCollectorRequest, arg3 ...grpc.CallOption) (*v1alpha1.RegisterCollectorResponse, error) {
 	fake.registerCollectorMutex.Lock()
 	ret, specificReturn := fake.registerCollectorReturnsOnCall[len(fake.registerCollectorArgsForCall)]
@@ -2035,6 +3568,138 @@ func (fake *FakeRodeClient) RegisterCollectorReturnsOnCall(i int, result1 *v1alp
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) ReplayResourceEvaluation(arg1 context.Context, arg2 *v1alpha1.ReplayResourceEvaluationRequest, arg3 ...grpc.CallOption) (*v1alpha1.ReplayResourceEvaluationResponse, error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	ret, specificReturn := fake.replayResourceEvaluationReturnsOnCall[len(fake.replayResourceEvaluationArgsForCall)]
+	fake.replayResourceEvaluationArgsForCall = append(fake.replayResourceEvaluationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ReplayResourceEvaluationRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayResourceEvaluationStub
+	fakeReturns := fake.replayResourceEvaluationReturns
+	fake.recordInvocation("ReplayResourceEvaluation", []interface{}{arg1, arg2, arg3})
+	fake.replayResourceEvaluationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ReplayResourceEvaluationCallCount() int {
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	return len(fake.replayResourceEvaluationArgsForCall)
+}
+
+func (fake *FakeRodeClient) ReplayResourceEvaluationCalls(stub func(context.Context, *v1alpha1.ReplayResourceEvaluationRequest, ...grpc.CallOption) (*v1alpha1.ReplayResourceEvaluationResponse, error)) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = stub
+}
+
+func (fake *FakeRodeClient) ReplayResourceEvaluationArgsForCall(i int) (context.Context, *v1alpha1.ReplayResourceEvaluationRequest, []grpc.CallOption) {
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	argsForCall := fake.replayResourceEvaluationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ReplayResourceEvaluationReturns(result1 *v1alpha1.ReplayResourceEvaluationResponse, result2 error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = nil
+	fake.replayResourceEvaluationReturns = struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ReplayResourceEvaluationReturnsOnCall(i int, result1 *v1alpha1.ReplayResourceEvaluationResponse, result2 error) {
+	fake.replayResourceEvaluationMutex.Lock()
+	defer fake.replayResourceEvaluationMutex.Unlock()
+	fake.ReplayResourceEvaluationStub = nil
+	if fake.replayResourceEvaluationReturnsOnCall == nil {
+		fake.replayResourceEvaluationReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ReplayResourceEvaluationResponse
+			result2 error
+		})
+	}
+	fake.replayResourceEvaluationReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ReplayResourceEvaluationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignments(arg1 context.Context, arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest, arg3 ...grpc.CallOption) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	ret, specificReturn := fake.resolvePolicyGroupAssignmentsReturnsOnCall[len(fake.resolvePolicyGroupAssignmentsArgsForCall)]
+	fake.resolvePolicyGroupAssignmentsArgsForCall = append(fake.resolvePolicyGroupAssignmentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.ResolvePolicyGroupAssignmentsRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.ResolvePolicyGroupAssignmentsStub
+	fakeReturns := fake.resolvePolicyGroupAssignmentsReturns
+	fake.recordInvocation("ResolvePolicyGroupAssignments", []interface{}{arg1, arg2, arg3})
+	fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignmentsCallCount() int {
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
+	return len(fake.resolvePolicyGroupAssignmentsArgsForCall)
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignmentsCalls(stub func(context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest, ...grpc.CallOption) (*v1alpha1.ResolvePolicyGroupAssignmentsResponse, error)) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = stub
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignmentsArgsForCall(i int) (context.Context, *v1alpha1.ResolvePolicyGroupAssignmentsRequest, []grpc.CallOption) {
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
+	argsForCall := fake.resolvePolicyGroupAssignmentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignmentsReturns(result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse, result2 error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = nil
+	fake.resolvePolicyGroupAssignmentsReturns = struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) ResolvePolicyGroupAssignmentsReturnsOnCall(i int, result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse, result2 error) {
+	fake.resolvePolicyGroupAssignmentsMutex.Lock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.Unlock()
+	fake.ResolvePolicyGroupAssignmentsStub = nil
+	if fake.resolvePolicyGroupAssignmentsReturnsOnCall == nil {
+		fake.resolvePolicyGroupAssignmentsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+			result2 error
+		})
+	}
+	fake.resolvePolicyGroupAssignmentsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ResolvePolicyGroupAssignmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) UpdateOccurrence(arg1 context.Context, arg2 *v1alpha1.UpdateOccurrenceRequest, arg3 ...grpc.CallOption) (*grafeas_go_proto.Occurrence, error) {
 	fake.updateOccurrenceMutex.Lock()
 	ret, specificReturn := fake.updateOccurrenceReturnsOnCall[len(fake.updateOccurrenceArgsForCall)]
@@ -2299,6 +3964,138 @@ func (fake *FakeRodeClient) UpdatePolicyGroupReturnsOnCall(i int, result1 *v1alp
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) UpdateWaiver(arg1 context.Context, arg2 *v1alpha1.Waiver, arg3 ...grpc.CallOption) (*v1alpha1.Waiver, error) {
+	fake.updateWaiverMutex.Lock()
+	ret, specificReturn := fake.updateWaiverReturnsOnCall[len(fake.updateWaiverArgsForCall)]
+	fake.updateWaiverArgsForCall = append(fake.updateWaiverArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Waiver
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateWaiverStub
+	fakeReturns := fake.updateWaiverReturns
+	fake.recordInvocation("UpdateWaiver", []interface{}{arg1, arg2, arg3})
+	fake.updateWaiverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) UpdateWaiverCallCount() int {
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	return len(fake.updateWaiverArgsForCall)
+}
+
+func (fake *FakeRodeClient) UpdateWaiverCalls(stub func(context.Context, *v1alpha1.Waiver, ...grpc.CallOption) (*v1alpha1.Waiver, error)) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = stub
+}
+
+func (fake *FakeRodeClient) UpdateWaiverArgsForCall(i int) (context.Context, *v1alpha1.Waiver, []grpc.CallOption) {
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	argsForCall := fake.updateWaiverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) UpdateWaiverReturns(result1 *v1alpha1.Waiver, result2 error) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = nil
+	fake.updateWaiverReturns = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) UpdateWaiverReturnsOnCall(i int, result1 *v1alpha1.Waiver, result2 error) {
+	fake.updateWaiverMutex.Lock()
+	defer fake.updateWaiverMutex.Unlock()
+	fake.UpdateWaiverStub = nil
+	if fake.updateWaiverReturnsOnCall == nil {
+		fake.updateWaiverReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Waiver
+			result2 error
+		})
+	}
+	fake.updateWaiverReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Waiver
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) UpdateWebhook(arg1 context.Context, arg2 *v1alpha1.Webhook, arg3 ...grpc.CallOption) (*v1alpha1.Webhook, error) {
+	fake.updateWebhookMutex.Lock()
+	ret, specificReturn := fake.updateWebhookReturnsOnCall[len(fake.updateWebhookArgsForCall)]
+	fake.updateWebhookArgsForCall = append(fake.updateWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Webhook
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateWebhookStub
+	fakeReturns := fake.updateWebhookReturns
+	fake.recordInvocation("UpdateWebhook", []interface{}{arg1, arg2, arg3})
+	fake.updateWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) UpdateWebhookCallCount() int {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	return len(fake.updateWebhookArgsForCall)
+}
+
+func (fake *FakeRodeClient) UpdateWebhookCalls(stub func(context.Context, *v1alpha1.Webhook, ...grpc.CallOption) (*v1alpha1.Webhook, error)) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = stub
+}
+
+func (fake *FakeRodeClient) UpdateWebhookArgsForCall(i int) (context.Context, *v1alpha1.Webhook, []grpc.CallOption) {
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
+	argsForCall := fake.updateWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) UpdateWebhookReturns(result1 *v1alpha1.Webhook, result2 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	fake.updateWebhookReturns = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) UpdateWebhookReturnsOnCall(i int, result1 *v1alpha1.Webhook, result2 error) {
+	fake.updateWebhookMutex.Lock()
+	defer fake.updateWebhookMutex.Unlock()
+	fake.UpdateWebhookStub = nil
+	if fake.updateWebhookReturnsOnCall == nil {
+		fake.updateWebhookReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Webhook
+			result2 error
+		})
+	}
+	fake.updateWebhookReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Webhook
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) ValidatePolicy(arg1 context.Context, arg2 *v1alpha1.ValidatePolicyRequest, arg3 ...grpc.CallOption) (*v1alpha1.ValidatePolicyResponse, error) {
 	fake.validatePolicyMutex.Lock()
 	ret, specificReturn := fake.validatePolicyReturnsOnCall[len(fake.validatePolicyArgsForCall)]
@@ -2365,11 +4162,81 @@ func (fake *FakeRodeClient) ValidatePolicyReturnsOnCall(i int, result1 *v1alpha1
 	}{result1, result2}
 }
 
+func (fake *FakeRodeClient) WatchResourceEvaluations(arg1 context.Context, arg2 *v1alpha1.WatchResourceEvaluationsRequest, arg3 ...grpc.CallOption) (v1alpha1.Rode_WatchResourceEvaluationsClient, error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	ret, specificReturn := fake.watchResourceEvaluationsReturnsOnCall[len(fake.watchResourceEvaluationsArgsForCall)]
+	fake.watchResourceEvaluationsArgsForCall = append(fake.watchResourceEvaluationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.WatchResourceEvaluationsRequest
+		arg3 []grpc.CallOption
+	}{arg1, arg2, arg3})
+	stub := fake.WatchResourceEvaluationsStub
+	fakeReturns := fake.watchResourceEvaluationsReturns
+	fake.recordInvocation("WatchResourceEvaluations", []interface{}{arg1, arg2, arg3})
+	fake.watchResourceEvaluationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRodeClient) WatchResourceEvaluationsCallCount() int {
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
+	return len(fake.watchResourceEvaluationsArgsForCall)
+}
+
+func (fake *FakeRodeClient) WatchResourceEvaluationsCalls(stub func(context.Context, *v1alpha1.WatchResourceEvaluationsRequest, ...grpc.CallOption) (v1alpha1.Rode_WatchResourceEvaluationsClient, error)) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = stub
+}
+
+func (fake *FakeRodeClient) WatchResourceEvaluationsArgsForCall(i int) (context.Context, *v1alpha1.WatchResourceEvaluationsRequest, []grpc.CallOption) {
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
+	argsForCall := fake.watchResourceEvaluationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRodeClient) WatchResourceEvaluationsReturns(result1 v1alpha1.Rode_WatchResourceEvaluationsClient, result2 error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = nil
+	fake.watchResourceEvaluationsReturns = struct {
+		result1 v1alpha1.Rode_WatchResourceEvaluationsClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRodeClient) WatchResourceEvaluationsReturnsOnCall(i int, result1 v1alpha1.Rode_WatchResourceEvaluationsClient, result2 error) {
+	fake.watchResourceEvaluationsMutex.Lock()
+	defer fake.watchResourceEvaluationsMutex.Unlock()
+	fake.WatchResourceEvaluationsStub = nil
+	if fake.watchResourceEvaluationsReturnsOnCall == nil {
+		fake.watchResourceEvaluationsReturnsOnCall = make(map[int]struct {
+			result1 v1alpha1.Rode_WatchResourceEvaluationsClient
+			result2 error
+		})
+	}
+	fake.watchResourceEvaluationsReturnsOnCall[i] = struct {
+		result1 v1alpha1.Rode_WatchResourceEvaluationsClient
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRodeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.analyzePolicyAssignmentImpactMutex.RLock()
+	defer fake.analyzePolicyAssignmentImpactMutex.RUnlock()
 	fake.batchCreateOccurrencesMutex.RLock()
 	defer fake.batchCreateOccurrencesMutex.RUnlock()
+	fake.batchEvaluateResourceMutex.RLock()
+	defer fake.batchEvaluateResourceMutex.RUnlock()
 	fake.createNoteMutex.RLock()
 	defer fake.createNoteMutex.RUnlock()
 	fake.createPolicyMutex.RLock()
@@ -2378,16 +4245,30 @@ func (fake *FakeRodeClient) Invocations() map[string][][]interface{} {
 	defer fake.createPolicyAssignmentMutex.RUnlock()
 	fake.createPolicyGroupMutex.RLock()
 	defer fake.createPolicyGroupMutex.RUnlock()
+	fake.createWaiverMutex.RLock()
+	defer fake.createWaiverMutex.RUnlock()
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
 	fake.deletePolicyMutex.RLock()
 	defer fake.deletePolicyMutex.RUnlock()
 	fake.deletePolicyAssignmentMutex.RLock()
 	defer fake.deletePolicyAssignmentMutex.RUnlock()
 	fake.deletePolicyGroupMutex.RLock()
 	defer fake.deletePolicyGroupMutex.RUnlock()
+	fake.deleteWaiverMutex.RLock()
+	defer fake.deleteWaiverMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
 	fake.evaluatePolicyMutex.RLock()
 	defer fake.evaluatePolicyMutex.RUnlock()
 	fake.evaluateResourceMutex.RLock()
 	defer fake.evaluateResourceMutex.RUnlock()
+	fake.evaluateResourceAsyncMutex.RLock()
+	defer fake.evaluateResourceAsyncMutex.RUnlock()
+	fake.evaluateResourcesMutex.RLock()
+	defer fake.evaluateResourcesMutex.RUnlock()
+	fake.getEvaluationPublicKeyMutex.RLock()
+	defer fake.getEvaluationPublicKeyMutex.RUnlock()
 	fake.getPolicyMutex.RLock()
 	defer fake.getPolicyMutex.RUnlock()
 	fake.getPolicyAssignmentMutex.RLock()
@@ -2396,6 +4277,16 @@ func (fake *FakeRodeClient) Invocations() map[string][][]interface{} {
 	defer fake.getPolicyGroupMutex.RUnlock()
 	fake.getResourceEvaluationMutex.RLock()
 	defer fake.getResourceEvaluationMutex.RUnlock()
+	fake.getResourceEvaluationInputMutex.RLock()
+	defer fake.getResourceEvaluationInputMutex.RUnlock()
+	fake.getResourceEvaluationStatementMutex.RLock()
+	defer fake.getResourceEvaluationStatementMutex.RUnlock()
+	fake.getWaiverMutex.RLock()
+	defer fake.getWaiverMutex.RUnlock()
+	fake.getWebhookMutex.RLock()
+	defer fake.getWebhookMutex.RUnlock()
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
 	fake.listOccurrencesMutex.RLock()
 	defer fake.listOccurrencesMutex.RUnlock()
 	fake.listPoliciesMutex.RLock()
@@ -2412,10 +4303,22 @@ func (fake *FakeRodeClient) Invocations() map[string][][]interface{} {
 	defer fake.listResourceVersionsMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
+	fake.listStaleResourceEvaluationsMutex.RLock()
+	defer fake.listStaleResourceEvaluationsMutex.RUnlock()
 	fake.listVersionedResourceOccurrencesMutex.RLock()
 	defer fake.listVersionedResourceOccurrencesMutex.RUnlock()
+	fake.listWaiversMutex.RLock()
+	defer fake.listWaiversMutex.RUnlock()
+	fake.listWebhookDeliveriesMutex.RLock()
+	defer fake.listWebhookDeliveriesMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
 	fake.registerCollectorMutex.RLock()
 	defer fake.registerCollectorMutex.RUnlock()
+	fake.replayResourceEvaluationMutex.RLock()
+	defer fake.replayResourceEvaluationMutex.RUnlock()
+	fake.resolvePolicyGroupAssignmentsMutex.RLock()
+	defer fake.resolvePolicyGroupAssignmentsMutex.RUnlock()
 	fake.updateOccurrenceMutex.RLock()
 	defer fake.updateOccurrenceMutex.RUnlock()
 	fake.updatePolicyMutex.RLock()
@@ -2424,8 +4327,14 @@ func (fake *FakeRodeClient) Invocations() map[string][][]interface{} {
 	defer fake.updatePolicyAssignmentMutex.RUnlock()
 	fake.updatePolicyGroupMutex.RLock()
 	defer fake.updatePolicyGroupMutex.RUnlock()
+	fake.updateWaiverMutex.RLock()
+	defer fake.updateWaiverMutex.RUnlock()
+	fake.updateWebhookMutex.RLock()
+	defer fake.updateWebhookMutex.RUnlock()
 	fake.validatePolicyMutex.RLock()
 	defer fake.validatePolicyMutex.RUnlock()
+	fake.watchResourceEvaluationsMutex.RLock()
+	defer fake.watchResourceEvaluationsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
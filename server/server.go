@@ -18,7 +18,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/rode/rode/pkg/audit"
 	"github.com/rode/rode/pkg/evaluation"
+	"github.com/rode/rode/pkg/events"
 	"strings"
 
 	"github.com/rode/rode/pkg/constants"
@@ -26,6 +28,7 @@ import (
 
 	"github.com/rode/rode/pkg/policy"
 	"github.com/rode/rode/pkg/resource"
+	"github.com/rode/rode/pkg/webhook"
 	"github.com/rode/rode/protodeps/grafeas/proto/v1beta1/common_go_proto"
 
 	"github.com/rode/es-index-manager/indexmanager"
@@ -50,7 +53,11 @@ func NewRodeServer(
 	policyManager policy.Manager,
 	policyGroupManager policy.PolicyGroupManager,
 	policyAssignmentManager policy.AssignmentManager,
+	waiverManager policy.WaiverManager,
 	evaluationManager evaluation.Manager,
+	webhookManager webhook.Manager,
+	auditManager audit.Manager,
+	eventPublisher events.Publisher,
 ) (pb.RodeServer, error) {
 	rodeServer := &rodeServer{
 		logger,
@@ -62,7 +69,11 @@ func NewRodeServer(
 		policyManager,
 		policyGroupManager,
 		policyAssignmentManager,
+		waiverManager,
 		evaluationManager,
+		webhookManager,
+		auditManager,
+		eventPublisher,
 	}
 
 	if err := rodeServer.initialize(context.Background()); err != nil {
@@ -82,7 +93,11 @@ type rodeServer struct {
 	policy.Manager
 	policy.PolicyGroupManager
 	policy.AssignmentManager
+	policy.WaiverManager
 	evaluation.EvaluationManager
+	webhook.WebhookManager
+	audit.AuditManager
+	eventPublisher events.Publisher
 }
 
 func (r *rodeServer) BatchCreateOccurrences(ctx context.Context, occurrenceRequest *pb.BatchCreateOccurrencesRequest) (*pb.BatchCreateOccurrencesResponse, error) {
@@ -105,6 +120,12 @@ func (r *rodeServer) BatchCreateOccurrences(ctx context.Context, occurrenceReque
 		return nil, createError(log, "error creating resource versions", err)
 	}
 
+	for _, occurrence := range occurrenceResponse.Occurrences {
+		r.eventPublisher.Publish(events.OccurrenceCreated, occurrence.Name, occurrence)
+	}
+
+	r.EvaluationManager.ScheduleAutoEvaluations(resource.ResourceVersionUris(occurrenceResponse.Occurrences)...)
+
 	return &pb.BatchCreateOccurrencesResponse{
 		Occurrences: occurrenceResponse.GetOccurrences(),
 	}, nil
@@ -190,6 +211,31 @@ func (r *rodeServer) initialize(ctx context.Context) error {
 			aliasName:    r.indexManager.AliasName(constants.EvaluationsDocumentKind, ""),
 			documentKind: constants.EvaluationsDocumentKind,
 		},
+		{
+			indexName:    r.indexManager.IndexName(constants.EvaluationInputsDocumentKind, ""),
+			aliasName:    r.indexManager.AliasName(constants.EvaluationInputsDocumentKind, ""),
+			documentKind: constants.EvaluationInputsDocumentKind,
+		},
+		{
+			indexName:    r.indexManager.IndexName(constants.WaiversDocumentKind, ""),
+			aliasName:    r.indexManager.AliasName(constants.WaiversDocumentKind, ""),
+			documentKind: constants.WaiversDocumentKind,
+		},
+		{
+			indexName:    r.indexManager.IndexName(constants.WebhooksDocumentKind, ""),
+			aliasName:    r.indexManager.AliasName(constants.WebhooksDocumentKind, ""),
+			documentKind: constants.WebhooksDocumentKind,
+		},
+		{
+			indexName:    r.indexManager.IndexName(constants.WebhookDeliveriesDocumentKind, ""),
+			aliasName:    r.indexManager.AliasName(constants.WebhookDeliveriesDocumentKind, ""),
+			documentKind: constants.WebhookDeliveriesDocumentKind,
+		},
+		{
+			indexName:    r.indexManager.IndexName(constants.AuditDocumentKind, ""),
+			aliasName:    r.indexManager.AliasName(constants.AuditDocumentKind, ""),
+			documentKind: constants.AuditDocumentKind,
+		},
 	}
 
 	for _, settings := range indexSettings {
@@ -211,6 +257,9 @@ func (r *rodeServer) ListVersionedResourceOccurrences(ctx context.Context, reque
 	}
 
 	occurrences, nextPageToken, err := r.grafeasExtensions.ListVersionedResourceOccurrences(ctx, resourceUri, request.PageToken, request.PageSize)
+	if errors.Is(err, grafeas.ErrTooManyOccurrences) {
+		return nil, createErrorWithCode(log, "error listing versioned resource occurrences", err, codes.FailedPrecondition)
+	}
 	if err != nil {
 		return nil, createError(log, "error listing versioned resource occurrences", err)
 	}
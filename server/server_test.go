@@ -21,12 +21,17 @@ import (
 	"strings"
 
 	"github.com/rode/rode/pkg/evaluation/evaluationfakes"
+	"github.com/rode/rode/pkg/events"
+	"github.com/rode/rode/pkg/events/eventsfakes"
 
+	"github.com/rode/rode/pkg/audit/auditfakes"
 	"github.com/rode/rode/pkg/constants"
+	"github.com/rode/rode/pkg/grafeas"
 	"github.com/rode/rode/pkg/grafeas/grafeasfakes"
 
 	"github.com/rode/rode/pkg/policy/policyfakes"
 	"github.com/rode/rode/pkg/resource/resourcefakes"
+	"github.com/rode/rode/pkg/webhook/webhookfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -53,7 +58,11 @@ var _ = Describe("rode server", func() {
 		policyManager           *policyfakes.FakeManager
 		policyGroupManager      *policyfakes.FakePolicyGroupManager
 		policyAssignmentManager *policyfakes.FakeAssignmentManager
+		waiverManager           *policyfakes.FakeWaiverManager
 		evaluationManager       *evaluationfakes.FakeManager
+		webhookManager          *webhookfakes.FakeManager
+		auditManager            *auditfakes.FakeManager
+		eventPublisher          *eventsfakes.FakePublisher
 		indexManager            *immocks.FakeIndexManager
 		ctx                     context.Context
 
@@ -71,6 +80,21 @@ var _ = Describe("rode server", func() {
 
 		expectedEvaluationsIndex string
 		expectedEvaluationsAlias string
+
+		expectedEvaluationInputsIndex string
+		expectedEvaluationInputsAlias string
+
+		expectedWaiversIndex string
+		expectedWaiversAlias string
+
+		expectedWebhooksIndex string
+		expectedWebhooksAlias string
+
+		expectedWebhookDeliveriesIndex string
+		expectedWebhookDeliveriesAlias string
+
+		expectedAuditIndex string
+		expectedAuditAlias string
 	)
 
 	BeforeEach(func() {
@@ -80,7 +104,11 @@ var _ = Describe("rode server", func() {
 		resourceManager = &resourcefakes.FakeManager{}
 		policyGroupManager = &policyfakes.FakePolicyGroupManager{}
 		policyAssignmentManager = &policyfakes.FakeAssignmentManager{}
+		waiverManager = &policyfakes.FakeWaiverManager{}
 		evaluationManager = &evaluationfakes.FakeManager{}
+		webhookManager = &webhookfakes.FakeManager{}
+		auditManager = &auditfakes.FakeManager{}
+		eventPublisher = &eventsfakes.FakePublisher{}
 
 		expectedPoliciesIndex = fake.LetterN(10)
 		expectedPoliciesAlias = fake.LetterN(10)
@@ -97,6 +125,21 @@ var _ = Describe("rode server", func() {
 		expectedEvaluationsIndex = fake.LetterN(10)
 		expectedEvaluationsAlias = fake.LetterN(10)
 
+		expectedEvaluationInputsIndex = fake.LetterN(10)
+		expectedEvaluationInputsAlias = fake.LetterN(10)
+
+		expectedWaiversIndex = fake.LetterN(10)
+		expectedWaiversAlias = fake.LetterN(10)
+
+		expectedWebhooksIndex = fake.LetterN(10)
+		expectedWebhooksAlias = fake.LetterN(10)
+
+		expectedWebhookDeliveriesIndex = fake.LetterN(10)
+		expectedWebhookDeliveriesAlias = fake.LetterN(10)
+
+		expectedAuditIndex = fake.LetterN(10)
+		expectedAuditAlias = fake.LetterN(10)
+
 		indexManager = &immocks.FakeIndexManager{}
 
 		indexManager.AliasNameStub = func(documentKind, _ string) string {
@@ -106,6 +149,11 @@ var _ = Describe("rode server", func() {
 				constants.PolicyAssignmentsDocumentKind: expectedPolicyAssignmentsAlias,
 				constants.ResourcesDocumentKind:         expectedResourceAlias,
 				constants.EvaluationsDocumentKind:       expectedEvaluationsAlias,
+				constants.EvaluationInputsDocumentKind:  expectedEvaluationInputsAlias,
+				constants.WaiversDocumentKind:           expectedWaiversAlias,
+				constants.WebhooksDocumentKind:          expectedWebhooksAlias,
+				constants.WebhookDeliveriesDocumentKind: expectedWebhookDeliveriesAlias,
+				constants.AuditDocumentKind:             expectedAuditAlias,
 			}[documentKind]
 		}
 
@@ -116,6 +164,11 @@ var _ = Describe("rode server", func() {
 				constants.PolicyAssignmentsDocumentKind: expectedPolicyAssignmentsIndex,
 				constants.ResourcesDocumentKind:         expectedResourceIndex,
 				constants.EvaluationsDocumentKind:       expectedEvaluationsIndex,
+				constants.EvaluationInputsDocumentKind:  expectedEvaluationInputsIndex,
+				constants.WaiversDocumentKind:           expectedWaiversIndex,
+				constants.WebhooksDocumentKind:          expectedWebhooksIndex,
+				constants.WebhookDeliveriesDocumentKind: expectedWebhookDeliveriesIndex,
+				constants.AuditDocumentKind:             expectedAuditIndex,
 			}[documentKind]
 		}
 
@@ -131,8 +184,12 @@ var _ = Describe("rode server", func() {
 			Manager:            policyManager,
 			AssignmentManager:  policyAssignmentManager,
 			PolicyGroupManager: policyGroupManager,
+			WaiverManager:      waiverManager,
 			indexManager:       indexManager,
 			EvaluationManager:  evaluationManager,
+			WebhookManager:     webhookManager,
+			AuditManager:       auditManager,
+			eventPublisher:     eventPublisher,
 		}
 	})
 
@@ -159,7 +216,7 @@ var _ = Describe("rode server", func() {
 			grafeasProjectsClient.GetProjectReturns(expectedProject, expectedGetProjectError)
 			grafeasProjectsClient.CreateProjectReturns(expectedProject, expectedCreateProjectError)
 
-			actualRodeServer, actualError = NewRodeServer(logger, grafeasClient, grafeasProjectsClient, grafeasExtensions, resourceManager, indexManager, policyManager, policyGroupManager, policyAssignmentManager, evaluationManager)
+			actualRodeServer, actualError = NewRodeServer(logger, grafeasClient, grafeasProjectsClient, grafeasExtensions, resourceManager, indexManager, policyManager, policyGroupManager, policyAssignmentManager, waiverManager, evaluationManager, webhookManager, auditManager, eventPublisher)
 		})
 
 		It("should check if the rode project exists", func() {
@@ -179,7 +236,7 @@ var _ = Describe("rode server", func() {
 		})
 
 		It("should create the application indices", func() {
-			Expect(indexManager.CreateIndexCallCount()).To(Equal(5))
+			Expect(indexManager.CreateIndexCallCount()).To(Equal(10))
 		})
 
 		It("should create an index for policies", func() {
@@ -191,7 +248,7 @@ var _ = Describe("rode server", func() {
 		})
 
 		It("should create an index for resources", func() {
-			Expect(indexManager.CreateIndexCallCount()).To(Equal(5))
+			Expect(indexManager.CreateIndexCallCount()).To(Equal(10))
 
 			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(1)
 
@@ -224,6 +281,46 @@ var _ = Describe("rode server", func() {
 			Expect(documentKind).To(Equal(constants.EvaluationsDocumentKind))
 		})
 
+		It("should create an index for evaluation inputs", func() {
+			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(5)
+
+			Expect(actualIndexName).To(Equal(expectedEvaluationInputsIndex))
+			Expect(actualAliasName).To(Equal(expectedEvaluationInputsAlias))
+			Expect(documentKind).To(Equal(constants.EvaluationInputsDocumentKind))
+		})
+
+		It("should create an index for waivers", func() {
+			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(6)
+
+			Expect(actualIndexName).To(Equal(expectedWaiversIndex))
+			Expect(actualAliasName).To(Equal(expectedWaiversAlias))
+			Expect(documentKind).To(Equal(constants.WaiversDocumentKind))
+		})
+
+		It("should create an index for webhooks", func() {
+			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(7)
+
+			Expect(actualIndexName).To(Equal(expectedWebhooksIndex))
+			Expect(actualAliasName).To(Equal(expectedWebhooksAlias))
+			Expect(documentKind).To(Equal(constants.WebhooksDocumentKind))
+		})
+
+		It("should create an index for webhook deliveries", func() {
+			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(8)
+
+			Expect(actualIndexName).To(Equal(expectedWebhookDeliveriesIndex))
+			Expect(actualAliasName).To(Equal(expectedWebhookDeliveriesAlias))
+			Expect(documentKind).To(Equal(constants.WebhookDeliveriesDocumentKind))
+		})
+
+		It("should create an index for audit events", func() {
+			_, actualIndexName, actualAliasName, documentKind := indexManager.CreateIndexArgsForCall(9)
+
+			Expect(actualIndexName).To(Equal(expectedAuditIndex))
+			Expect(actualAliasName).To(Equal(expectedAuditAlias))
+			Expect(documentKind).To(Equal(constants.AuditDocumentKind))
+		})
+
 		It("should return the initialized rode server", func() {
 			Expect(actualRodeServer).ToNot(BeNil())
 			Expect(actualError).ToNot(HaveOccurred())
@@ -388,6 +485,22 @@ var _ = Describe("rode server", func() {
 			Expect(occurrences).To(BeEquivalentTo(expectedRodeBatchCreateOccurrencesRequest.Occurrences))
 		})
 
+		It("should schedule automatic evaluations of the resource versions", func() {
+			Expect(evaluationManager.ScheduleAutoEvaluationsCallCount()).To(Equal(1))
+
+			resourceUris := evaluationManager.ScheduleAutoEvaluationsArgsForCall(0)
+			Expect(resourceUris).To(ConsistOf(expectedOccurrence.Resource.Uri))
+		})
+
+		It("should publish an event for each created occurrence", func() {
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+
+			eventType, subject, data := eventPublisher.PublishArgsForCall(0)
+			Expect(eventType).To(Equal(events.OccurrenceCreated))
+			Expect(subject).To(Equal(expectedOccurrence.Name))
+			Expect(data).To(Equal(expectedOccurrence))
+		})
+
 		It("should return the created occurrences", func() {
 			Expect(actualRodeBatchCreateOccurrencesResponse.Occurrences).To(HaveLen(1))
 			Expect(actualRodeBatchCreateOccurrencesResponse.Occurrences[0]).To(BeEquivalentTo(expectedOccurrence))
@@ -411,6 +524,10 @@ var _ = Describe("rode server", func() {
 			It("should not attempt to create resource versions", func() {
 				Expect(resourceManager.BatchCreateResourceVersionsCallCount()).To(Equal(0))
 			})
+
+			It("should not publish any events", func() {
+				Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+			})
 		})
 
 		When("an error occurs while creating resources", func() {
@@ -437,6 +554,10 @@ var _ = Describe("rode server", func() {
 				Expect(actualRodeBatchCreateOccurrencesResponse).To(BeNil())
 				Expect(actualError).To(HaveOccurred())
 			})
+
+			It("should not schedule automatic evaluations", func() {
+				Expect(evaluationManager.ScheduleAutoEvaluationsCallCount()).To(Equal(0))
+			})
 		})
 	})
 
@@ -712,6 +833,17 @@ var _ = Describe("rode server", func() {
 			})
 		})
 
+		When("the resource has too many build occurrences", func() {
+			BeforeEach(func() {
+				listVersionedResourceOccurrencesError = fmt.Errorf("resource has more than 1 build occurrences: %w", grafeas.ErrTooManyOccurrences)
+			})
+
+			It("should return a failed precondition error", func() {
+				Expect(actualResponse).To(BeNil())
+				Expect(getGRPCStatusFromError(actualError).Code()).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
 		When("the resource uri is not specified", func() {
 			BeforeEach(func() {
 				request.ResourceUri = ""